@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"flag"
 	"math"
 	"net"
@@ -11,10 +14,12 @@ import (
 	"path"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -23,6 +28,8 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/ray-project/kuberay/apiserver/pkg/auth"
+	"github.com/ray-project/kuberay/apiserver/pkg/client"
 	"github.com/ray-project/kuberay/apiserver/pkg/interceptor"
 	"github.com/ray-project/kuberay/apiserver/pkg/manager"
 	"github.com/ray-project/kuberay/apiserver/pkg/server"
@@ -36,6 +43,19 @@ var (
 	collectMetricsFlag = flag.Bool("collectMetricsFlag", true, "Whether to collect Prometheus metrics in API server.")
 	logFile            = flag.String("logFilePath", "", "Synchronize logs to local file")
 	localSwaggerPath   = flag.String("localSwaggerPath", "", "Specify the root directory for `*.swagger.json` the swagger files.")
+	authentication     = flag.String("authentication", "", "Comma separated methods to authenticate the callers: token (Kubernetes TokenReview), oidc and x509 (TLS client certificates). Authentication is disabled if it is empty.")
+	authorization      = flag.Bool("enableAuthorization", false, "Whether to authorize the RPCs against the Kubernetes RBAC permissions of the callers. Requires authentication.")
+	oidcIssuerURL      = flag.String("oidcIssuerURL", "", "The URL of the OpenID Connect issuer, used by the oidc authentication.")
+	oidcClientID       = flag.String("oidcClientID", "", "The client ID the OpenID Connect tokens must be issued for.")
+	oidcUsernameClaim  = flag.String("oidcUsernameClaim", "sub", "The claim of the OpenID Connect tokens used as username.")
+	oidcGroupsClaim    = flag.String("oidcGroupsClaim", "groups", "The claim of the OpenID Connect tokens used as groups.")
+	tlsCertFile        = flag.String("tlsCertFile", "", "The TLS certificate of the gRPC server. TLS is disabled if it is empty.")
+	tlsKeyFile         = flag.String("tlsKeyFile", "", "The TLS private key of the gRPC server.")
+	clientCAFile       = flag.String("clientCAFile", "", "The CA bundle to verify the TLS client certificates, used by the x509 authentication.")
+	auditLogPath       = flag.String("auditLogPath", "", "The file to append the audit events of the RPCs to as JSON lines.")
+	auditWebhookURL    = flag.String("auditWebhookURL", "", "The URL to post the audit events of the RPCs to as JSON. Auditing is disabled if neither the audit log path nor the webhook URL is set.")
+	auditLevel         = flag.String("auditLevel", "Metadata", "The audit level of the RPCs modifying resources: None, Metadata, or Request to also record the request payloads with the secrets redacted.")
+	auditMethodLevels  = flag.String("auditMethodLevels", "", "Comma separated audit levels of single RPCs, overriding the audit level, e.g. /proto.ClusterService/CreateCluster=Request,/proto.ClusterService/GetCluster=Metadata.")
 	healthy            int32
 )
 
@@ -54,7 +74,7 @@ func main() {
 	resourceManager := manager.NewResourceManager(&clientManager)
 
 	atomic.StoreInt32(&healthy, 1)
-	go startRpcServer(resourceManager)
+	go startRpcServer(resourceManager, clientManager.KubernetesClient())
 	startHttpProxy()
 	// See also https://gist.github.com/enricofoltran/10b4a980cd07cb02836f70a4ab3e72d7
 	quit := make(chan os.Signal, 1)
@@ -70,7 +90,7 @@ func main() {
 
 type RegisterHttpHandlerFromEndpoint func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
-func startRpcServer(resourceManager *manager.ResourceManager) {
+func startRpcServer(resourceManager *manager.ResourceManager, kubernetesClient client.KubernetesClientInterface) {
 	klog.Info("Starting gRPC server")
 
 	listener, err := net.Listen("tcp", *rpcPortFlag)
@@ -80,16 +100,36 @@ func startRpcServer(resourceManager *manager.ResourceManager) {
 
 	clusterServer := server.NewClusterServer(resourceManager, &server.ClusterServerOptions{CollectMetrics: *collectMetricsFlag})
 	templateServer := server.NewComputeTemplateServer(resourceManager, &server.ComputeTemplateServerOptions{CollectMetrics: *collectMetricsFlag})
+	imageTemplateServer := server.NewImageTemplateServer(resourceManager, &server.ImageTemplateServerOptions{CollectMetrics: *collectMetricsFlag})
 	jobServer := server.NewRayJobServer(resourceManager, &server.JobServerOptions{CollectMetrics: *collectMetricsFlag})
 	jobSubmissionServer := server.NewRayJobSubmissionServiceServer(clusterServer, &server.RayJobSubmissionServiceServerOptions{CollectMetrics: *collectMetricsFlag})
 	serveServer := server.NewRayServiceServer(resourceManager, &server.ServiceServerOptions{CollectMetrics: *collectMetricsFlag})
 
-	s := grpc.NewServer(
-		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(grpc_prometheus.UnaryServerInterceptor, interceptor.ApiServerInterceptor)),
-		grpc.MaxRecvMsgSize(math.MaxInt32))
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor, interceptor.ApiServerInterceptor}
+	// The audit interceptor is chained before the authentication to record the denied RPCs as well.
+	if auditInterceptor := newAuditInterceptor(); auditInterceptor != nil {
+		streamInterceptors = append(streamInterceptors, auditInterceptor.Stream)
+		unaryInterceptors = append(unaryInterceptors, auditInterceptor.Unary)
+	}
+	if authInterceptor := newAuthInterceptor(kubernetesClient); authInterceptor != nil {
+		streamInterceptors = append(streamInterceptors, authInterceptor.Stream)
+		unaryInterceptors = append(unaryInterceptors, authInterceptor.Unary)
+	} else {
+		klog.Warning("Authentication is disabled, any caller can operate on the resources of the API server.")
+	}
+	serverOptions := []grpc.ServerOption{
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
+		grpc.MaxRecvMsgSize(math.MaxInt32),
+	}
+	if tlsConfig := newServerTLSConfig(); tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s := grpc.NewServer(serverOptions...)
 	api.RegisterClusterServiceServer(s, clusterServer)
 	api.RegisterComputeTemplateServiceServer(s, templateServer)
+	api.RegisterImageTemplateServiceServer(s, imageTemplateServer)
 	api.RegisterRayJobServiceServer(s, jobServer)
 	api.RegisterRayJobSubmissionServiceServer(s, jobSubmissionServer)
 	api.RegisterRayServeServiceServer(s, serveServer)
@@ -116,21 +156,25 @@ func startHttpProxy() {
 	defer cancel()
 
 	// Create gRPC HTTP MUX and register services.
+	jsonMarshaler := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames:  false,
+			UseEnumNumbers: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}
 	runtimeMux := runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-			MarshalOptions: protojson.MarshalOptions{
-				UseProtoNames:  false,
-				UseEnumNumbers: true,
-			},
-			UnmarshalOptions: protojson.UnmarshalOptions{
-				DiscardUnknown: true,
-			},
-		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+		// Used instead of JSON when the client only accepts server-sent events.
+		runtime.WithMarshalerOption(eventStreamContentType, &eventStreamMarshaler{JSONPb: jsonMarshaler}),
 		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
 	)
 	// Register endpoints
 	registerHttpHandlerFromEndpoint(api.RegisterClusterServiceHandlerFromEndpoint, "ClusterService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterComputeTemplateServiceHandlerFromEndpoint, "ComputeTemplateService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterImageTemplateServiceHandlerFromEndpoint, "ImageTemplateService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterRayJobServiceHandlerFromEndpoint, "JobService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterRayServeServiceHandlerFromEndpoint, "ServeService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterRayJobSubmissionServiceHandlerFromEndpoint, "RayJobSubmissionService", ctx, runtimeMux)
@@ -196,9 +240,158 @@ func serveSwaggerUI(mux *http.ServeMux) {
 
 func registerHttpHandlerFromEndpoint(handler RegisterHttpHandlerFromEndpoint, serviceName string, ctx context.Context, mux *runtime.ServeMux) {
 	endpoint := "localhost" + *rpcPortFlag
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32))}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(newGatewayTransportCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32))}
 
 	if err := handler(ctx, mux, endpoint, opts); err != nil {
 		klog.Fatalf("Failed to register %v handler: %v", serviceName, err)
 	}
 }
+
+// newAuthInterceptor creates the interceptor authenticating and authorizing the RPCs, or returns nil if authentication
+// is disabled.
+func newAuthInterceptor(kubernetesClient client.KubernetesClientInterface) *interceptor.AuthInterceptor {
+	if *authentication == "" {
+		if *authorization {
+			klog.Fatal("Authorization requires authentication. Please set the authentication methods.")
+		}
+		return nil
+	}
+
+	var authenticators auth.UnionAuthenticator
+	for _, method := range strings.Split(*authentication, ",") {
+		switch strings.TrimSpace(method) {
+		case "token":
+			authenticators = append(authenticators, auth.NewTokenReviewAuthenticator(kubernetesClient.TokenReviewClient(), nil))
+		case "oidc":
+			if *oidcIssuerURL == "" || *oidcClientID == "" {
+				klog.Fatal("The oidc authentication requires the OpenID Connect issuer URL and client ID.")
+			}
+			oidcAuthenticator, err := auth.NewOIDCAuthenticator(context.Background(), *oidcIssuerURL, *oidcClientID, *oidcUsernameClaim, *oidcGroupsClaim)
+			if err != nil {
+				klog.Fatalf("Failed to create the OIDC authenticator: %v", err)
+			}
+			authenticators = append(authenticators, oidcAuthenticator)
+		case "x509":
+			if *clientCAFile == "" || *tlsCertFile == "" {
+				klog.Fatal("The x509 authentication requires the TLS certificate of the server and the client CA bundle.")
+			}
+			authenticators = append(authenticators, &auth.ClientCertAuthenticator{})
+		default:
+			klog.Fatalf("Unknown authentication method %q. Supported methods are token, oidc and x509.", method)
+		}
+	}
+
+	var authorizer auth.Authorizer
+	if *authorization {
+		authorizer = auth.NewSubjectAccessReviewAuthorizer(kubernetesClient.SubjectAccessReviewClient())
+	}
+	return interceptor.NewAuthInterceptor(authenticators, authorizer)
+}
+
+// newAuditInterceptor creates the interceptor recording the RPCs in the audit log file and the audit webhook, or returns
+// nil if auditing is disabled.
+func newAuditInterceptor() *interceptor.AuditInterceptor {
+	var sinks interceptor.MultiAuditSink
+	if *auditLogPath != "" {
+		sink, err := interceptor.NewFileAuditSink(*auditLogPath)
+		if err != nil {
+			klog.Fatalf("Failed to create the audit log: %v", err)
+		}
+		sinks = append(sinks, sink)
+	}
+	if *auditWebhookURL != "" {
+		sinks = append(sinks, interceptor.NewWebhookAuditSink(*auditWebhookURL, &http.Client{Timeout: 10 * time.Second}, 1000))
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	level, err := interceptor.ParseAuditLevel(*auditLevel)
+	if err != nil {
+		klog.Fatalf("Invalid audit level: %v", err)
+	}
+	methodLevels, err := interceptor.ParseAuditMethodLevels(*auditMethodLevels)
+	if err != nil {
+		klog.Fatalf("Invalid audit method levels: %v", err)
+	}
+	return interceptor.NewAuditInterceptor(sinks, interceptor.AuditPolicy{Level: level, MethodLevels: methodLevels})
+}
+
+// newServerTLSConfig returns the TLS configuration of the gRPC server, or nil if TLS is disabled. The client
+// certificates are verified if they are given, and callers without certificates are left to the other authentications.
+func newServerTLSConfig() *tls.Config {
+	if *tlsCertFile == "" {
+		return nil
+	}
+	certificate, err := tls.LoadX509KeyPair(*tlsCertFile, *tlsKeyFile)
+	if err != nil {
+		klog.Fatalf("Failed to load the TLS certificate of the gRPC server: %v", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if *clientCAFile != "" {
+		caBundle, err := os.ReadFile(*clientCAFile)
+		if err != nil {
+			klog.Fatalf("Failed to read the client CA bundle: %v", err)
+		}
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(caBundle) {
+			klog.Fatalf("No certificate found in the client CA bundle %s", *clientCAFile)
+		}
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config
+}
+
+// newGatewayTransportCredentials returns the credentials of the HTTP gateway to connect to the gRPC server. With TLS,
+// the gateway trusts the certificate of the server and connects with one of its host names.
+func newGatewayTransportCredentials() credentials.TransportCredentials {
+	if *tlsCertFile == "" {
+		return insecure.NewCredentials()
+	}
+	certificatePEM, err := os.ReadFile(*tlsCertFile)
+	if err != nil {
+		klog.Fatalf("Failed to read the TLS certificate of the gRPC server: %v", err)
+	}
+	block, _ := pem.Decode(certificatePEM)
+	if block == nil {
+		klog.Fatalf("No certificate found in %s", *tlsCertFile)
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		klog.Fatalf("Failed to parse the TLS certificate of the gRPC server: %v", err)
+	}
+	serverName := certificate.Subject.CommonName
+	if len(certificate.DNSNames) > 0 {
+		serverName = certificate.DNSNames[0]
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(certificate)
+	return credentials.NewTLS(&tls.Config{RootCAs: roots, ServerName: serverName, MinVersion: tls.VersionTLS12})
+}
+
+const eventStreamContentType = "text/event-stream"
+
+// eventStreamMarshaler marshals the responses as server-sent events, so that the watch RPCs can be consumed by a browser
+// EventSource. Each message, including errors, is sent as the JSON data of an event.
+type eventStreamMarshaler struct {
+	*runtime.JSONPb
+}
+
+func (m *eventStreamMarshaler) ContentType(_ interface{}) string {
+	return eventStreamContentType
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), data...), nil
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventStreamMarshaler(t *testing.T) {
+	marshaler := &eventStreamMarshaler{JSONPb: &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{UseEnumNumbers: true},
+	}}
+	assert.Equal(t, eventStreamContentType, marshaler.ContentType(nil))
+
+	// Each message is sent as the data of an event, and the events are separated by a blank line.
+	data, err := marshaler.Marshal(&api.WatchClustersResponse{
+		Type:            api.WatchEventType_BOOKMARK,
+		ResourceVersion: "42",
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "data: {"))
+	assert.NotContains(t, string(data), "\n")
+	assert.Contains(t, string(data), `"resourceVersion":"42"`)
+	assert.Equal(t, []byte("\n\n"), marshaler.Delimiter())
+
+	// The gateway writes each message of a stream followed by the delimiter.
+	responses := []proto.Message{
+		&api.WatchClustersResponse{Type: api.WatchEventType_ADDED, ResourceVersion: "1"},
+		&api.WatchClustersResponse{Type: api.WatchEventType_DELETED, ResourceVersion: "2"},
+	}
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/apis/v1/watch/clusters", nil)
+	ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{})
+	runtime.ForwardResponseStream(ctx, runtime.NewServeMux(), marshaler, recorder, request, func() (proto.Message, error) {
+		if len(responses) == 0 {
+			return nil, io.EOF
+		}
+		response := responses[0]
+		responses = responses[1:]
+		return response, nil
+	})
+	assert.Equal(t, eventStreamContentType, recorder.Header().Get("Content-Type"))
+	events := strings.Split(strings.TrimSuffix(recorder.Body.String(), "\n\n"), "\n\n")
+	require.Len(t, events, 2)
+	for i, event := range events {
+		assert.True(t, strings.HasPrefix(event, "data: {"), event)
+		assert.Contains(t, event, fmt.Sprintf(`"resourceVersion":"%d"`, i+1))
+	}
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authenticationclientv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
+)
+
+// Authenticator authenticates the caller of an RPC.
+type Authenticator interface {
+	// Authenticate returns the identity of the caller. It returns a nil identity without error if the RPC carries no
+	// credentials the authenticator understands, so that another authenticator can be tried.
+	Authenticate(ctx context.Context) (*Identity, error)
+}
+
+// UnionAuthenticator tries each authenticator in turn and returns the first identity.
+type UnionAuthenticator []Authenticator
+
+func (u UnionAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	var errs []string
+	for _, authenticator := range u {
+		identity, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if identity != nil {
+			return identity, nil
+		}
+	}
+	if len(errs) > 0 {
+		return nil, util.NewUnauthenticatedError(fmt.Errorf("%s", strings.Join(errs, "; ")), "The credentials of the request are invalid.")
+	}
+	return nil, util.NewUnauthenticatedError(fmt.Errorf("no credentials"), "The request has no credentials. Please provide a bearer token or a client certificate.")
+}
+
+// TokenReviewAuthenticator validates bearer tokens, e.g. service account tokens, with the Kubernetes TokenReview API.
+type TokenReviewAuthenticator struct {
+	client    authenticationclientv1.TokenReviewInterface
+	audiences []string
+}
+
+// NewTokenReviewAuthenticator creates a TokenReviewAuthenticator. The tokens must be issued for one of the audiences,
+// or for the audiences of the Kubernetes API server if there are none.
+func NewTokenReviewAuthenticator(client authenticationclientv1.TokenReviewInterface, audiences []string) *TokenReviewAuthenticator {
+	return &TokenReviewAuthenticator{client: client, audiences: audiences}
+}
+
+func (a *TokenReviewAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, nil
+	}
+	review, err := a.client.Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("token review failed: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return nil, fmt.Errorf("token is not authenticated by Kubernetes: %s", review.Status.Error)
+	}
+
+	extra := make(map[string][]string, len(review.Status.User.Extra))
+	for key, values := range review.Status.User.Extra {
+		extra[key] = values
+	}
+	return &Identity{
+		Username: review.Status.User.Username,
+		UID:      review.Status.User.UID,
+		Groups:   review.Status.User.Groups,
+		Extra:    extra,
+	}, nil
+}
+
+// ClientCertAuthenticator authenticates the callers by their verified TLS client certificates. Like Kubernetes, the
+// common name of the certificate is the username and its organizations are the groups.
+type ClientCertAuthenticator struct{}
+
+func (a *ClientCertAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil, nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	return identityFromCertificate(tlsInfo.State.VerifiedChains[0][0])
+}
+
+func identityFromCertificate(certificate *x509.Certificate) (*Identity, error) {
+	if certificate.Subject.CommonName == "" {
+		return nil, fmt.Errorf("client certificate has no common name")
+	}
+	return &Identity{
+		Username: certificate.Subject.CommonName,
+		Groups:   certificate.Subject.Organization,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authorizationclientv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+)
+
+// ResourceAttributes are the Kubernetes verb and resource an RPC operates on. An empty namespace means all namespaces.
+type ResourceAttributes struct {
+	Verb      string
+	Group     string
+	Resource  string
+	Namespace string
+	Name      string
+}
+
+// Authorizer checks whether the caller of an RPC may operate on the underlying Kubernetes resource.
+type Authorizer interface {
+	Authorize(ctx context.Context, identity *Identity, attributes ResourceAttributes) error
+}
+
+// SubjectAccessReviewAuthorizer authorizes the callers against their Kubernetes RBAC permissions with the
+// SubjectAccessReview API, as if they operated on the resources with kubectl.
+type SubjectAccessReviewAuthorizer struct {
+	client authorizationclientv1.SubjectAccessReviewInterface
+}
+
+func NewSubjectAccessReviewAuthorizer(client authorizationclientv1.SubjectAccessReviewInterface) *SubjectAccessReviewAuthorizer {
+	return &SubjectAccessReviewAuthorizer{client: client}
+}
+
+func (a *SubjectAccessReviewAuthorizer) Authorize(ctx context.Context, identity *Identity, attributes ResourceAttributes) error {
+	extra := make(map[string]authorizationv1.ExtraValue, len(identity.Extra))
+	for key, values := range identity.Extra {
+		extra[key] = values
+	}
+	review, err := a.client.Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   identity.Username,
+			UID:    identity.UID,
+			Groups: identity.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Verb:      attributes.Verb,
+				Group:     attributes.Group,
+				Resource:  attributes.Resource,
+				Namespace: attributes.Namespace,
+				Name:      attributes.Name,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to review the access of user %s", identity.Username)
+	}
+	if !review.Status.Allowed || review.Status.Denied {
+		scope := "in all namespaces"
+		if attributes.Namespace != "" {
+			scope = "in namespace " + attributes.Namespace
+		}
+		return util.NewPermissionDeniedError(fmt.Errorf("access review: %s", review.Status.Reason),
+			"User %s cannot %s %s %s.", identity.Username, attributes.Verb, attributes.Resource, scope)
+	}
+	return nil
+}
+
+const rayGroup = "ray.io"
+
+// The Kubernetes verbs and resources of the RPCs. The compute and image templates are stored in config maps, and the
+// job submissions are sent to the dashboard of a RayCluster.
+var rpcResourceAttributes = map[string]ResourceAttributes{
+	"/proto.ClusterService/CreateCluster":      {Verb: "create", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/UpdateCluster":      {Verb: "update", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/PatchCluster":       {Verb: "patch", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/GetCluster":         {Verb: "get", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/GetClusterManifest": {Verb: "get", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/ListCluster":        {Verb: "list", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/ListAllClusters":    {Verb: "list", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/WatchClusters":      {Verb: "watch", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.ClusterService/DeleteCluster":      {Verb: "delete", Group: rayGroup, Resource: "rayclusters"},
+
+	"/proto.RayJobService/CreateRayJob":      {Verb: "create", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/GetRayJob":         {Verb: "get", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/GetRayJobManifest": {Verb: "get", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/ListRayJobs":       {Verb: "list", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/ListAllRayJobs":    {Verb: "list", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/WatchRayJobs":      {Verb: "watch", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/DeleteRayJob":      {Verb: "delete", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/SuspendRayJob":     {Verb: "update", Group: rayGroup, Resource: "rayjobs"},
+	"/proto.RayJobService/ResumeRayJob":      {Verb: "update", Group: rayGroup, Resource: "rayjobs"},
+
+	"/proto.RayServeService/CreateRayService":      {Verb: "create", Group: rayGroup, Resource: "rayservices"},
+	"/proto.RayServeService/UpdateRayService":      {Verb: "update", Group: rayGroup, Resource: "rayservices"},
+	"/proto.RayServeService/GetRayService":         {Verb: "get", Group: rayGroup, Resource: "rayservices"},
+	"/proto.RayServeService/GetRayServiceManifest": {Verb: "get", Group: rayGroup, Resource: "rayservices"},
+	"/proto.RayServeService/ListRayServices":       {Verb: "list", Group: rayGroup, Resource: "rayservices"},
+	"/proto.RayServeService/ListAllRayServices":    {Verb: "list", Group: rayGroup, Resource: "rayservices"},
+	"/proto.RayServeService/WatchRayServices":      {Verb: "watch", Group: rayGroup, Resource: "rayservices"},
+	"/proto.RayServeService/DeleteRayService":      {Verb: "delete", Group: rayGroup, Resource: "rayservices"},
+
+	"/proto.ComputeTemplateService/CreateComputeTemplate":   {Verb: "create", Resource: "configmaps"},
+	"/proto.ComputeTemplateService/GetComputeTemplate":      {Verb: "get", Resource: "configmaps"},
+	"/proto.ComputeTemplateService/ListComputeTemplates":    {Verb: "list", Resource: "configmaps"},
+	"/proto.ComputeTemplateService/ListAllComputeTemplates": {Verb: "list", Resource: "configmaps"},
+	"/proto.ComputeTemplateService/DeleteComputeTemplate":   {Verb: "delete", Resource: "configmaps"},
+
+	"/proto.ImageTemplateService/CreateImageTemplate":   {Verb: "create", Resource: "configmaps"},
+	"/proto.ImageTemplateService/GetImageTemplate":      {Verb: "get", Resource: "configmaps"},
+	"/proto.ImageTemplateService/ListImageTemplates":    {Verb: "list", Resource: "configmaps"},
+	"/proto.ImageTemplateService/ListAllImageTemplates": {Verb: "list", Resource: "configmaps"},
+	"/proto.ImageTemplateService/DeleteImageTemplate":   {Verb: "delete", Resource: "configmaps"},
+
+	"/proto.RayJobSubmissionService/SubmitRayJob":   {Verb: "update", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.RayJobSubmissionService/GetJobDetails":  {Verb: "get", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.RayJobSubmissionService/GetJobLog":      {Verb: "get", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.RayJobSubmissionService/TailJobLog":     {Verb: "get", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.RayJobSubmissionService/ListJobDetails": {Verb: "get", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.RayJobSubmissionService/StopRayJob":     {Verb: "update", Group: rayGroup, Resource: "rayclusters"},
+	"/proto.RayJobSubmissionService/DeleteRayJob":   {Verb: "update", Group: rayGroup, Resource: "rayclusters"},
+}
+
+// GetResourceAttributes returns the verb and the resource the RPC operates on, with the namespace and the name given by
+// the request. It returns false if the RPC is unknown.
+func GetResourceAttributes(fullMethod string, request interface{}) (ResourceAttributes, bool) {
+	attributes, ok := rpcResourceAttributes[fullMethod]
+	if !ok {
+		return ResourceAttributes{}, false
+	}
+	if r, ok := request.(interface{ GetNamespace() string }); ok {
+		attributes.Namespace = r.GetNamespace()
+	}
+	switch r := request.(type) {
+	case interface{ GetName() string }:
+		attributes.Name = r.GetName()
+	case interface{ GetClustername() string }:
+		attributes.Name = r.GetClustername()
+	}
+	return attributes, true
+}
+
+// SetUser sets the user of the object created or updated by the request to the authenticated caller, instead of
+// trusting the user given in the request.
+func SetUser(request interface{}, username string) {
+	switch r := request.(type) {
+	case *api.CreateClusterRequest:
+		if r.Cluster != nil {
+			r.Cluster.User = username
+		}
+	case *api.UpdateClusterRequest:
+		if r.Cluster != nil {
+			r.Cluster.User = username
+		}
+	case *api.CreateRayJobRequest:
+		if r.Job != nil {
+			r.Job.User = username
+		}
+	case *api.CreateRayServiceRequest:
+		if r.Service != nil {
+			r.Service.User = username
+		}
+	case *api.UpdateRayServiceRequest:
+		if r.Service != nil {
+			r.Service.User = username
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGetResourceAttributes(t *testing.T) {
+	attributes, ok := GetResourceAttributes("/proto.ClusterService/DeleteCluster", &api.DeleteClusterRequest{Name: "cluster", Namespace: "ns"})
+	assert.True(t, ok)
+	assert.Equal(t, ResourceAttributes{Verb: "delete", Group: "ray.io", Resource: "rayclusters", Namespace: "ns", Name: "cluster"}, attributes)
+
+	attributes, ok = GetResourceAttributes("/proto.RayJobService/ListAllRayJobs", &api.ListAllRayJobsRequest{})
+	assert.True(t, ok)
+	assert.Equal(t, ResourceAttributes{Verb: "list", Group: "ray.io", Resource: "rayjobs"}, attributes)
+
+	attributes, ok = GetResourceAttributes("/proto.ComputeTemplateService/CreateComputeTemplate", &api.CreateComputeTemplateRequest{Namespace: "ns"})
+	assert.True(t, ok)
+	assert.Equal(t, ResourceAttributes{Verb: "create", Resource: "configmaps", Namespace: "ns"}, attributes)
+
+	attributes, ok = GetResourceAttributes("/proto.RayJobSubmissionService/SubmitRayJob", &api.SubmitRayJobRequest{Namespace: "ns", Clustername: "cluster"})
+	assert.True(t, ok)
+	assert.Equal(t, ResourceAttributes{Verb: "update", Group: "ray.io", Resource: "rayclusters", Namespace: "ns", Name: "cluster"}, attributes)
+
+	_, ok = GetResourceAttributes("/proto.UnknownService/Unknown", nil)
+	assert.False(t, ok)
+}
+
+func TestSetUser(t *testing.T) {
+	request := &api.CreateClusterRequest{Cluster: &api.Cluster{Name: "cluster", User: "spoofed"}}
+	SetUser(request, "alice")
+	assert.Equal(t, "alice", request.Cluster.User)
+
+	jobRequest := &api.CreateRayJobRequest{Job: &api.RayJob{}}
+	SetUser(jobRequest, "alice")
+	assert.Equal(t, "alice", jobRequest.Job.User)
+
+	// Requests without objects are left as is.
+	SetUser(&api.CreateRayServiceRequest{}, "alice")
+}
+
+func TestTokenReviewAuthenticator(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "valid" {
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authenticationv1.UserInfo{Username: "system:serviceaccount:ns:ui", Groups: []string{"system:serviceaccounts"}},
+			}
+		}
+		return true, review, nil
+	})
+	authenticator := UnionAuthenticator{NewTokenReviewAuthenticator(clientset.AuthenticationV1().TokenReviews(), nil)}
+
+	identity, err := authenticator.Authenticate(withBearerToken("valid"))
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:ns:ui", identity.Username)
+	assert.Equal(t, []string{"system:serviceaccounts"}, identity.Groups)
+
+	_, err = authenticator.Authenticate(withBearerToken("invalid"))
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.Unauthenticated))
+	_, err = authenticator.Authenticate(context.Background())
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.Unauthenticated))
+}
+
+func TestSubjectAccessReviewAuthorizer(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == "alice" && attributes.Namespace == "team-a" && attributes.Resource == "rayclusters"
+		return true, review, nil
+	})
+	authorizer := NewSubjectAccessReviewAuthorizer(clientset.AuthorizationV1().SubjectAccessReviews())
+	alice := &Identity{Username: "alice"}
+
+	err := authorizer.Authorize(context.Background(), alice, ResourceAttributes{Verb: "create", Group: "ray.io", Resource: "rayclusters", Namespace: "team-a"})
+	assert.NoError(t, err)
+
+	err = authorizer.Authorize(context.Background(), alice, ResourceAttributes{Verb: "create", Group: "ray.io", Resource: "rayclusters", Namespace: "team-b"})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+
+	err = authorizer.Authorize(context.Background(), alice, ResourceAttributes{Verb: "list", Group: "ray.io", Resource: "rayclusters"})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+}
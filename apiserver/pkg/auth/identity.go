@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Identity is the authenticated caller of an RPC, in the terms of Kubernetes user info.
+type Identity struct {
+	Username string
+	UID      string
+	Groups   []string
+	Extra    map[string][]string
+}
+
+type identityKey struct{}
+
+type identityHolderKey struct{}
+
+// identityHolder receives the identity of the caller once it is authenticated.
+type identityHolder struct {
+	identity *Identity
+}
+
+// NewContext returns a context carrying the identity of the caller. The identity is also published to the holder of
+// the context, if any.
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	if holder, ok := ctx.Value(identityHolderKey{}).(*identityHolder); ok {
+		holder.identity = identity
+	}
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// WithIdentityHolder returns a context holding the identity of the caller once the RPC is authenticated down the
+// interceptor chain, so that the interceptors chained before the authentication, e.g. the audit, know the caller
+// even if the RPC is then denied.
+func WithIdentityHolder(ctx context.Context) context.Context {
+	return context.WithValue(ctx, identityHolderKey{}, &identityHolder{})
+}
+
+// FromContext returns the identity of the caller, if the RPC was authenticated.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	if identity, ok := ctx.Value(identityKey{}).(*Identity); ok {
+		return identity, true
+	}
+	if holder, ok := ctx.Value(identityHolderKey{}).(*identityHolder); ok && holder.identity != nil {
+		return holder.identity, true
+	}
+	return nil, false
+}
+
+// bearerToken returns the bearer token of the `authorization` metadata, which the HTTP gateway forwards from the
+// `Authorization` header. It returns an empty string if there is no bearer token.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// OIDCAuthenticator validates bearer tokens which are JWTs issued by an OpenID Connect provider. Only the tokens of the
+// issuer are handled; the other bearer tokens are left to the other authenticators.
+type OIDCAuthenticator struct {
+	issuerURL     string
+	usernameClaim string
+	groupsClaim   string
+	verifier      *oidc.IDTokenVerifier
+}
+
+// NewOIDCAuthenticator creates an OIDCAuthenticator from the discovery document of the issuer. The tokens must have the
+// client ID as audience. The username and the groups of the callers are read from the given claims. The signing keys
+// of the issuer are fetched with the context, so it must outlive the authenticator.
+func NewOIDCAuthenticator(ctx context.Context, issuerURL, clientID, usernameClaim, groupsClaim string) (*OIDCAuthenticator, error) {
+	provider, err := oidc.NewProvider(ctx, issuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC issuer %s: %w", issuerURL, err)
+	}
+	var discovery struct {
+		JWKSURI           string   `json:"jwks_uri"`
+		SigningAlgorithms []string `json:"id_token_signing_alg_values_supported"`
+	}
+	if err := provider.Claims(&discovery); err != nil {
+		return nil, fmt.Errorf("invalid discovery document of OIDC issuer %s: %w", issuerURL, err)
+	}
+	return &OIDCAuthenticator{
+		issuerURL:     issuerURL,
+		usernameClaim: usernameClaim,
+		groupsClaim:   groupsClaim,
+		verifier: oidc.NewVerifier(issuerURL, oidc.NewRemoteKeySet(ctx, discovery.JWKSURI), &oidc.Config{
+			ClientID:             clientID,
+			SupportedSigningAlgs: discovery.SigningAlgorithms,
+		}),
+	}, nil
+}
+
+func (a *OIDCAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	token := bearerToken(ctx)
+	if tokenIssuer(token) != a.issuerURL {
+		return nil, nil
+	}
+	idToken, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OIDC token: %w", err)
+	}
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("invalid OIDC token claims: %w", err)
+	}
+
+	username, _ := claims[a.usernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("OIDC token has no %s claim", a.usernameClaim)
+	}
+	identity := &Identity{Username: username, UID: idToken.Subject}
+	switch groups := claims[a.groupsClaim].(type) {
+	case string:
+		identity.Groups = []string{groups}
+	case []interface{}:
+		for _, group := range groups {
+			if group, ok := group.(string); ok {
+				identity.Groups = append(identity.Groups, group)
+			}
+		}
+	}
+	return identity, nil
+}
+
+// tokenIssuer returns the unverified issuer of a JWT, or an empty string if the token is not a JWT.
+func tokenIssuer(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Issuer
+}
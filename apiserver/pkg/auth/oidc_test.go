@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func newOIDCIssuer(t *testing.T, key *rsa.PublicKey) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"issuer":                                server.URL,
+				"jwks_uri":                              server.URL + "/keys",
+				"id_token_signing_alg_values_supported": []string{"RS256", "ES256"},
+			})
+		case "/keys":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{{
+					"kty": "RSA",
+					"kid": "key-1",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func signJWT(t *testing.T, key *rsa.PrivateKey, keyID string, claims map[string]interface{}) string {
+	return signJWTWithAlgorithm(t, key, "RS256", keyID, claims)
+}
+
+// signJWTWithAlgorithm signs the JWT with RS256 whatever the algorithm set in its header.
+func signJWTWithAlgorithm(t *testing.T, key *rsa.PrivateKey, algorithm string, keyID string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "kid": keyID, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signedContent := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signedContent))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signedContent + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func withBearerToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := newOIDCIssuer(t, &key.PublicKey)
+	authenticator, err := NewOIDCAuthenticator(context.Background(), issuer.URL, "kuberay", "email", "groups")
+	require.NoError(t, err)
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":    issuer.URL,
+			"aud":    []string{"other", "kuberay"},
+			"sub":    "1234",
+			"email":  "alice@example.com",
+			"groups": []string{"ray-users"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	identity, err := authenticator.Authenticate(withBearerToken(signJWT(t, key, "key-1", validClaims())))
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{Username: "alice@example.com", UID: "1234", Groups: []string{"ray-users"}}, identity)
+
+	// Requests without OIDC tokens of the issuer are left to the other authenticators.
+	identity, err = authenticator.Authenticate(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, identity)
+	identity, err = authenticator.Authenticate(withBearerToken("opaque-service-account-token"))
+	assert.NoError(t, err)
+	assert.Nil(t, identity)
+	claims := validClaims()
+	claims["iss"] = "https://other.example.com"
+	identity, err = authenticator.Authenticate(withBearerToken(signJWT(t, key, "key-1", claims)))
+	assert.NoError(t, err)
+	assert.Nil(t, identity)
+
+	claims = validClaims()
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	_, err = authenticator.Authenticate(withBearerToken(signJWT(t, key, "key-1", claims)))
+	assert.ErrorContains(t, err, "expired")
+
+	claims = validClaims()
+	claims["aud"] = "other"
+	_, err = authenticator.Authenticate(withBearerToken(signJWT(t, key, "key-1", claims)))
+	assert.ErrorContains(t, err, "expected audience")
+
+	_, err = authenticator.Authenticate(withBearerToken(signJWT(t, otherKey, "key-1", validClaims())))
+	assert.ErrorContains(t, err, "failed to verify signature")
+
+	// The signing algorithm must be one the issuer supports and must match the key.
+	_, err = authenticator.Authenticate(withBearerToken(signJWTWithAlgorithm(t, key, "ES256", "key-1", validClaims())))
+	assert.ErrorContains(t, err, "invalid OIDC token")
+	_, err = authenticator.Authenticate(withBearerToken(signJWTWithAlgorithm(t, key, "none", "key-1", validClaims())))
+	assert.ErrorContains(t, err, "invalid OIDC token")
+
+	// The issuer must serve its discovery document.
+	_, err = NewOIDCAuthenticator(context.Background(), issuer.URL+"/other", "kuberay", "email", "groups")
+	assert.Error(t, err)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	"k8s.io/client-go/kubernetes"
+	authenticationv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
@@ -16,10 +18,14 @@ type KubernetesClientInterface interface {
 	ConfigMapClient(namespace string) v1.ConfigMapInterface
 	NamespaceClient() v1.NamespaceInterface
 	EventsClient(namespace string) v1.EventInterface
+	TokenReviewClient() authenticationv1.TokenReviewInterface
+	SubjectAccessReviewClient() authorizationv1.SubjectAccessReviewInterface
 }
 
 type KubernetesClient struct {
-	coreV1Client v1.CoreV1Interface
+	coreV1Client           v1.CoreV1Interface
+	authenticationV1Client authenticationv1.AuthenticationV1Interface
+	authorizationV1Client  authorizationv1.AuthorizationV1Interface
 }
 
 func (c *KubernetesClient) PodClient(namespace string) v1.PodInterface {
@@ -38,6 +44,14 @@ func (c *KubernetesClient) NamespaceClient() v1.NamespaceInterface {
 	return c.coreV1Client.Namespaces()
 }
 
+func (c *KubernetesClient) TokenReviewClient() authenticationv1.TokenReviewInterface {
+	return c.authenticationV1Client.TokenReviews()
+}
+
+func (c *KubernetesClient) SubjectAccessReviewClient() authorizationv1.SubjectAccessReviewInterface {
+	return c.authorizationV1Client.SubjectAccessReviews()
+}
+
 // CreateKubernetesCoreOrFatal creates a new client for the Kubernetes pod.
 func CreateKubernetesCoreOrFatal(initConnectionTimeout time.Duration, options util.ClientOptions) KubernetesClientInterface {
 	cfg, err := config.GetConfig()
@@ -51,5 +65,9 @@ func CreateKubernetesCoreOrFatal(initConnectionTimeout time.Duration, options ut
 	if err != nil {
 		klog.Fatalf("Failed to create pod client. Error: %v", err)
 	}
-	return &KubernetesClient{clientSet.CoreV1()}
+	return &KubernetesClient{
+		coreV1Client:           clientSet.CoreV1(),
+		authenticationV1Client: clientSet.AuthenticationV1(),
+		authorizationV1Client:  clientSet.AuthorizationV1(),
+	}
 }
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	api "github.com/ray-project/kuberay/proto/go_client"
 	rpcStatus "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type KuberayAPIServerClient struct {
@@ -137,7 +141,8 @@ func (krc *KuberayAPIServerClient) GetAllComputeTemplates() (*api.ListAllCompute
 
 // GetAllComputeTemplatesInNamespace Finds all compute templates in a given namespace.
 func (krc *KuberayAPIServerClient) GetAllComputeTemplatesInNamespace(request *api.ListComputeTemplatesRequest) (*api.ListComputeTemplatesResponse, *rpcStatus.Status, error) {
-	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/compute_templates"
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/compute_templates" +
+		encodeListQuery(request.PageToken, request.PageSize, request.LabelSelector, "", "", "", request.SortBy)
 	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
@@ -156,9 +161,107 @@ func (krc *KuberayAPIServerClient) GetAllComputeTemplatesInNamespace(request *ap
 	return response, nil, nil
 }
 
+// CreateImageTemplate creates a new image template.
+func (krc *KuberayAPIServerClient) CreateImageTemplate(request *api.CreateImageTemplateRequest) (*api.ImageTemplate, *rpcStatus.Status, error) {
+	createURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/image_templates"
+
+	bytez, err := krc.marshaler.Marshal(request.ImageTemplate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal api.ImageTemplate to JSON: %w", err)
+	}
+
+	httpRequest, err := krc.createHttpRequest("POST", createURL, bytes.NewReader(bytez))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", createURL, err)
+	}
+
+	httpRequest.Header.Add("Accept", "application/json")
+	httpRequest.Header.Add("Content-Type", "application/json")
+
+	bodyBytes, status, err := krc.executeRequest(httpRequest, createURL)
+	if err != nil {
+		return nil, status, err
+	}
+	imageTemplate := &api.ImageTemplate{}
+	if err := krc.unmarshaler.Unmarshal(bodyBytes, imageTemplate); err != nil {
+		return nil, status, nil
+	}
+
+	return imageTemplate, nil, nil
+}
+
+// DeleteImageTemplate deletes an image template.
+func (krc *KuberayAPIServerClient) DeleteImageTemplate(request *api.DeleteImageTemplateRequest) (*rpcStatus.Status, error) {
+	deleteURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/image_templates/" + request.Name
+	return krc.doDelete(deleteURL)
+}
+
+// GetImageTemplate finds a specific image template by its name and namespace.
+func (krc *KuberayAPIServerClient) GetImageTemplate(request *api.GetImageTemplateRequest) (*api.ImageTemplate, *rpcStatus.Status, error) {
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/image_templates/" + request.Name
+	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
+	}
+
+	httpRequest.Header.Add("Accept", "application/json")
+
+	bodyBytes, status, err := krc.executeRequest(httpRequest, getURL)
+	if err != nil {
+		return nil, status, err
+	}
+	imageTemplate := &api.ImageTemplate{}
+	if err := krc.unmarshaler.Unmarshal(bodyBytes, imageTemplate); err != nil {
+		return nil, status, nil
+	}
+	return imageTemplate, nil, nil
+}
+
+// GetAllImageTemplates finds all image templates in all namespaces.
+func (krc *KuberayAPIServerClient) GetAllImageTemplates() (*api.ListAllImageTemplatesResponse, *rpcStatus.Status, error) {
+	getURL := krc.baseURL + "/apis/v1/image_templates"
+	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
+	}
+
+	httpRequest.Header.Add("Accept", "application/json")
+
+	bodyBytes, status, err := krc.executeRequest(httpRequest, getURL)
+	if err != nil {
+		return nil, status, err
+	}
+	response := &api.ListAllImageTemplatesResponse{}
+	if err := krc.unmarshaler.Unmarshal(bodyBytes, response); err != nil {
+		return nil, status, nil
+	}
+	return response, nil, nil
+}
+
+// GetAllImageTemplatesInNamespace finds all image templates in a given namespace.
+func (krc *KuberayAPIServerClient) GetAllImageTemplatesInNamespace(request *api.ListImageTemplatesRequest) (*api.ListImageTemplatesResponse, *rpcStatus.Status, error) {
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/image_templates"
+	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
+	}
+
+	httpRequest.Header.Add("Accept", "application/json")
+
+	bodyBytes, status, err := krc.executeRequest(httpRequest, getURL)
+	if err != nil {
+		return nil, status, err
+	}
+	response := &api.ListImageTemplatesResponse{}
+	if err := krc.unmarshaler.Unmarshal(bodyBytes, response); err != nil {
+		return nil, status, nil
+	}
+	return response, nil, nil
+}
+
 // CreateCluster creates a new cluster.
 func (krc *KuberayAPIServerClient) CreateCluster(request *api.CreateClusterRequest) (*api.Cluster, *rpcStatus.Status, error) {
-	createURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters"
+	createURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters" + encodeDryRunQuery(request.DryRun)
 
 	bytez, err := krc.marshaler.Marshal(request.Cluster)
 	if err != nil {
@@ -184,6 +287,53 @@ func (krc *KuberayAPIServerClient) CreateCluster(request *api.CreateClusterReque
 	return cluster, nil, nil
 }
 
+// UpdateCluster replaces a cluster with the given one.
+func (krc *KuberayAPIServerClient) UpdateCluster(request *api.UpdateClusterRequest) (*api.Cluster, *rpcStatus.Status, error) {
+	updateURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters/" + request.Name + encodeDryRunQuery(request.DryRun)
+	return krc.doUpdateCluster("PUT", updateURL, request.Cluster)
+}
+
+// PatchCluster updates the fields of a cluster in the update mask.
+func (krc *KuberayAPIServerClient) PatchCluster(request *api.PatchClusterRequest) (*api.Cluster, *rpcStatus.Status, error) {
+	patchURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters/" + request.Name
+	query := url.Values{}
+	if paths := request.UpdateMask.GetPaths(); len(paths) > 0 {
+		query.Set("update_mask", strings.Join(paths, ","))
+	}
+	if request.DryRun {
+		query.Set("dry_run", "true")
+	}
+	if len(query) > 0 {
+		patchURL += "?" + query.Encode()
+	}
+	return krc.doUpdateCluster("PATCH", patchURL, request.Cluster)
+}
+
+func (krc *KuberayAPIServerClient) doUpdateCluster(method string, updateURL string, apiCluster *api.Cluster) (*api.Cluster, *rpcStatus.Status, error) {
+	bytez, err := krc.marshaler.Marshal(apiCluster)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal api.Cluster to JSON: %w", err)
+	}
+
+	httpRequest, err := krc.createHttpRequest(method, updateURL, bytes.NewReader(bytez))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", updateURL, err)
+	}
+
+	httpRequest.Header.Add("Accept", "application/json")
+	httpRequest.Header.Add("Content-Type", "application/json")
+
+	bodyBytes, status, err := krc.executeRequest(httpRequest, updateURL)
+	if err != nil {
+		return nil, status, err
+	}
+	cluster := &api.Cluster{}
+	if err := krc.unmarshaler.Unmarshal(bodyBytes, cluster); err != nil {
+		return nil, status, nil
+	}
+	return cluster, nil, nil
+}
+
 // DeleteCluster deletes a cluster
 func (krc *KuberayAPIServerClient) DeleteCluster(request *api.DeleteClusterRequest) (*rpcStatus.Status, error) {
 	deleteURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters/" + request.Name
@@ -211,9 +361,20 @@ func (krc *KuberayAPIServerClient) GetCluster(request *api.GetClusterRequest) (*
 	return cluster, nil, nil
 }
 
+// GetClusterManifest returns the RayCluster of a cluster as YAML.
+func (krc *KuberayAPIServerClient) GetClusterManifest(request *api.GetClusterManifestRequest) (*api.GetClusterManifestResponse, *rpcStatus.Status, error) {
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters/" + request.Name + "/manifest"
+	response := &api.GetClusterManifestResponse{}
+	if status, err := krc.doGetManifest(getURL, response); err != nil || status != nil {
+		return nil, status, err
+	}
+	return response, nil, nil
+}
+
 // ListCluster finds all clusters in a given namespace.
 func (krc *KuberayAPIServerClient) ListClusters(request *api.ListClustersRequest) (*api.ListClustersResponse, *rpcStatus.Status, error) {
-	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters"
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/clusters" +
+		encodeListQuery(request.PageToken, request.PageSize, request.LabelSelector, request.User, "cluster_state", request.ClusterState, request.SortBy)
 	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
@@ -255,7 +416,7 @@ func (krc *KuberayAPIServerClient) ListAllClusters() (*api.ListAllClustersRespon
 
 // CreateRayJob creates a new job.
 func (krc *KuberayAPIServerClient) CreateRayJob(request *api.CreateRayJobRequest) (*api.RayJob, *rpcStatus.Status, error) {
-	createURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs"
+	createURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs" + encodeDryRunQuery(request.DryRun)
 	bytez, err := krc.marshaler.Marshal(request.Job)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal api.Cluster to JSON: %w", err)
@@ -303,7 +464,8 @@ func (krc *KuberayAPIServerClient) GetRayJob(request *api.GetRayJobRequest) (*ap
 
 // Finds all job in a given namespace.
 func (krc *KuberayAPIServerClient) ListRayJobs(request *api.ListRayJobsRequest) (*api.ListRayJobsResponse, *rpcStatus.Status, error) {
-	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs"
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs" +
+		encodeListQuery(request.PageToken, request.PageSize, request.LabelSelector, request.User, "job_status", request.JobStatus, request.SortBy)
 	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
@@ -343,6 +505,45 @@ func (krc *KuberayAPIServerClient) ListAllRayJobs() (*api.ListAllRayJobsResponse
 	return response, nil, nil
 }
 
+// GetRayJobManifest returns the RayJob of a job as YAML.
+func (krc *KuberayAPIServerClient) GetRayJobManifest(request *api.GetRayJobManifestRequest) (*api.GetRayJobManifestResponse, *rpcStatus.Status, error) {
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs/" + request.Name + "/manifest"
+	response := &api.GetRayJobManifestResponse{}
+	if status, err := krc.doGetManifest(getURL, response); err != nil || status != nil {
+		return nil, status, err
+	}
+	return response, nil, nil
+}
+
+// SuspendRayJob suspends a job by its name and namespace.
+func (krc *KuberayAPIServerClient) SuspendRayJob(request *api.SuspendRayJobRequest) (*api.RayJob, *rpcStatus.Status, error) {
+	return krc.doRayJobAction(krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs/" + request.Name + "/suspend")
+}
+
+// ResumeRayJob resumes a suspended job by its name and namespace.
+func (krc *KuberayAPIServerClient) ResumeRayJob(request *api.ResumeRayJobRequest) (*api.RayJob, *rpcStatus.Status, error) {
+	return krc.doRayJobAction(krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs/" + request.Name + "/resume")
+}
+
+func (krc *KuberayAPIServerClient) doRayJobAction(actionURL string) (*api.RayJob, *rpcStatus.Status, error) {
+	httpRequest, err := krc.createHttpRequest("POST", actionURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", actionURL, err)
+	}
+
+	httpRequest.Header.Add("Accept", "application/json")
+
+	bodyBytes, status, err := krc.executeRequest(httpRequest, actionURL)
+	if err != nil {
+		return nil, status, err
+	}
+	rayJob := &api.RayJob{}
+	if err := krc.unmarshaler.Unmarshal(bodyBytes, rayJob); err != nil {
+		return nil, status, nil
+	}
+	return rayJob, nil, nil
+}
+
 // Deletes a job by its name and namespace.
 func (krc *KuberayAPIServerClient) DeleteRayJob(request *api.DeleteRayJobRequest) (*rpcStatus.Status, error) {
 	deleteURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/jobs/" + request.Name
@@ -351,7 +552,7 @@ func (krc *KuberayAPIServerClient) DeleteRayJob(request *api.DeleteRayJobRequest
 
 // CreateRayService create a new ray serve.
 func (krc *KuberayAPIServerClient) CreateRayService(request *api.CreateRayServiceRequest) (*api.RayService, *rpcStatus.Status, error) {
-	createURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services"
+	createURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services" + encodeDryRunQuery(request.DryRun)
 	bytez, err := krc.marshaler.Marshal(request.Service)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal api.Cluster to JSON: %w", err)
@@ -378,7 +579,7 @@ func (krc *KuberayAPIServerClient) CreateRayService(request *api.CreateRayServic
 
 // UpdateRayService updates a ray serve service.
 func (krc *KuberayAPIServerClient) UpdateRayService(request *api.UpdateRayServiceRequest) (*api.RayService, *rpcStatus.Status, error) {
-	updateURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services/" + request.Name
+	updateURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services/" + request.Name + encodeDryRunQuery(request.DryRun)
 	bytez, err := krc.marshaler.Marshal(request.Service)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal api.Cluster to JSON: %w", err)
@@ -426,7 +627,8 @@ func (krc *KuberayAPIServerClient) GetRayService(request *api.GetRayServiceReque
 
 // Finds all ray services in a given namespace. Supports pagination, and sorting on certain fields.
 func (krc *KuberayAPIServerClient) ListRayServices(request *api.ListRayServicesRequest) (*api.ListRayServicesResponse, *rpcStatus.Status, error) {
-	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services"
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services" +
+		encodeListQuery(request.PageToken, request.PageSize, request.LabelSelector, request.User, "service_status", request.ServiceStatus, request.SortBy)
 	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
@@ -466,6 +668,35 @@ func (krc *KuberayAPIServerClient) ListAllRayServices() (*api.ListAllRayServices
 	return response, nil, nil
 }
 
+// GetRayServiceManifest returns the RayService of a ray service as YAML.
+func (krc *KuberayAPIServerClient) GetRayServiceManifest(request *api.GetRayServiceManifestRequest) (*api.GetRayServiceManifestResponse, *rpcStatus.Status, error) {
+	getURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services/" + request.Name + "/manifest"
+	response := &api.GetRayServiceManifestResponse{}
+	if status, err := krc.doGetManifest(getURL, response); err != nil || status != nil {
+		return nil, status, err
+	}
+	return response, nil, nil
+}
+
+// doGetManifest gets the manifest of an object from the URL into the response.
+func (krc *KuberayAPIServerClient) doGetManifest(getURL string, response proto.Message) (*rpcStatus.Status, error) {
+	httpRequest, err := krc.createHttpRequest("GET", getURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http request for url '%s': %w", getURL, err)
+	}
+
+	httpRequest.Header.Add("Accept", "application/json")
+
+	bodyBytes, status, err := krc.executeRequest(httpRequest, getURL)
+	if err != nil {
+		return status, err
+	}
+	if err := krc.unmarshaler.Unmarshal(bodyBytes, response); err != nil {
+		return status, nil
+	}
+	return nil, nil
+}
+
 // DeleteRayService deletes a ray service by its name and namespace
 func (krc *KuberayAPIServerClient) DeleteRayService(request *api.DeleteRayServiceRequest) (*rpcStatus.Status, error) {
 	deleteURL := krc.baseURL + "/apis/v1/namespaces/" + request.Namespace + "/services/" + request.Name
@@ -635,3 +866,39 @@ func (krc *KuberayAPIServerClient) createHttpRequest(method string, endPoint str
 	}
 	return req, nil
 }
+
+// encodeDryRunQuery returns the query of the dry_run parameter of a create or update request.
+func encodeDryRunQuery(dryRun bool) string {
+	if dryRun {
+		return "?dry_run=true"
+	}
+	return ""
+}
+
+// encodeListQuery encodes the pagination, filtering and sorting parameters of a List request as a URL query. The state
+// filter is encoded under the given parameter name.
+func encodeListQuery(pageToken string, pageSize int32, labelSelector, user, stateParam, state, sortBy string) string {
+	query := url.Values{}
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+	if pageSize > 0 {
+		query.Set("page_size", strconv.Itoa(int(pageSize)))
+	}
+	if labelSelector != "" {
+		query.Set("label_selector", labelSelector)
+	}
+	if user != "" {
+		query.Set("user", user)
+	}
+	if state != "" {
+		query.Set(stateParam, state)
+	}
+	if sortBy != "" {
+		query.Set("sort_by", sortBy)
+	}
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
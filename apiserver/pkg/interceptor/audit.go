@@ -0,0 +1,305 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	klog "k8s.io/klog/v2"
+)
+
+// AuditLevel is the verbosity of the audit events of an RPC, like the levels of the Kubernetes audit policies.
+type AuditLevel string
+
+const (
+	// AuditLevelNone doesn't record the RPC.
+	AuditLevelNone AuditLevel = "None"
+	// AuditLevelMetadata records the caller, the method, the target resource, the result code and the latency.
+	AuditLevelMetadata AuditLevel = "Metadata"
+	// AuditLevelRequest also records the request payload, with the secrets redacted.
+	AuditLevelRequest AuditLevel = "Request"
+)
+
+// ParseAuditLevel parses an audit level, matched case-insensitively.
+func ParseAuditLevel(value string) (AuditLevel, error) {
+	for _, level := range []AuditLevel{AuditLevelNone, AuditLevelMetadata, AuditLevelRequest} {
+		if strings.EqualFold(value, string(level)) {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("unknown audit level %q, supported levels are None, Metadata and Request", value)
+}
+
+// ParseAuditMethodLevels parses comma separated `<full method>=<level>` pairs, e.g.
+// `/proto.ClusterService/GetCluster=Metadata,/proto.ClusterService/CreateCluster=Request`.
+func ParseAuditMethodLevels(value string) (map[string]AuditLevel, error) {
+	levels := map[string]AuditLevel{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		method, levelName, found := strings.Cut(pair, "=")
+		if !found || !strings.HasPrefix(method, "/") {
+			return nil, fmt.Errorf("invalid audit method level %q, expected <full method>=<level>", pair)
+		}
+		level, err := ParseAuditLevel(levelName)
+		if err != nil {
+			return nil, err
+		}
+		levels[method] = level
+	}
+	return levels, nil
+}
+
+// AuditPolicy decides the audit level of the RPCs.
+type AuditPolicy struct {
+	// Level is the audit level of the RPCs modifying resources. The read-only RPCs, which get, list or watch
+	// resources, are not audited by default.
+	Level AuditLevel
+	// MethodLevels overrides the audit level of RPCs by their full method, e.g. /proto.ClusterService/GetCluster.
+	MethodLevels map[string]AuditLevel
+}
+
+func (p *AuditPolicy) levelOf(fullMethod string) AuditLevel {
+	if level, ok := p.MethodLevels[fullMethod]; ok {
+		return level
+	}
+	attributes, ok := auth.GetResourceAttributes(fullMethod, nil)
+	if !ok {
+		return AuditLevelNone
+	}
+	switch attributes.Verb {
+	case "get", "list", "watch":
+		return AuditLevelNone
+	}
+	return p.Level
+}
+
+// AuditEvent is the record of an RPC.
+type AuditEvent struct {
+	Timestamp     time.Time       `json:"timestamp"`
+	Level         AuditLevel      `json:"level"`
+	User          string          `json:"user,omitempty"`
+	Groups        []string        `json:"groups,omitempty"`
+	SourceAddress string          `json:"sourceAddress,omitempty"`
+	Method        string          `json:"method"`
+	Verb          string          `json:"verb,omitempty"`
+	Resource      string          `json:"resource,omitempty"`
+	Namespace     string          `json:"namespace,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Request       json.RawMessage `json:"request,omitempty"`
+	Code          string          `json:"code"`
+	Error         string          `json:"error,omitempty"`
+	LatencyMs     float64         `json:"latencyMs"`
+}
+
+// AuditInterceptor records who called which RPC on which resource, and with which result, in an AuditSink. It must
+// be chained before the AuthInterceptor so that the RPCs denied by the authentication or the authorization are
+// recorded too; the caller is published back through an identity holder in the context.
+type AuditInterceptor struct {
+	sink   AuditSink
+	policy AuditPolicy
+}
+
+// NewAuditInterceptor creates an AuditInterceptor writing the events of the RPCs audited by the policy to the sink.
+func NewAuditInterceptor(sink AuditSink, policy AuditPolicy) *AuditInterceptor {
+	return &AuditInterceptor{sink: sink, policy: policy}
+}
+
+// Unary implements UnaryServerInterceptor.
+func (i *AuditInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	level := i.policy.levelOf(info.FullMethod)
+	if level == AuditLevelNone {
+		return handler(ctx, req)
+	}
+	start := time.Now()
+	ctx = auth.WithIdentityHolder(ctx)
+	// The request is recorded before the handler, which may modify it.
+	event := newAuditEvent(level, info.FullMethod, req)
+	resp, err := handler(ctx, req)
+	i.record(ctx, event, start, err)
+	return resp, err
+}
+
+// Stream implements StreamServerInterceptor. The target resource and the payload are the ones of the first request.
+func (i *AuditInterceptor) Stream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	level := i.policy.levelOf(info.FullMethod)
+	if level == AuditLevelNone {
+		return handler(srv, stream)
+	}
+	start := time.Now()
+	audited := &auditedStream{ServerStream: stream, ctx: auth.WithIdentityHolder(stream.Context()), level: level, fullMethod: info.FullMethod}
+	err := handler(srv, audited)
+	if audited.event == nil {
+		audited.event = newAuditEvent(level, info.FullMethod, nil)
+	}
+	i.record(audited.Context(), audited.event, start, err)
+	return err
+}
+
+func (i *AuditInterceptor) record(ctx context.Context, event *AuditEvent, start time.Time, err error) {
+	if identity, ok := auth.FromContext(ctx); ok {
+		event.User = identity.Username
+		event.Groups = identity.Groups
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		event.SourceAddress = p.Addr.String()
+	}
+	event.Code = status.Code(err).String()
+	if err != nil {
+		event.Error = status.Convert(err).Message()
+	}
+	event.LatencyMs = float64(time.Since(start).Microseconds()) / 1000
+	if err := i.sink.Write(event); err != nil {
+		klog.Errorf("Failed to write the audit event of %s: %v", event.Method, err)
+	}
+}
+
+// auditedStream records the first request of a streaming RPC.
+type auditedStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	level      AuditLevel
+	fullMethod string
+	event      *AuditEvent
+}
+
+func (s *auditedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.event == nil {
+		s.event = newAuditEvent(s.level, s.fullMethod, m)
+	}
+	return nil
+}
+
+func newAuditEvent(level AuditLevel, fullMethod string, req interface{}) *AuditEvent {
+	event := &AuditEvent{
+		Timestamp: time.Now().UTC(),
+		Level:     level,
+		Method:    fullMethod,
+	}
+	if attributes, ok := auth.GetResourceAttributes(fullMethod, req); ok {
+		event.Verb = attributes.Verb
+		event.Resource = attributes.Resource
+		event.Namespace = attributes.Namespace
+		event.Name = attributes.Name
+	}
+	message, ok := req.(proto.Message)
+	if !ok {
+		return event
+	}
+	if event.Name == "" {
+		event.Name = requestObjectName(message)
+	}
+	if level == AuditLevelRequest {
+		redacted := proto.Clone(message)
+		redact(redacted.ProtoReflect())
+		payload, err := protojson.Marshal(redacted)
+		if err != nil {
+			klog.Errorf("Failed to marshal the request of %s for the audit event: %v", fullMethod, err)
+		} else {
+			event.Request = payload
+		}
+	}
+	return event
+}
+
+// requestObjectName returns the name of the object held by a create request, e.g. the cluster of a CreateClusterRequest.
+func requestObjectName(message proto.Message) string {
+	var name string
+	message.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return true
+		}
+		nameField := fd.Message().Fields().ByName("name")
+		if nameField == nil || nameField.Kind() != protoreflect.StringKind {
+			return true
+		}
+		name = v.Message().Get(nameField).String()
+		return name == ""
+	})
+	return name
+}
+
+// The placeholder of the redacted values.
+const redactedValue = "[REDACTED]"
+
+// The fields holding environment variables, whose values often are credentials, and the runtime environments and
+// Serve configs, which can define environment variables. Their values are always redacted, in the create and the
+// update requests alike.
+var redactedFields = map[protoreflect.FullName]bool{
+	"proto.EnvironmentVariables.values":         true,
+	"proto.ImageTemplate.environment_variables": true,
+	"proto.RuntimeEnvVar.value":                 true,
+	"proto.RayJob.runtime_env":                  true,
+	"proto.RayJobSubmission.runtime_env":        true,
+	"proto.RayService.serve_config_V2":          true,
+}
+
+// The names of the fields and map keys whose values are redacted as well.
+var sensitiveNames = []string{"password", "secret", "token", "credential", "apikey", "api_key", "accesskey", "access_key", "privatekey", "private_key"}
+
+func isSensitiveName(name string) bool {
+	name = strings.ToLower(name)
+	for _, sensitive := range sensitiveNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact replaces the secrets of a message with a placeholder.
+func redact(m protoreflect.Message) {
+	// The fields of a message can't be set while ranging over them.
+	var redactedStrings []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		redactAll := redactedFields[fd.FullName()]
+		switch {
+		case fd.IsMap():
+			v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				switch {
+				case fd.MapValue().Kind() == protoreflect.StringKind:
+					if redactAll || isSensitiveName(key.String()) {
+						v.Map().Set(key, protoreflect.ValueOfString(redactedValue))
+					}
+				case fd.MapValue().Kind() == protoreflect.MessageKind:
+					redact(value.Message())
+				}
+				return true
+			})
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				for i := 0; i < v.List().Len(); i++ {
+					redact(v.List().Get(i).Message())
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			redact(v.Message())
+		case fd.Kind() == protoreflect.StringKind:
+			if redactAll || isSensitiveName(string(fd.Name())) {
+				redactedStrings = append(redactedStrings, fd)
+			}
+		}
+		return true
+	})
+	for _, fd := range redactedStrings {
+		m.Set(fd, protoreflect.ValueOfString(redactedValue))
+	}
+}
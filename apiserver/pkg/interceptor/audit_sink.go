@@ -0,0 +1,117 @@
+package interceptor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	klog "k8s.io/klog/v2"
+)
+
+// AuditSink stores the audit events. Write is called concurrently by the RPCs.
+type AuditSink interface {
+	Write(event *AuditEvent) error
+}
+
+// MultiAuditSink writes the audit events to each sink.
+type MultiAuditSink []AuditSink
+
+func (m MultiAuditSink) Write(event *AuditEvent) error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Write(event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FileAuditSink appends the audit events to a file as JSON lines.
+type FileAuditSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileAuditSink opens the file to append the audit events to, creating it if needed.
+func NewFileAuditSink(path string) (*FileAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit log %s: %w", path, err)
+	}
+	return &FileAuditSink{file: file}, nil
+}
+
+func (s *FileAuditSink) Write(event *AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(line)
+	return err
+}
+
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// WebhookAuditSink posts each audit event as JSON to a webhook. The events are posted in the background in the order
+// they are written, so that a slow webhook doesn't delay the RPCs, and are dropped when the buffer is full.
+type WebhookAuditSink struct {
+	url    string
+	client *http.Client
+	events chan *AuditEvent
+}
+
+// NewWebhookAuditSink creates a WebhookAuditSink buffering up to bufferSize events.
+func NewWebhookAuditSink(url string, client *http.Client, bufferSize int) *WebhookAuditSink {
+	s := &WebhookAuditSink{url: url, client: client, events: make(chan *AuditEvent, bufferSize)}
+	go s.run()
+	return s
+}
+
+func (s *WebhookAuditSink) Write(event *AuditEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	default:
+		return fmt.Errorf("the audit webhook buffer is full, dropping the event")
+	}
+}
+
+func (s *WebhookAuditSink) run() {
+	for event := range s.events {
+		if err := s.post(event); err != nil {
+			klog.Errorf("Failed to post the audit event of %s to the webhook: %v", event.Method, err)
+		}
+	}
+}
+
+func (s *WebhookAuditSink) post(event *AuditEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("the webhook returned HTTP status %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,308 @@
+package interceptor
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/auth"
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type recordingSink struct {
+	mu     sync.Mutex
+	events []*AuditEvent
+}
+
+func (s *recordingSink) Write(event *AuditEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	request proto.Message
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.request)
+	return nil
+}
+
+func TestParseAuditLevels(t *testing.T) {
+	level, err := ParseAuditLevel("request")
+	require.NoError(t, err)
+	assert.Equal(t, AuditLevelRequest, level)
+	_, err = ParseAuditLevel("RequestResponse")
+	assert.Error(t, err)
+
+	levels, err := ParseAuditMethodLevels("/proto.ClusterService/GetCluster=Metadata, /proto.ClusterService/CreateCluster=Request")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]AuditLevel{
+		"/proto.ClusterService/GetCluster":    AuditLevelMetadata,
+		"/proto.ClusterService/CreateCluster": AuditLevelRequest,
+	}, levels)
+	levels, err = ParseAuditMethodLevels("")
+	require.NoError(t, err)
+	assert.Empty(t, levels)
+	_, err = ParseAuditMethodLevels("GetCluster=Metadata")
+	assert.Error(t, err)
+	_, err = ParseAuditMethodLevels("/proto.ClusterService/GetCluster")
+	assert.Error(t, err)
+}
+
+func TestAuditPolicy(t *testing.T) {
+	policy := AuditPolicy{
+		Level:        AuditLevelMetadata,
+		MethodLevels: map[string]AuditLevel{"/proto.ClusterService/GetCluster": AuditLevelRequest, "/proto.ClusterService/DeleteCluster": AuditLevelNone},
+	}
+	assert.Equal(t, AuditLevelMetadata, policy.levelOf("/proto.ClusterService/CreateCluster"))
+	assert.Equal(t, AuditLevelMetadata, policy.levelOf("/proto.RayJobSubmissionService/SubmitRayJob"))
+	assert.Equal(t, AuditLevelNone, policy.levelOf("/proto.RayJobService/ListRayJobs"))
+	assert.Equal(t, AuditLevelNone, policy.levelOf("/proto.ClusterService/WatchClusters"))
+	assert.Equal(t, AuditLevelNone, policy.levelOf("/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"))
+	assert.Equal(t, AuditLevelRequest, policy.levelOf("/proto.ClusterService/GetCluster"))
+	assert.Equal(t, AuditLevelNone, policy.levelOf("/proto.ClusterService/DeleteCluster"))
+}
+
+func TestAuditUnary(t *testing.T) {
+	sink := &recordingSink{}
+	interceptor := NewAuditInterceptor(sink, AuditPolicy{Level: AuditLevelRequest})
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Username: "alice", Groups: []string{"team-a"}})
+	request := &api.CreateClusterRequest{
+		Namespace: "ns",
+		Cluster: &api.Cluster{
+			Name:      "cluster",
+			Namespace: "ns",
+			Envs: &api.EnvironmentVariables{
+				Values: map[string]string{"AWS_SECRET_ACCESS_KEY": "secret-value"},
+			},
+			Annotations: map[string]string{"team": "a", "registry-token": "token-value"},
+		},
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.ClusterService/CreateCluster"}
+
+	_, err := interceptor.Unary(ctx, request, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &api.Cluster{}, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, sink.events, 1)
+	event := sink.events[0]
+	assert.Equal(t, AuditLevelRequest, event.Level)
+	assert.Equal(t, "alice", event.User)
+	assert.Equal(t, []string{"team-a"}, event.Groups)
+	assert.Equal(t, "create", event.Verb)
+	assert.Equal(t, "rayclusters", event.Resource)
+	assert.Equal(t, "ns", event.Namespace)
+	assert.Equal(t, "cluster", event.Name)
+	assert.Equal(t, "OK", event.Code)
+
+	var payload api.CreateClusterRequest
+	require.NoError(t, json.Unmarshal(event.Request, &payload))
+	assert.Equal(t, redactedValue, payload.Cluster.Envs.Values["AWS_SECRET_ACCESS_KEY"])
+	assert.Equal(t, redactedValue, payload.Cluster.Annotations["registry-token"])
+	assert.Equal(t, "a", payload.Cluster.Annotations["team"])
+	// The request given to the handler is left unchanged
+	assert.Equal(t, "secret-value", request.Cluster.Envs.Values["AWS_SECRET_ACCESS_KEY"])
+
+	// The metadata level leaves out the request, and failed RPCs record their code
+	interceptor = NewAuditInterceptor(sink, AuditPolicy{Level: AuditLevelMetadata})
+	info = &grpc.UnaryServerInfo{FullMethod: "/proto.ClusterService/DeleteCluster"}
+	_, err = interceptor.Unary(ctx, &api.DeleteClusterRequest{Name: "cluster", Namespace: "ns"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, util.NewInvalidInputError("Cluster name is invalid.")
+	})
+	require.Error(t, err)
+	require.Len(t, sink.events, 2)
+	event = sink.events[1]
+	assert.Equal(t, "delete", event.Verb)
+	assert.Equal(t, "cluster", event.Name)
+	assert.Nil(t, event.Request)
+	assert.Equal(t, "InvalidArgument", event.Code)
+	assert.Contains(t, event.Error, "Cluster name is invalid.")
+
+	// Read-only RPCs are not audited
+	info = &grpc.UnaryServerInfo{FullMethod: "/proto.ClusterService/GetCluster"}
+	_, err = interceptor.Unary(ctx, &api.GetClusterRequest{Name: "cluster", Namespace: "ns"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &api.Cluster{}, nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, sink.events, 2)
+}
+
+func TestAuditStream(t *testing.T) {
+	sink := &recordingSink{}
+	interceptor := NewAuditInterceptor(sink, AuditPolicy{
+		Level:        AuditLevelMetadata,
+		MethodLevels: map[string]AuditLevel{"/proto.RayJobSubmissionService/TailJobLog": AuditLevelRequest},
+	})
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Username: "bob"})
+	stream := &fakeServerStream{ctx: ctx, request: &api.TailJobLogRequest{Namespace: "ns", Clustername: "cluster", Submissionid: "raysubmit_1"}}
+	info := &grpc.StreamServerInfo{FullMethod: "/proto.RayJobSubmissionService/TailJobLog", IsServerStream: true}
+
+	err := interceptor.Stream(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&api.TailJobLogRequest{})
+	})
+	require.NoError(t, err)
+	require.Len(t, sink.events, 1)
+	event := sink.events[0]
+	assert.Equal(t, "bob", event.User)
+	assert.Equal(t, "ns", event.Namespace)
+	assert.Equal(t, "cluster", event.Name)
+	assert.Equal(t, "OK", event.Code)
+	assert.Contains(t, string(event.Request), "raysubmit_1")
+}
+
+func TestAuditDeniedCalls(t *testing.T) {
+	sink := &recordingSink{}
+	auditInterceptor := NewAuditInterceptor(sink, AuditPolicy{
+		Level:        AuditLevelMetadata,
+		MethodLevels: map[string]AuditLevel{"/proto.RayJobSubmissionService/TailJobLog": AuditLevelMetadata},
+	})
+	authInterceptor := newTestAuthInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("the denied call must not be handled")
+		return nil, nil
+	}
+
+	// The audit is chained before the authentication, which publishes the caller even if the call is denied.
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.ClusterService/DeleteCluster"}
+	_, err := auditInterceptor.Unary(withToken("alice-token"), &api.DeleteClusterRequest{Name: "cluster", Namespace: "team-b"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return authInterceptor.Unary(ctx, req, info, handler)
+	})
+	require.Error(t, err)
+	require.Len(t, sink.events, 1)
+	event := sink.events[0]
+	assert.Equal(t, "alice", event.User)
+	assert.Equal(t, "delete", event.Verb)
+	assert.Equal(t, "team-b", event.Namespace)
+	assert.Equal(t, "cluster", event.Name)
+	assert.Equal(t, "PermissionDenied", event.Code)
+
+	_, err = auditInterceptor.Unary(context.Background(), &api.DeleteClusterRequest{Name: "cluster", Namespace: "team-a"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return authInterceptor.Unary(ctx, req, info, handler)
+	})
+	require.Error(t, err)
+	require.Len(t, sink.events, 2)
+	assert.Empty(t, sink.events[1].User)
+	assert.Equal(t, "Unauthenticated", sink.events[1].Code)
+
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/proto.RayJobSubmissionService/TailJobLog", IsServerStream: true}
+	stream := &fakeServerStream{ctx: withToken("alice-token"), request: &api.TailJobLogRequest{Namespace: "team-b", Clustername: "cluster"}}
+	err = auditInterceptor.Stream(nil, stream, streamInfo, func(srv interface{}, stream grpc.ServerStream) error {
+		return authInterceptor.Stream(srv, stream, streamInfo, func(srv interface{}, stream grpc.ServerStream) error {
+			return stream.RecvMsg(&api.TailJobLogRequest{})
+		})
+	})
+	require.Error(t, err)
+	require.Len(t, sink.events, 3)
+	event = sink.events[2]
+	assert.Equal(t, "alice", event.User)
+	assert.Equal(t, "team-b", event.Namespace)
+	assert.Equal(t, "PermissionDenied", event.Code)
+}
+
+func TestRedact(t *testing.T) {
+	request := &api.SubmitRayJobRequest{
+		Namespace:   "ns",
+		Clustername: "cluster",
+		Jobsubmission: &api.RayJobSubmission{
+			Entrypoint: "python job.py",
+			RuntimeEnv: "env_vars:\n  PASSWORD: hunter2\n",
+			Metadata:   map[string]string{"owner": "alice", "api_key": "key"},
+		},
+	}
+	redact(request.ProtoReflect())
+	assert.Equal(t, "python job.py", request.Jobsubmission.Entrypoint)
+	assert.Equal(t, redactedValue, request.Jobsubmission.RuntimeEnv)
+	assert.Equal(t, map[string]string{"owner": "alice", "api_key": redactedValue}, request.Jobsubmission.Metadata)
+
+	template := &api.ImageTemplate{Name: "image", EnvironmentVariables: map[string]string{"HF_HOME": "/cache"}}
+	redact(template.ProtoReflect())
+	assert.Equal(t, "image", template.Name)
+	assert.Equal(t, redactedValue, template.EnvironmentVariables["HF_HOME"])
+
+	// The Serve config can define the runtime environments of the applications.
+	serveConfig := "applications:\n  - name: app\n    runtime_env:\n      env_vars:\n        API_KEY: key\n"
+	serviceRequest := &api.CreateRayServiceRequest{
+		Namespace: "ns",
+		Service:   &api.RayService{Name: "service", Namespace: "ns", ServeConfig_V2: serveConfig},
+	}
+	redact(serviceRequest.ProtoReflect())
+	assert.Equal(t, "service", serviceRequest.Service.Name)
+	assert.Equal(t, redactedValue, serviceRequest.Service.ServeConfig_V2)
+	updateServiceRequest := &api.UpdateRayServiceRequest{
+		Namespace: "ns",
+		Name:      "service",
+		Service:   &api.RayService{Name: "service", Namespace: "ns", ServeConfig_V2: serveConfig},
+	}
+	redact(updateServiceRequest.ProtoReflect())
+	assert.Equal(t, redactedValue, updateServiceRequest.Service.ServeConfig_V2)
+
+	// The same fields are redacted in the update requests.
+	updateClusterRequest := &api.UpdateClusterRequest{
+		Cluster: &api.Cluster{Name: "cluster", Envs: &api.EnvironmentVariables{Values: map[string]string{"HF_HOME": "/cache"}}},
+	}
+	redact(updateClusterRequest.ProtoReflect())
+	assert.Equal(t, redactedValue, updateClusterRequest.Cluster.Envs.Values["HF_HOME"])
+}
+
+func TestFileAuditSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileAuditSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&AuditEvent{Method: "/proto.ClusterService/CreateCluster", Code: "OK"}))
+	require.NoError(t, sink.Write(&AuditEvent{Method: "/proto.ClusterService/DeleteCluster", Code: "NotFound"}))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var methods []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event AuditEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		methods = append(methods, event.Method)
+	}
+	assert.Equal(t, []string{"/proto.ClusterService/CreateCluster", "/proto.ClusterService/DeleteCluster"}, methods)
+}
+
+func TestWebhookAuditSink(t *testing.T) {
+	received := make(chan AuditEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var event AuditEvent
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer server.Close()
+
+	sink := NewWebhookAuditSink(server.URL, server.Client(), 1)
+	require.NoError(t, sink.Write(&AuditEvent{Method: "/proto.RayJobService/CreateRayJob", User: "alice"}))
+	select {
+	case event := <-received:
+		assert.Equal(t, "/proto.RayJobService/CreateRayJob", event.Method)
+		assert.Equal(t, "alice", event.User)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the webhook didn't receive the audit event")
+	}
+}
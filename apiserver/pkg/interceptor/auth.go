@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/auth"
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	"google.golang.org/grpc"
+	klog "k8s.io/klog/v2"
+)
+
+// The RPCs of the gRPC reflection service only require authentication, since they don't touch any resource.
+const reflectionServicePrefix = "/grpc.reflection."
+
+// AuthInterceptor authenticates the caller of each RPC and, if an authorizer is set, checks its Kubernetes permissions
+// on the resource the RPC operates on. The user of the created objects is set to the authenticated caller.
+type AuthInterceptor struct {
+	authenticator auth.Authenticator
+	authorizer    auth.Authorizer
+}
+
+// NewAuthInterceptor creates an AuthInterceptor. The authorizer can be nil to only authenticate the callers.
+func NewAuthInterceptor(authenticator auth.Authenticator, authorizer auth.Authorizer) *AuthInterceptor {
+	return &AuthInterceptor{authenticator: authenticator, authorizer: authorizer}
+}
+
+// Unary implements UnaryServerInterceptor.
+func (i *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := i.authorize(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream implements StreamServerInterceptor. The RPC is authorized when its request is received.
+func (i *AuthInterceptor) Stream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &authorizedStream{ServerStream: stream, interceptor: i, fullMethod: info.FullMethod, ctx: stream.Context()})
+}
+
+// authorize returns the context carrying the identity of the caller if the RPC is allowed.
+func (i *AuthInterceptor) authorize(ctx context.Context, fullMethod string, req interface{}) (context.Context, error) {
+	identity, err := i.authenticator.Authenticate(ctx)
+	if err != nil {
+		klog.Warningf("Failed to authenticate the caller of %s: %v", fullMethod, err)
+		return nil, err
+	}
+	ctx = auth.NewContext(ctx, identity)
+	auth.SetUser(req, identity.Username)
+
+	if i.authorizer == nil || strings.HasPrefix(fullMethod, reflectionServicePrefix) {
+		return ctx, nil
+	}
+	attributes, ok := auth.GetResourceAttributes(fullMethod, req)
+	if !ok {
+		return nil, util.NewPermissionDeniedError(fmt.Errorf("unknown RPC %s", fullMethod), "%s cannot be authorized.", fullMethod)
+	}
+	if err := i.authorizer.Authorize(ctx, identity, attributes); err != nil {
+		klog.Warningf("User %s is not authorized to call %s: %v", identity.Username, fullMethod, err)
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// authorizedStream authorizes a streaming RPC on the first received request, since a stream interceptor doesn't see
+// the request. Following messages are not authorized again.
+type authorizedStream struct {
+	grpc.ServerStream
+	interceptor *AuthInterceptor
+	fullMethod  string
+	ctx         context.Context
+	authorized  bool
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.authorized {
+		return nil
+	}
+	ctx, err := s.interceptor.authorize(s.ctx, s.fullMethod, m)
+	if err != nil {
+		return err
+	}
+	s.ctx = ctx
+	s.authorized = true
+	return nil
+}
@@ -0,0 +1,109 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/auth"
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newTestAuthInterceptor creates an AuthInterceptor authenticating the token "alice-token" as alice, who may only
+// operate on the resources of namespace team-a.
+func newTestAuthInterceptor() *AuthInterceptor {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "alice-token" {
+			review.Status = authenticationv1.TokenReviewStatus{Authenticated: true, User: authenticationv1.UserInfo{Username: "alice"}}
+		}
+		return true, review, nil
+	})
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		review.Status.Allowed = review.Spec.User == "alice" && review.Spec.ResourceAttributes.Namespace == "team-a"
+		return true, review, nil
+	})
+	return NewAuthInterceptor(
+		auth.UnionAuthenticator{auth.NewTokenReviewAuthenticator(clientset.AuthenticationV1().TokenReviews(), nil)},
+		auth.NewSubjectAccessReviewAuthorizer(clientset.AuthorizationV1().SubjectAccessReviews()),
+	)
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthInterceptorUnary(t *testing.T) {
+	interceptor := newTestAuthInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.ClusterService/CreateCluster"}
+	var handledCtx context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handledCtx = ctx
+		return req, nil
+	}
+
+	request := &api.CreateClusterRequest{Namespace: "team-a", Cluster: &api.Cluster{Name: "cluster", User: "spoofed"}}
+	_, err := interceptor.Unary(withToken("alice-token"), request, info, handler)
+	require.NoError(t, err)
+	identity, ok := auth.FromContext(handledCtx)
+	require.True(t, ok)
+	assert.Equal(t, "alice", identity.Username)
+	// The cluster is created on behalf of the authenticated caller.
+	assert.Equal(t, "alice", request.Cluster.User)
+
+	// Calls without credentials are not handled.
+	handledCtx = nil
+	_, err = interceptor.Unary(context.Background(), &api.CreateClusterRequest{Namespace: "team-a"}, info, handler)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.Unauthenticated))
+	_, err = interceptor.Unary(withToken("other-token"), &api.CreateClusterRequest{Namespace: "team-a"}, info, handler)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.Unauthenticated))
+	assert.Nil(t, handledCtx)
+
+	// Calls denied by the SubjectAccessReview are not handled.
+	_, err = interceptor.Unary(withToken("alice-token"), &api.CreateClusterRequest{Namespace: "team-b"}, info, handler)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+	assert.Nil(t, handledCtx)
+
+	// RPCs unknown to the authorizer are denied.
+	_, err = interceptor.Unary(withToken("alice-token"), &api.CreateClusterRequest{Namespace: "team-a"}, &grpc.UnaryServerInfo{FullMethod: "/proto.ClusterService/Unknown"}, handler)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+	assert.Nil(t, handledCtx)
+}
+
+func TestAuthInterceptorStream(t *testing.T) {
+	interceptor := newTestAuthInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/proto.ClusterService/WatchClusters", IsServerStream: true}
+
+	// The stream is authorized on its first received request, and the handler then sees the identity of the caller.
+	stream := &fakeServerStream{ctx: withToken("alice-token"), request: &api.WatchClustersRequest{Namespace: "team-a"}}
+	err := interceptor.Stream(nil, stream, info, func(_ interface{}, stream grpc.ServerStream) error {
+		_, ok := auth.FromContext(stream.Context())
+		assert.False(t, ok)
+		var request api.WatchClustersRequest
+		require.NoError(t, stream.RecvMsg(&request))
+		identity, ok := auth.FromContext(stream.Context())
+		require.True(t, ok)
+		assert.Equal(t, "alice", identity.Username)
+		return nil
+	})
+	require.NoError(t, err)
+
+	stream = &fakeServerStream{ctx: withToken("alice-token"), request: &api.WatchClustersRequest{Namespace: "team-b"}}
+	err = interceptor.Stream(nil, stream, info, func(_ interface{}, stream grpc.ServerStream) error {
+		var request api.WatchClustersRequest
+		return stream.RecvMsg(&request)
+	})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+}
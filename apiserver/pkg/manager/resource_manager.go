@@ -8,12 +8,14 @@ import (
 	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
 	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/typed/ray/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
 	clientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
 )
 
 const DefaultNamespace = "ray-system"
@@ -21,25 +23,37 @@ const DefaultNamespace = "ray-system"
 // ResourceManagerInterface can be used by services to operate resources
 // kubernetes objects and potential db objects underneath operations should be encapsulated at this layer
 type ResourceManagerInterface interface {
-	CreateCluster(ctx context.Context, apiCluster *api.Cluster) (*rayv1api.RayCluster, error)
+	CreateCluster(ctx context.Context, apiCluster *api.Cluster, dryRun bool) (*rayv1api.RayCluster, error)
+	UpdateCluster(ctx context.Context, apiCluster *api.Cluster, dryRun bool) (*rayv1api.RayCluster, error)
+	PatchCluster(ctx context.Context, apiCluster *api.Cluster, paths []string, dryRun bool) (*rayv1api.RayCluster, error)
 	GetCluster(ctx context.Context, clusterName string, namespace string) (*rayv1api.RayCluster, error)
-	ListClusters(ctx context.Context, namespace string) ([]*rayv1api.RayCluster, error)
-	ListAllClusters(ctx context.Context) ([]*rayv1api.RayCluster, error)
+	ListClusters(ctx context.Context, namespace string, opts *util.ListOptions) ([]*rayv1api.RayCluster, string, error)
+	ListAllClusters(ctx context.Context, opts *util.ListOptions) ([]*rayv1api.RayCluster, string, error)
+	WatchClusters(ctx context.Context, namespace string, resourceVersion string, opts *util.ListOptions) (watch.Interface, error)
 	DeleteCluster(ctx context.Context, clusterName string, namespace string) error
 	CreateComputeTemplate(ctx context.Context, runtime *api.ComputeTemplate) (*corev1.ConfigMap, error)
 	GetComputeTemplate(ctx context.Context, name string, namespace string) (*corev1.ConfigMap, error)
-	ListComputeTemplates(ctx context.Context, namespace string) ([]*corev1.ConfigMap, error)
+	ListComputeTemplates(ctx context.Context, namespace string, opts *util.ListOptions) ([]*corev1.ConfigMap, string, error)
+	ListAllComputeTemplates(ctx context.Context, opts *util.ListOptions) ([]*corev1.ConfigMap, string, error)
 	DeleteComputeTemplate(ctx context.Context, name string, namespace string) error
-	CreateJob(ctx context.Context, apiJob *api.RayJob) (*rayv1api.RayJob, error)
+	CreateImageTemplate(ctx context.Context, imageTemplate *api.ImageTemplate) (*corev1.ConfigMap, error)
+	GetImageTemplate(ctx context.Context, name string, namespace string) (*corev1.ConfigMap, error)
+	ListImageTemplates(ctx context.Context, namespace string) ([]*corev1.ConfigMap, error)
+	DeleteImageTemplate(ctx context.Context, name string, namespace string) error
+	CreateJob(ctx context.Context, apiJob *api.RayJob, dryRun bool) (*rayv1api.RayJob, error)
 	GetJob(ctx context.Context, jobName string, namespace string) (*rayv1api.RayJob, error)
-	ListJobs(ctx context.Context, namespace string) ([]*rayv1api.RayJob, error)
-	ListAllJobs(ctx context.Context) ([]*rayv1api.RayJob, error)
+	ListJobs(ctx context.Context, namespace string, opts *util.ListOptions) ([]*rayv1api.RayJob, string, error)
+	ListAllJobs(ctx context.Context, opts *util.ListOptions) ([]*rayv1api.RayJob, string, error)
+	WatchJobs(ctx context.Context, namespace string, resourceVersion string, opts *util.ListOptions) (watch.Interface, error)
 	DeleteJob(ctx context.Context, jobName string, namespace string) error
-	CreateService(ctx context.Context, apiService *api.RayService) (*rayv1api.RayService, error)
-	UpdateRayService(ctx context.Context, request *api.UpdateRayServiceRequest) (*rayv1api.RayService, error)
+	SuspendJob(ctx context.Context, jobName string, namespace string) (*rayv1api.RayJob, error)
+	ResumeJob(ctx context.Context, jobName string, namespace string) (*rayv1api.RayJob, error)
+	CreateService(ctx context.Context, apiService *api.RayService, dryRun bool) (*rayv1api.RayService, error)
+	UpdateRayService(ctx context.Context, apiService *api.RayService, dryRun bool) (*rayv1api.RayService, error)
 	GetService(ctx context.Context, serviceName, namespace string) error
-	ListServices(ctx context.Context, namespace string) ([]*rayv1api.RayService, error)
-	ListAllServices(ctx context.Context) ([]*rayv1api.RayService, error)
+	ListServices(ctx context.Context, namespace string, opts *util.ListOptions) ([]*rayv1api.RayService, string, error)
+	ListAllServices(ctx context.Context, opts *util.ListOptions) ([]*rayv1api.RayService, string, error)
+	WatchServices(ctx context.Context, namespace string, resourceVersion string, opts *util.ListOptions) (watch.Interface, error)
 	DeleteService(ctx context.Context, serviceName, namespace string) error
 	GetClusterEvents(ctx context.Context, clusterName string, namespace string) ([]corev1.Event, error)
 	GetServiceEvents(ctx context.Context, service rayv1api.RayService) ([]corev1.Event, error)
@@ -82,15 +96,22 @@ func (r *ResourceManager) getKubernetesNamespaceClient() clientv1.NamespaceInter
 }
 
 // clusters
-func (r *ResourceManager) CreateCluster(ctx context.Context, apiCluster *api.Cluster) (*rayv1api.RayCluster, error) {
+
+// CreateCluster creates a cluster. With dryRun, the cluster is validated by Kubernetes but not persisted.
+func (r *ResourceManager) CreateCluster(ctx context.Context, apiCluster *api.Cluster, dryRun bool) (*rayv1api.RayCluster, error) {
 	// populate cluster map
 	computeTemplateDict, err := r.populateComputeTemplate(ctx, apiCluster.ClusterSpec, apiCluster.Namespace)
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to populate compute template for (%s/%s)", apiCluster.Namespace, apiCluster.Name)
 	}
 
+	imageTemplateDict, err := r.populateImageTemplate(ctx, apiCluster.ClusterSpec, apiCluster.Namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to populate image template for (%s/%s)", apiCluster.Namespace, apiCluster.Name)
+	}
+
 	// convert *api.Cluster to rayv1api.RayCluster
-	rayCluster, err := util.NewRayCluster(apiCluster, computeTemplateDict)
+	rayCluster, err := util.NewRayCluster(apiCluster, computeTemplateDict, imageTemplateDict)
 	if err != nil {
 		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to create a Ray cluster")
 	}
@@ -99,7 +120,7 @@ func (r *ResourceManager) CreateCluster(ctx context.Context, apiCluster *api.Clu
 	clusterAt := r.clientManager.Time().Now().String()
 	rayCluster.Annotations["ray.io/creation-timestamp"] = clusterAt
 
-	newRayCluster, err := r.getRayClusterClient(apiCluster.Namespace).Create(ctx, rayCluster.Get(), metav1.CreateOptions{})
+	newRayCluster, err := r.getRayClusterClient(apiCluster.Namespace).Create(ctx, rayCluster.Get(), metav1.CreateOptions{DryRun: util.DryRunOptions(dryRun)})
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create a cluster for (%s/%s)", rayCluster.Namespace, rayCluster.Name)
 	}
@@ -107,17 +128,141 @@ func (r *ResourceManager) CreateCluster(ctx context.Context, apiCluster *api.Clu
 	return newRayCluster, nil
 }
 
+// UpdateCluster replaces a cluster with the given one, rebuilt from its compute and image templates.
+func (r *ResourceManager) UpdateCluster(ctx context.Context, apiCluster *api.Cluster, dryRun bool) (*rayv1api.RayCluster, error) {
+	client := r.getRayClusterClient(apiCluster.Namespace)
+	oldCluster, err := getClusterByName(ctx, client, apiCluster.Name)
+	if err != nil {
+		return nil, util.Wrap(err, fmt.Sprintf("Update cluster fail, no cluster named: %s ", apiCluster.Name))
+	}
+
+	computeTemplateDict, err := r.populateComputeTemplate(ctx, apiCluster.ClusterSpec, apiCluster.Namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to populate compute template for (%s/%s)", apiCluster.Namespace, apiCluster.Name)
+	}
+	imageTemplateDict, err := r.populateImageTemplate(ctx, apiCluster.ClusterSpec, apiCluster.Namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to populate image template for (%s/%s)", apiCluster.Namespace, apiCluster.Name)
+	}
+	rayCluster, err := util.NewRayCluster(apiCluster, computeTemplateDict, imageTemplateDict)
+	if err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to update a Ray cluster")
+	}
+
+	if clusterAt, ok := oldCluster.Annotations["ray.io/creation-timestamp"]; ok {
+		rayCluster.Annotations["ray.io/creation-timestamp"] = clusterAt
+	}
+	rayCluster.ResourceVersion = oldCluster.ResourceVersion
+	if apiCluster.ResourceVersion != "" {
+		rayCluster.ResourceVersion = apiCluster.ResourceVersion
+	}
+	return r.updateCluster(ctx, client, rayCluster.Get(), dryRun)
+}
+
+// PatchCluster updates the fields of a cluster in the paths with the values of the given cluster. The supported
+// paths are `annotations`, `suspend` and `cluster_spec.worker_group_spec`.
+func (r *ResourceManager) PatchCluster(ctx context.Context, apiCluster *api.Cluster, paths []string, dryRun bool) (*rayv1api.RayCluster, error) {
+	client := r.getRayClusterClient(apiCluster.Namespace)
+	oldCluster, err := getClusterByName(ctx, client, apiCluster.Name)
+	if err != nil {
+		return nil, util.Wrap(err, fmt.Sprintf("Patch cluster fail, no cluster named: %s ", apiCluster.Name))
+	}
+
+	rayCluster := oldCluster.DeepCopy()
+	if apiCluster.ResourceVersion != "" {
+		rayCluster.ResourceVersion = apiCluster.ResourceVersion
+	}
+	for _, path := range paths {
+		switch path {
+		case "resource_version":
+			// Already used as the precondition of the update.
+		case "annotations":
+			annotations := map[string]string{}
+			for key, value := range apiCluster.Annotations {
+				annotations[key] = value
+			}
+			if clusterAt, ok := oldCluster.Annotations["ray.io/creation-timestamp"]; ok {
+				annotations["ray.io/creation-timestamp"] = clusterAt
+			}
+			rayCluster.Annotations = annotations
+		case "suspend":
+			suspend := apiCluster.Suspend
+			rayCluster.Spec.Suspend = &suspend
+		case "cluster_spec.worker_group_spec":
+			workerGroupSpecs, err := r.patchWorkerGroupSpecs(ctx, apiCluster, rayCluster.Spec)
+			if err != nil {
+				return nil, err
+			}
+			rayCluster.Spec.WorkerGroupSpecs = workerGroupSpecs
+		default:
+			return nil, util.NewInvalidInputError("Field %s of cluster %s can not be patched. Please use UpdateCluster instead.", path, apiCluster.Name)
+		}
+	}
+	return r.updateCluster(ctx, client, rayCluster, dryRun)
+}
+
+// patchWorkerGroupSpecs patches the worker groups of a cluster spec, populating the templates of the new groups only.
+func (r *ResourceManager) patchWorkerGroupSpecs(ctx context.Context, apiCluster *api.Cluster, spec rayv1api.RayClusterSpec) ([]rayv1api.WorkerGroupSpec, error) {
+	existing := map[string]bool{}
+	for _, workerGroupSpec := range spec.WorkerGroupSpecs {
+		existing[workerGroupSpec.GroupName] = true
+	}
+	newGroups := &api.ClusterSpec{}
+	for _, workerGroupSpec := range apiCluster.GetClusterSpec().GetWorkerGroupSpec() {
+		if existing[workerGroupSpec.GroupName] {
+			continue
+		}
+		if workerGroupSpec.ComputeTemplate == "" {
+			return nil, util.NewInvalidInputError("Compute template of new worker group %s is empty. Please specify a valid value.", workerGroupSpec.GroupName)
+		}
+		newGroups.WorkerGroupSpec = append(newGroups.WorkerGroupSpec, workerGroupSpec)
+	}
+
+	computeTemplateDict, err := r.populateComputeTemplate(ctx, newGroups, apiCluster.Namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to populate compute template for (%s/%s)", apiCluster.Namespace, apiCluster.Name)
+	}
+	imageTemplateDict, err := r.populateImageTemplate(ctx, newGroups, apiCluster.Namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to populate image template for (%s/%s)", apiCluster.Namespace, apiCluster.Name)
+	}
+	workerGroupSpecs, err := util.PatchRayClusterWorkerGroupSpecs(spec.RayVersion, apiCluster.Envs, apiCluster.GetClusterSpec().GetWorkerGroupSpec(), spec.WorkerGroupSpecs, computeTemplateDict, imageTemplateDict)
+	if err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to patch worker groups of a Ray cluster")
+	}
+	return workerGroupSpecs, nil
+}
+
+// updateCluster updates a cluster, failing with an Aborted error if it was modified since its resource version.
+// With dryRun, the update is validated by Kubernetes but not persisted.
+func (r *ResourceManager) updateCluster(ctx context.Context, client rayv1.RayClusterInterface, rayCluster *rayv1api.RayCluster, dryRun bool) (*rayv1api.RayCluster, error) {
+	if rayCluster.Annotations == nil {
+		rayCluster.Annotations = map[string]string{}
+	}
+	rayCluster.Annotations["ray.io/update-timestamp"] = r.clientManager.Time().Now().String()
+	newRayCluster, err := client.Update(ctx, rayCluster, metav1.UpdateOptions{DryRun: util.DryRunOptions(dryRun)})
+	if err != nil {
+		if errors.IsConflict(err) {
+			return nil, util.NewBadRequestError(err, "Cluster (%s/%s) was modified since resource version %s. Please get the cluster and try again.", rayCluster.Namespace, rayCluster.Name, rayCluster.ResourceVersion)
+		}
+		return nil, util.NewInternalServerError(err, "Failed to update cluster for (%s/%s)", rayCluster.Namespace, rayCluster.Name)
+	}
+	return newRayCluster, nil
+}
+
 // Compute template
 func (r *ResourceManager) populateComputeTemplate(ctx context.Context, clusterSpec *api.ClusterSpec, nameSpace string) (map[string]*api.ComputeTemplate, error) {
 	dict := map[string]*api.ComputeTemplate{}
 	// populate head compute template
-	name := clusterSpec.HeadGroupSpec.ComputeTemplate
-	configMap, err := r.GetComputeTemplate(ctx, name, nameSpace)
-	if err != nil {
-		return nil, err
+	if clusterSpec.HeadGroupSpec != nil {
+		name := clusterSpec.HeadGroupSpec.ComputeTemplate
+		configMap, err := r.GetComputeTemplate(ctx, name, nameSpace)
+		if err != nil {
+			return nil, err
+		}
+		computeTemplate := model.FromKubeToAPIComputeTemplate(configMap)
+		dict[name] = computeTemplate
 	}
-	computeTemplate := model.FromKubeToAPIComputeTemplate(configMap)
-	dict[name] = computeTemplate
 
 	// populate worker compute template
 	for _, spec := range clusterSpec.WorkerGroupSpec {
@@ -135,22 +280,42 @@ func (r *ResourceManager) populateComputeTemplate(ctx context.Context, clusterSp
 	return dict, nil
 }
 
+// Image template
+func (r *ResourceManager) populateImageTemplate(ctx context.Context, clusterSpec *api.ClusterSpec, nameSpace string) (map[string]*api.ImageTemplate, error) {
+	dict := map[string]*api.ImageTemplate{}
+	names := []string{clusterSpec.GetHeadGroupSpec().GetImageTemplate()}
+	for _, spec := range clusterSpec.WorkerGroupSpec {
+		names = append(names, spec.ImageTemplate)
+	}
+
+	for _, name := range names {
+		if _, exist := dict[name]; name == "" || exist {
+			continue
+		}
+		configMap, err := r.GetImageTemplate(ctx, name, nameSpace)
+		if err != nil {
+			return nil, err
+		}
+		dict[name] = model.FromKubeToAPIImageTemplate(configMap)
+	}
+
+	return dict, nil
+}
+
 func (r *ResourceManager) GetCluster(ctx context.Context, clusterName string, namespace string) (*rayv1api.RayCluster, error) {
 	client := r.getRayClusterClient(namespace)
 	return getClusterByName(ctx, client, clusterName)
 }
 
-func (r *ResourceManager) ListClusters(ctx context.Context, namespace string) ([]*rayv1api.RayCluster, error) {
-	labelSelector := metav1.LabelSelector{
-		MatchLabels: map[string]string{
-			util.KubernetesManagedByLabelKey: util.ComponentName,
-		},
-	}
-	rayClusterList, err := r.getRayClusterClient(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
-	})
+func (r *ResourceManager) ListClusters(ctx context.Context, namespace string, opts *util.ListOptions) ([]*rayv1api.RayCluster, string, error) {
+	rayClusterList, err := r.getRayClusterClient(namespace).List(ctx, opts.ToKubeListOptions(map[string]string{
+		util.KubernetesManagedByLabelKey: util.ComponentName,
+	}))
 	if err != nil {
-		return nil, util.Wrap(err, fmt.Sprintf("List RayCluster failed in %s", namespace))
+		if errors.IsResourceExpired(err) {
+			return nil, "", util.NewInvalidInputError("Page token has expired. Please list RayCluster from the first page.")
+		}
+		return nil, "", util.Wrap(err, fmt.Sprintf("List RayCluster failed in %s", namespace))
 	}
 
 	var result []*rayv1api.RayCluster
@@ -158,36 +323,32 @@ func (r *ResourceManager) ListClusters(ctx context.Context, namespace string) ([
 	for i := 0; i < length; i++ {
 		result = append(result, &rayClusterList.Items[i])
 	}
+	if opts != nil {
+		util.SortObjects(result, opts.SortBy)
+	}
 
-	return result, nil
+	return result, rayClusterList.Continue, nil
 }
 
-func (r *ResourceManager) ListAllClusters(ctx context.Context) ([]*rayv1api.RayCluster, error) {
-	namespaces, err := r.getKubernetesNamespaceClient().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, util.Wrap(err, "Failed to fetch all Kubernetes namespaces")
-	}
-
-	var result []*rayv1api.RayCluster
-	labelSelector := metav1.LabelSelector{
-		MatchLabels: map[string]string{
-			util.KubernetesManagedByLabelKey: util.ComponentName,
-		},
-	}
-	for _, namespace := range namespaces.Items {
-		rayClusterList, err := r.getRayClusterClient(namespace.Name).List(ctx, metav1.ListOptions{
-			LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
-		})
-		if err != nil {
-			return nil, util.Wrap(err, fmt.Sprintf("List RayCluster failed in %s", namespace.Name))
-		}
+func (r *ResourceManager) ListAllClusters(ctx context.Context, opts *util.ListOptions) ([]*rayv1api.RayCluster, string, error) {
+	return r.ListClusters(ctx, metav1.NamespaceAll, opts)
+}
 
-		length := len(rayClusterList.Items)
-		for i := 0; i < length; i++ {
-			result = append(result, &rayClusterList.Items[i])
+// WatchClusters watches the clusters in the namespace, or in all namespaces if it is empty, from the resource version.
+func (r *ResourceManager) WatchClusters(ctx context.Context, namespace string, resourceVersion string, opts *util.ListOptions) (watch.Interface, error) {
+	listOptions := opts.ToKubeListOptions(map[string]string{
+		util.KubernetesManagedByLabelKey: util.ComponentName,
+	})
+	listOptions.ResourceVersion = resourceVersion
+	listOptions.AllowWatchBookmarks = true
+	watcher, err := r.getRayClusterClient(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		if errors.IsResourceExpired(err) || errors.IsGone(err) {
+			return nil, util.NewInvalidInputError("Resource version %s is too old. Please watch clusters again without a resource version.", resourceVersion)
 		}
+		return nil, util.Wrap(err, fmt.Sprintf("Watch RayCluster failed in %s", namespace))
 	}
-	return result, nil
+	return watcher, nil
 }
 
 func (r *ResourceManager) DeleteCluster(ctx context.Context, clusterName string, namespace string) error {
@@ -206,8 +367,10 @@ func (r *ResourceManager) DeleteCluster(ctx context.Context, clusterName string,
 	return nil
 }
 
-func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.RayJob) (*rayv1api.RayJob, error) {
+// CreateJob creates a job. With dryRun, the job is validated by Kubernetes but not persisted.
+func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.RayJob, dryRun bool) (*rayv1api.RayJob, error) {
 	computeTemplateMap := make(map[string]*api.ComputeTemplate)
+	imageTemplateMap := make(map[string]*api.ImageTemplate)
 	var err error
 
 	// populate cluster map
@@ -216,15 +379,19 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.RayJob) (*r
 		if err != nil {
 			return nil, util.NewInternalServerError(err, "Failed to populate compute template for (%s/%s)", apiJob.Namespace, apiJob.JobId)
 		}
+		imageTemplateMap, err = r.populateImageTemplate(ctx, apiJob.ClusterSpec, apiJob.Namespace)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to populate image template for (%s/%s)", apiJob.Namespace, apiJob.JobId)
+		}
 	}
 
 	// convert *api.Cluster to rayv1api.RayCluster
-	rayJob, err := util.NewRayJob(apiJob, computeTemplateMap)
+	rayJob, err := util.NewRayJob(apiJob, computeTemplateMap, imageTemplateMap)
 	if err != nil {
 		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to create a Ray Job")
 	}
 
-	newRayJob, err := r.getRayJobClient(apiJob.Namespace).Create(ctx, rayJob.Get(), metav1.CreateOptions{})
+	newRayJob, err := r.getRayJobClient(apiJob.Namespace).Create(ctx, rayJob.Get(), metav1.CreateOptions{DryRun: util.DryRunOptions(dryRun)})
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create a job for (%s/%s)", apiJob.Namespace, apiJob.JobId)
 	}
@@ -237,17 +404,15 @@ func (r *ResourceManager) GetJob(ctx context.Context, jobName string, namespace
 	return getJobByName(ctx, client, jobName)
 }
 
-func (r *ResourceManager) ListJobs(ctx context.Context, namespace string) ([]*rayv1api.RayJob, error) {
-	labelSelector := metav1.LabelSelector{
-		MatchLabels: map[string]string{
-			util.KubernetesManagedByLabelKey: util.ComponentName,
-		},
-	}
-	rayJobList, err := r.getRayJobClient(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
-	})
+func (r *ResourceManager) ListJobs(ctx context.Context, namespace string, opts *util.ListOptions) ([]*rayv1api.RayJob, string, error) {
+	rayJobList, err := r.getRayJobClient(namespace).List(ctx, opts.ToKubeListOptions(map[string]string{
+		util.KubernetesManagedByLabelKey: util.ComponentName,
+	}))
 	if err != nil {
-		return nil, util.Wrap(err, fmt.Sprintf("List RayCluster failed in %s", namespace))
+		if errors.IsResourceExpired(err) {
+			return nil, "", util.NewInvalidInputError("Page token has expired. Please list RayJob from the first page.")
+		}
+		return nil, "", util.Wrap(err, fmt.Sprintf("List RayJob failed in %s", namespace))
 	}
 
 	var result []*rayv1api.RayJob
@@ -255,36 +420,32 @@ func (r *ResourceManager) ListJobs(ctx context.Context, namespace string) ([]*ra
 	for i := 0; i < length; i++ {
 		result = append(result, &rayJobList.Items[i])
 	}
+	if opts != nil {
+		util.SortObjects(result, opts.SortBy)
+	}
 
-	return result, nil
+	return result, rayJobList.Continue, nil
 }
 
-func (r *ResourceManager) ListAllJobs(ctx context.Context) ([]*rayv1api.RayJob, error) {
-	namespaces, err := r.getKubernetesNamespaceClient().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, util.Wrap(err, "Failed to fetch all Kubernetes namespaces")
-	}
-
-	var result []*rayv1api.RayJob
-	labelSelector := metav1.LabelSelector{
-		MatchLabels: map[string]string{
-			util.KubernetesManagedByLabelKey: util.ComponentName,
-		},
-	}
-	for _, namespace := range namespaces.Items {
-		rayJobList, err := r.getRayJobClient(namespace.Name).List(ctx, metav1.ListOptions{
-			LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
-		})
-		if err != nil {
-			return nil, util.Wrap(err, fmt.Sprintf("List RayCluster failed in %s", namespace.Name))
-		}
+func (r *ResourceManager) ListAllJobs(ctx context.Context, opts *util.ListOptions) ([]*rayv1api.RayJob, string, error) {
+	return r.ListJobs(ctx, metav1.NamespaceAll, opts)
+}
 
-		length := len(rayJobList.Items)
-		for i := 0; i < length; i++ {
-			result = append(result, &rayJobList.Items[i])
+// WatchJobs watches the jobs in the namespace, or in all namespaces if it is empty, from the resource version.
+func (r *ResourceManager) WatchJobs(ctx context.Context, namespace string, resourceVersion string, opts *util.ListOptions) (watch.Interface, error) {
+	listOptions := opts.ToKubeListOptions(map[string]string{
+		util.KubernetesManagedByLabelKey: util.ComponentName,
+	})
+	listOptions.ResourceVersion = resourceVersion
+	listOptions.AllowWatchBookmarks = true
+	watcher, err := r.getRayJobClient(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		if errors.IsResourceExpired(err) || errors.IsGone(err) {
+			return nil, util.NewInvalidInputError("Resource version %s is too old. Please watch jobs again without a resource version.", resourceVersion)
 		}
+		return nil, util.Wrap(err, fmt.Sprintf("Watch RayJob failed in %s", namespace))
 	}
-	return result, nil
+	return watcher, nil
 }
 
 func (r *ResourceManager) DeleteJob(ctx context.Context, jobName string, namespace string) error {
@@ -303,19 +464,24 @@ func (r *ResourceManager) DeleteJob(ctx context.Context, jobName string, namespa
 	return nil
 }
 
-func (r *ResourceManager) CreateService(ctx context.Context, apiService *api.RayService) (*rayv1api.RayService, error) {
+// CreateService creates a service. With dryRun, the service is validated by Kubernetes but not persisted.
+func (r *ResourceManager) CreateService(ctx context.Context, apiService *api.RayService, dryRun bool) (*rayv1api.RayService, error) {
 	// populate cluster map
 	computeTemplateDict, err := r.populateComputeTemplate(ctx, apiService.ClusterSpec, apiService.Namespace)
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to populate compute template for (%s/%s)", apiService.Namespace, apiService.Name)
 	}
-	rayService, err := util.NewRayService(apiService, computeTemplateDict)
+	imageTemplateDict, err := r.populateImageTemplate(ctx, apiService.ClusterSpec, apiService.Namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to populate image template for (%s/%s)", apiService.Namespace, apiService.Name)
+	}
+	rayService, err := util.NewRayService(apiService, computeTemplateDict, imageTemplateDict)
 	if err != nil {
 		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to create a Ray Service")
 	}
 	createdAt := r.clientManager.Time().Now().String()
 	rayService.Annotations["ray.io/creation-timestamp"] = createdAt
-	newRayService, err := r.getRayServiceClient(apiService.Namespace).Create(ctx, rayService.Get(), metav1.CreateOptions{})
+	newRayService, err := r.getRayServiceClient(apiService.Namespace).Create(ctx, rayService.Get(), metav1.CreateOptions{DryRun: util.DryRunOptions(dryRun)})
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create service for (%s/%s)", rayService.Namespace, rayService.Name)
 	}
@@ -323,7 +489,7 @@ func (r *ResourceManager) CreateService(ctx context.Context, apiService *api.Ray
 	return newRayService, nil
 }
 
-func (r *ResourceManager) UpdateRayService(ctx context.Context, apiService *api.RayService) (*rayv1api.RayService, error) {
+func (r *ResourceManager) UpdateRayService(ctx context.Context, apiService *api.RayService, dryRun bool) (*rayv1api.RayService, error) {
 	name := apiService.Name
 	namespace := apiService.Namespace
 	client := r.getRayServiceClient(namespace)
@@ -336,13 +502,17 @@ func (r *ResourceManager) UpdateRayService(ctx context.Context, apiService *api.
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to populate compute template for (%s/%s)", apiService.Namespace, apiService.Name)
 	}
-	rayService, err := util.NewRayService(apiService, computeTemplateDict)
+	imageTemplateDict, err := r.populateImageTemplate(ctx, apiService.ClusterSpec, apiService.Namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to populate image template for (%s/%s)", apiService.Namespace, apiService.Name)
+	}
+	rayService, err := util.NewRayService(apiService, computeTemplateDict, imageTemplateDict)
 	if err != nil {
 		return nil, err
 	}
 	rayService.Annotations["ray.io/update-timestamp"] = r.clientManager.Time().Now().String()
 	rayService.ResourceVersion = oldService.DeepCopy().ResourceVersion
-	newRayService, err := client.Update(ctx, rayService.Get(), metav1.UpdateOptions{})
+	newRayService, err := client.Update(ctx, rayService.Get(), metav1.UpdateOptions{DryRun: util.DryRunOptions(dryRun)})
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to update service for (%s/%s)", rayService.Namespace, rayService.Name)
 	}
@@ -354,42 +524,48 @@ func (r *ResourceManager) GetService(ctx context.Context, serviceName, namespace
 	return getServiceByName(ctx, client, serviceName)
 }
 
-func (r *ResourceManager) ListServices(ctx context.Context, namespace string) ([]*rayv1api.RayService, error) {
-	labelSelector := metav1.LabelSelector{
-		MatchLabels: map[string]string{
-			util.KubernetesManagedByLabelKey: util.ComponentName,
-		},
-	}
-	rayServiceList, err := r.getRayServiceClient(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
-	})
+func (r *ResourceManager) ListServices(ctx context.Context, namespace string, opts *util.ListOptions) ([]*rayv1api.RayService, string, error) {
+	rayServiceList, err := r.getRayServiceClient(namespace).List(ctx, opts.ToKubeListOptions(map[string]string{
+		util.KubernetesManagedByLabelKey: util.ComponentName,
+	}))
 	if err != nil {
-		return nil, util.Wrap(err, fmt.Sprintf("List RayService failed in %s", namespace))
+		if errors.IsResourceExpired(err) {
+			return nil, "", util.NewInvalidInputError("Page token has expired. Please list RayService from the first page.")
+		}
+		return nil, "", util.Wrap(err, fmt.Sprintf("List RayService failed in %s", namespace))
 	}
-	rayServices := make([]*rayv1api.RayService, 0)
-	for _, service := range rayServiceList.Items {
-		rayServices = append(rayServices, &service)
+
+	var result []*rayv1api.RayService
+	length := len(rayServiceList.Items)
+	for i := 0; i < length; i++ {
+		result = append(result, &rayServiceList.Items[i])
+	}
+	if opts != nil {
+		util.SortObjects(result, opts.SortBy)
 	}
 
-	return rayServices, nil
+	return result, rayServiceList.Continue, nil
 }
 
-func (r *ResourceManager) ListAllServices(ctx context.Context) ([]*rayv1api.RayService, error) {
-	rayServices := make([]*rayv1api.RayService, 0)
+func (r *ResourceManager) ListAllServices(ctx context.Context, opts *util.ListOptions) ([]*rayv1api.RayService, string, error) {
+	return r.ListServices(ctx, metav1.NamespaceAll, opts)
+}
 
-	namespaces, err := r.getKubernetesNamespaceClient().List(ctx, metav1.ListOptions{})
+// WatchServices watches the services in the namespace, or in all namespaces if it is empty, from the resource version.
+func (r *ResourceManager) WatchServices(ctx context.Context, namespace string, resourceVersion string, opts *util.ListOptions) (watch.Interface, error) {
+	listOptions := opts.ToKubeListOptions(map[string]string{
+		util.KubernetesManagedByLabelKey: util.ComponentName,
+	})
+	listOptions.ResourceVersion = resourceVersion
+	listOptions.AllowWatchBookmarks = true
+	watcher, err := r.getRayServiceClient(namespace).Watch(ctx, listOptions)
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to fetch all Kubernetes namespaces")
-	}
-
-	for _, namespace := range namespaces.Items {
-		servicesByNamespace, err := r.ListServices(ctx, namespace.Name)
-		if err != nil {
-			return nil, util.Wrap(err, "List All Rayservices failed")
+		if errors.IsResourceExpired(err) || errors.IsGone(err) {
+			return nil, util.NewInvalidInputError("Resource version %s is too old. Please watch services again without a resource version.", resourceVersion)
 		}
-		rayServices = append(rayServices, servicesByNamespace...)
+		return nil, util.Wrap(err, fmt.Sprintf("Watch RayService failed in %s", namespace))
 	}
-	return rayServices, nil
+	return watcher, nil
 }
 
 func (r *ResourceManager) DeleteService(ctx context.Context, serviceName, namespace string) error {
@@ -431,11 +607,79 @@ func (r *ResourceManager) GetComputeTemplate(ctx context.Context, name string, n
 	return getComputeTemplateByName(ctx, client, name)
 }
 
-func (r *ResourceManager) ListComputeTemplates(ctx context.Context, namespace string) ([]*corev1.ConfigMap, error) {
+func (r *ResourceManager) ListComputeTemplates(ctx context.Context, namespace string, opts *util.ListOptions) ([]*corev1.ConfigMap, string, error) {
+	configMapList, err := r.getKubernetesConfigMapClient(namespace).List(ctx, opts.ToKubeListOptions(map[string]string{
+		"ray.io/config-type": "compute-template",
+	}))
+	if err != nil {
+		if errors.IsResourceExpired(err) {
+			return nil, "", util.NewInvalidInputError("Page token has expired. Please list compute templates from the first page.")
+		}
+		return nil, "", util.Wrap(err, fmt.Sprintf("List compute templates failed in %s", namespace))
+	}
+
+	var result []*corev1.ConfigMap
+	length := len(configMapList.Items)
+	for i := 0; i < length; i++ {
+		result = append(result, &configMapList.Items[i])
+	}
+	if opts != nil {
+		util.SortObjects(result, opts.SortBy)
+	}
+
+	return result, configMapList.Continue, nil
+}
+
+func (r *ResourceManager) ListAllComputeTemplates(ctx context.Context, opts *util.ListOptions) ([]*corev1.ConfigMap, string, error) {
+	return r.ListComputeTemplates(ctx, metav1.NamespaceAll, opts)
+}
+
+func (r *ResourceManager) DeleteComputeTemplate(ctx context.Context, name string, namespace string) error {
 	client := r.getKubernetesConfigMapClient(namespace)
-	configMapList, err := client.List(ctx, metav1.ListOptions{LabelSelector: "ray.io/config-type=compute-template"})
+
+	configMap, err := getComputeTemplateByName(ctx, client, name)
+	if err != nil {
+		return util.Wrap(err, "Get compute template failure")
+	}
+
+	if err := client.Delete(ctx, configMap.Name, metav1.DeleteOptions{}); err != nil {
+		return util.NewInternalServerError(err, "failed to delete compute template %v.", name)
+	}
+
+	return nil
+}
+
+// Image templates
+func (r *ResourceManager) CreateImageTemplate(ctx context.Context, imageTemplate *api.ImageTemplate) (*corev1.ConfigMap, error) {
+	_, err := r.GetImageTemplate(ctx, imageTemplate.Name, imageTemplate.Namespace)
+	if err == nil {
+		return nil, util.NewAlreadyExistError("Image template with name %s already exists in namespace %s", imageTemplate.Name, imageTemplate.Namespace)
+	}
+
+	configMap, err := util.NewImageTemplate(imageTemplate)
 	if err != nil {
-		return nil, util.Wrap(err, "List compute templates failed")
+		return nil, util.NewInternalServerError(err, "Failed to convert image template (%s/%s)", imageTemplate.Namespace, imageTemplate.Name)
+	}
+
+	client := r.getKubernetesConfigMapClient(imageTemplate.Namespace)
+	newConfigMap, err := client.Create(ctx, configMap, metav1.CreateOptions{})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create an image template for (%s/%s)", imageTemplate.Namespace, imageTemplate.Name)
+	}
+
+	return newConfigMap, nil
+}
+
+func (r *ResourceManager) GetImageTemplate(ctx context.Context, name string, namespace string) (*corev1.ConfigMap, error) {
+	client := r.getKubernetesConfigMapClient(namespace)
+	return getImageTemplateByName(ctx, client, name)
+}
+
+func (r *ResourceManager) ListImageTemplates(ctx context.Context, namespace string) ([]*corev1.ConfigMap, error) {
+	client := r.getKubernetesConfigMapClient(namespace)
+	configMapList, err := client.List(ctx, metav1.ListOptions{LabelSelector: "ray.io/config-type=image-template"})
+	if err != nil {
+		return nil, util.Wrap(err, "List image templates failed")
 	}
 
 	var result []*corev1.ConfigMap
@@ -447,7 +691,7 @@ func (r *ResourceManager) ListComputeTemplates(ctx context.Context, namespace st
 	return result, nil
 }
 
-func (r *ResourceManager) ListAllComputeTemplates(ctx context.Context) ([]*corev1.ConfigMap, error) {
+func (r *ResourceManager) ListAllImageTemplates(ctx context.Context) ([]*corev1.ConfigMap, error) {
 	namespaces, err := r.getKubernetesNamespaceClient().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to fetch all Kubernetes namespaces")
@@ -455,30 +699,25 @@ func (r *ResourceManager) ListAllComputeTemplates(ctx context.Context) ([]*corev
 
 	var result []*corev1.ConfigMap
 	for _, namespace := range namespaces.Items {
-		client := r.getKubernetesConfigMapClient(namespace.Name)
-		configMapList, err := client.List(ctx, metav1.ListOptions{LabelSelector: "ray.io/config-type=compute-template"})
+		configMaps, err := r.ListImageTemplates(ctx, namespace.Name)
 		if err != nil {
-			return nil, util.Wrap(err, fmt.Sprintf("List compute templates failed in %s", namespace.Name))
-		}
-
-		length := len(configMapList.Items)
-		for i := 0; i < length; i++ {
-			result = append(result, &configMapList.Items[i])
+			return nil, util.Wrap(err, fmt.Sprintf("List image templates failed in %s", namespace.Name))
 		}
+		result = append(result, configMaps...)
 	}
 	return result, nil
 }
 
-func (r *ResourceManager) DeleteComputeTemplate(ctx context.Context, name string, namespace string) error {
+func (r *ResourceManager) DeleteImageTemplate(ctx context.Context, name string, namespace string) error {
 	client := r.getKubernetesConfigMapClient(namespace)
 
-	configMap, err := getComputeTemplateByName(ctx, client, name)
+	configMap, err := getImageTemplateByName(ctx, client, name)
 	if err != nil {
-		return util.Wrap(err, "Get compute template failure")
+		return util.Wrap(err, "Get image template failure")
 	}
 
 	if err := client.Delete(ctx, configMap.Name, metav1.DeleteOptions{}); err != nil {
-		return util.NewInternalServerError(err, "failed to delete compute template %v.", name)
+		return util.NewInternalServerError(err, "failed to delete image template %v.", name)
 	}
 
 	return nil
@@ -501,6 +740,76 @@ func getClusterByName(ctx context.Context, client rayv1.RayClusterInterface, nam
 	return cluster, nil
 }
 
+// SuspendJob suspends a job, deleting its RayCluster until it is resumed.
+func (r *ResourceManager) SuspendJob(ctx context.Context, jobName string, namespace string) (*rayv1api.RayJob, error) {
+	return r.setJobSuspend(ctx, jobName, namespace, true)
+}
+
+// ResumeJob resumes a suspended job.
+func (r *ResourceManager) ResumeJob(ctx context.Context, jobName string, namespace string) (*rayv1api.RayJob, error) {
+	return r.setJobSuspend(ctx, jobName, namespace, false)
+}
+
+func (r *ResourceManager) setJobSuspend(ctx context.Context, jobName string, namespace string, suspend bool) (*rayv1api.RayJob, error) {
+	client := r.getRayJobClient(namespace)
+	var updatedJob *rayv1api.RayJob
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		job, err := getJobByName(ctx, client, jobName)
+		if err != nil {
+			return util.Wrap(err, "Get job failure")
+		}
+		if err := validateJobSuspend(job, suspend); err != nil {
+			return err
+		}
+		queued := job.Spec.RayJobQueueName != "" && job.Annotations[utils.RayJobQueueAdmittedAnnotationKey] == "false"
+		switch {
+		case suspend && queued:
+			// The job waits in its RayJobQueue, so it is already suspended. It is held by taking it out of the queue
+			// until it is resumed, and the RayJob controller then queues it again.
+			delete(job.Annotations, utils.RayJobQueueAdmittedAnnotationKey)
+		case job.Spec.Suspend == suspend || queued:
+			// A job waiting in its RayJobQueue is resumed when the queue admits it.
+			updatedJob = job
+			return nil
+		default:
+			job.Spec.Suspend = suspend
+		}
+		updatedJob, err = client.Update(ctx, job, metav1.UpdateOptions{})
+		if err != nil && !errors.IsConflict(err) {
+			return util.NewInternalServerError(err, "Failed to update job %s/%s", namespace, jobName)
+		}
+		return err
+	})
+	if err != nil {
+		if errors.IsConflict(err) {
+			return nil, util.NewBadRequestError(err, "Job %s/%s is being modified. Please try again.", namespace, jobName)
+		}
+		return nil, err
+	}
+	return updatedJob, nil
+}
+
+// validateJobSuspend checks that the job can be suspended or resumed, with the same limitations as the RayJob controller.
+func validateJobSuspend(job *rayv1api.RayJob, suspend bool) error {
+	if !suspend {
+		return nil
+	}
+	if !job.Spec.ShutdownAfterJobFinishes {
+		return util.NewInvalidInputError("Job %s does not shut down its cluster after it finishes, so it can not be suspended.", job.Name)
+	}
+	if len(job.Spec.ClusterSelector) != 0 {
+		return util.NewInvalidInputError("Job %s runs on a selected cluster, so it can not be suspended.", job.Name)
+	}
+	if job.Spec.RayClusterPoolName != "" {
+		return util.NewInvalidInputError("Job %s runs on a cluster of RayClusterPool %s, so it can not be suspended.", job.Name, job.Spec.RayClusterPoolName)
+	}
+	switch job.Status.JobDeploymentStatus {
+	case rayv1api.JobDeploymentStatusComplete, rayv1api.JobDeploymentStatusFailed:
+		return util.NewInvalidInputError("Job %s is already %s, so it can not be suspended.", job.Name, job.Status.JobDeploymentStatus)
+	}
+	return nil
+}
+
 // getJobByName returns the Kubernetes RayJob object by given name and client
 func getJobByName(ctx context.Context, client rayv1.RayJobInterface, name string) (*rayv1api.RayJob, error) {
 	job, err := client.Get(ctx, name, metav1.GetOptions{})
@@ -546,6 +855,23 @@ func getComputeTemplateByName(ctx context.Context, client clientv1.ConfigMapInte
 	return runtime, nil
 }
 
+// getImageTemplateByName returns the Kubernetes configmap object of the image template by given name and client
+func getImageTemplateByName(ctx context.Context, client clientv1.ConfigMapInterface, name string) (*corev1.ConfigMap, error) {
+	configMap, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, util.NewNotFoundError(err, "Image template %s not found", name)
+		}
+
+		return nil, util.Wrap(err, "Get image template failed")
+	}
+	if configMap.Labels["ray.io/config-type"] != "image-template" {
+		return nil, util.NewNotFoundError(fmt.Errorf("configmap %s is not an image template", name), "Image template %s not found", name)
+	}
+
+	return configMap, nil
+}
+
 func (r *ResourceManager) GetClusterEvents(ctx context.Context, clusterName string, namespace string) ([]corev1.Event, error) {
 	client := r.getEventsClient(namespace)
 	clusterClient := r.getRayClusterClient(namespace)
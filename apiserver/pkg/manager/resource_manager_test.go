@@ -0,0 +1,253 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/client"
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	rayfake "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/fake"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/typed/ray/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	authenticationv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// The fake typed clients neither pass the options of the requests to the recorded actions nor support dry-run, so the
+// clients record the dry-run options they are called with and, like the Kubernetes API server, don't persist the
+// objects of dry-run requests.
+type dryRunRecorder struct {
+	actions []string
+	dryRuns [][]string
+}
+
+func (r *dryRunRecorder) record(action string, dryRun []string) {
+	r.actions = append(r.actions, action)
+	r.dryRuns = append(r.dryRuns, dryRun)
+}
+
+type recordingRayClusterClient struct {
+	rayv1.RayClusterInterface
+	recorder *dryRunRecorder
+}
+
+func (c *recordingRayClusterClient) Create(ctx context.Context, cluster *rayv1api.RayCluster, opts metav1.CreateOptions) (*rayv1api.RayCluster, error) {
+	c.recorder.record("create", opts.DryRun)
+	if len(opts.DryRun) != 0 {
+		return cluster.DeepCopy(), nil
+	}
+	return c.RayClusterInterface.Create(ctx, cluster, opts)
+}
+
+func (c *recordingRayClusterClient) Update(ctx context.Context, cluster *rayv1api.RayCluster, opts metav1.UpdateOptions) (*rayv1api.RayCluster, error) {
+	c.recorder.record("update", opts.DryRun)
+	if len(opts.DryRun) != 0 {
+		return cluster.DeepCopy(), nil
+	}
+	return c.RayClusterInterface.Update(ctx, cluster, opts)
+}
+
+type recordingRayJobClient struct {
+	rayv1.RayJobInterface
+	recorder *dryRunRecorder
+}
+
+func (c *recordingRayJobClient) Create(ctx context.Context, job *rayv1api.RayJob, opts metav1.CreateOptions) (*rayv1api.RayJob, error) {
+	c.recorder.record("create", opts.DryRun)
+	if len(opts.DryRun) != 0 {
+		return job.DeepCopy(), nil
+	}
+	return c.RayJobInterface.Create(ctx, job, opts)
+}
+
+type fakeClientManager struct {
+	rayClientset        *rayfake.Clientset
+	kubernetesClientset *fake.Clientset
+	recorder            *dryRunRecorder
+}
+
+func newFakeClientManager() *fakeClientManager {
+	return &fakeClientManager{
+		rayClientset:        rayfake.NewSimpleClientset(),
+		kubernetesClientset: fake.NewSimpleClientset(),
+		recorder:            &dryRunRecorder{},
+	}
+}
+
+func (m *fakeClientManager) ClusterClient() client.ClusterClientInterface { return m }
+
+func (m *fakeClientManager) JobClient() client.JobClientInterface { return m }
+
+func (m *fakeClientManager) ServiceClient() client.ServiceClientInterface { return m }
+
+func (m *fakeClientManager) KubernetesClient() client.KubernetesClientInterface { return m }
+
+func (m *fakeClientManager) Time() util.TimeInterface { return util.NewFakeTimeForEpoch() }
+
+func (m *fakeClientManager) RayClusterClient(namespace string) rayv1.RayClusterInterface {
+	return &recordingRayClusterClient{RayClusterInterface: m.rayClientset.RayV1().RayClusters(namespace), recorder: m.recorder}
+}
+
+func (m *fakeClientManager) RayJobClient(namespace string) rayv1.RayJobInterface {
+	return &recordingRayJobClient{RayJobInterface: m.rayClientset.RayV1().RayJobs(namespace), recorder: m.recorder}
+}
+
+func (m *fakeClientManager) RayServiceClient(namespace string) rayv1.RayServiceInterface {
+	return m.rayClientset.RayV1().RayServices(namespace)
+}
+
+func (m *fakeClientManager) PodClient(namespace string) v1.PodInterface {
+	return m.kubernetesClientset.CoreV1().Pods(namespace)
+}
+
+func (m *fakeClientManager) ConfigMapClient(namespace string) v1.ConfigMapInterface {
+	return m.kubernetesClientset.CoreV1().ConfigMaps(namespace)
+}
+
+func (m *fakeClientManager) NamespaceClient() v1.NamespaceInterface {
+	return m.kubernetesClientset.CoreV1().Namespaces()
+}
+
+func (m *fakeClientManager) EventsClient(namespace string) v1.EventInterface {
+	return m.kubernetesClientset.CoreV1().Events(namespace)
+}
+
+func (m *fakeClientManager) TokenReviewClient() authenticationv1.TokenReviewInterface {
+	return m.kubernetesClientset.AuthenticationV1().TokenReviews()
+}
+
+func (m *fakeClientManager) SubjectAccessReviewClient() authorizationv1.SubjectAccessReviewInterface {
+	return m.kubernetesClientset.AuthorizationV1().SubjectAccessReviews()
+}
+
+func newTestCluster() *api.Cluster {
+	return &api.Cluster{
+		Name:      "cluster",
+		Namespace: "default",
+		User:      "alice",
+		Version:   "2.9.0",
+		ClusterSpec: &api.ClusterSpec{
+			HeadGroupSpec: &api.HeadGroupSpec{
+				ComputeTemplate: "template",
+				Image:           "rayproject/ray:2.9.0",
+				RayStartParams:  map[string]string{"dashboard-host": "0.0.0.0"},
+			},
+			WorkerGroupSpec: []*api.WorkerGroupSpec{{
+				GroupName:       "workers",
+				ComputeTemplate: "template",
+				Image:           "rayproject/ray:2.9.0",
+				Replicas:        1,
+				MinReplicas:     1,
+				MaxReplicas:     1,
+			}},
+		},
+	}
+}
+
+func TestClusterDryRun(t *testing.T) {
+	ctx := context.Background()
+	clientManager := newFakeClientManager()
+	resourceManager := NewResourceManager(clientManager)
+	_, err := resourceManager.CreateComputeTemplate(ctx, &api.ComputeTemplate{Name: "template", Namespace: "default", Cpu: 1, Memory: 1})
+	require.NoError(t, err)
+	_, err = resourceManager.CreateCluster(ctx, newTestCluster(), false)
+	require.NoError(t, err)
+
+	_, err = resourceManager.CreateCluster(ctx, newTestCluster(), true)
+	require.NoError(t, err)
+	_, err = resourceManager.UpdateCluster(ctx, newTestCluster(), true)
+	require.NoError(t, err)
+	patch := &api.Cluster{Name: "cluster", Namespace: "default", Suspend: true}
+	_, err = resourceManager.PatchCluster(ctx, patch, []string{"suspend"}, true)
+	require.NoError(t, err)
+	_, err = resourceManager.UpdateCluster(ctx, newTestCluster(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"create", "create", "update", "update", "update"}, clientManager.recorder.actions)
+	assert.Equal(t, [][]string{nil, {metav1.DryRunAll}, {metav1.DryRunAll}, {metav1.DryRunAll}, nil}, clientManager.recorder.dryRuns)
+	// Only the requests without dry-run reached the clientset.
+	var verbs []string
+	for _, action := range clientManager.rayClientset.Actions() {
+		if action.GetVerb() != "get" {
+			verbs = append(verbs, action.GetVerb())
+		}
+	}
+	assert.Equal(t, []string{"create", "update"}, verbs)
+	cluster, err := clientManager.rayClientset.RayV1().RayClusters("default").Get(ctx, "cluster", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, cluster.Spec.Suspend)
+}
+
+func TestJobDryRun(t *testing.T) {
+	ctx := context.Background()
+	clientManager := newFakeClientManager()
+	resourceManager := NewResourceManager(clientManager)
+	job := &api.RayJob{
+		Name:            "job",
+		Namespace:       "default",
+		User:            "alice",
+		Entrypoint:      "python job.py",
+		ClusterSelector: map[string]string{"ray.io/cluster": "cluster"},
+	}
+
+	_, err := resourceManager.CreateJob(ctx, job, true)
+	require.NoError(t, err)
+	_, err = resourceManager.CreateJob(ctx, job, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"create", "create"}, clientManager.recorder.actions)
+	assert.Equal(t, [][]string{{metav1.DryRunAll}, nil}, clientManager.recorder.dryRuns)
+}
+
+func TestSuspendAndResumeQueuedJob(t *testing.T) {
+	ctx := context.Background()
+	clientManager := newFakeClientManager()
+	resourceManager := NewResourceManager(clientManager)
+	jobs := clientManager.rayClientset.RayV1().RayJobs("default")
+	newQueuedJob := func(name string, suspend bool, admitted string) {
+		_, err := jobs.Create(ctx, &rayv1api.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Labels:      map[string]string{util.KubernetesManagedByLabelKey: util.ComponentName},
+				Annotations: map[string]string{utils.RayJobQueueAdmittedAnnotationKey: admitted},
+			},
+			Spec: rayv1api.RayJobSpec{
+				RayJobQueueName:          "queue",
+				ShutdownAfterJobFinishes: true,
+				Suspend:                  suspend,
+			},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	// A job waiting in its queue is resumed when the queue admits it, and suspending it takes it out of the queue.
+	newQueuedJob("waiting", true, "false")
+	job, err := resourceManager.ResumeJob(ctx, "waiting", "default")
+	require.NoError(t, err)
+	assert.True(t, job.Spec.Suspend)
+	assert.Equal(t, "false", job.Annotations[utils.RayJobQueueAdmittedAnnotationKey])
+	job, err = resourceManager.SuspendJob(ctx, "waiting", "default")
+	require.NoError(t, err)
+	assert.True(t, job.Spec.Suspend)
+	assert.NotContains(t, job.Annotations, utils.RayJobQueueAdmittedAnnotationKey)
+	job, err = resourceManager.ResumeJob(ctx, "waiting", "default")
+	require.NoError(t, err)
+	assert.False(t, job.Spec.Suspend)
+
+	// An admitted job is suspended and resumed like the other jobs.
+	newQueuedJob("admitted", false, "true")
+	job, err = resourceManager.SuspendJob(ctx, "admitted", "default")
+	require.NoError(t, err)
+	assert.True(t, job.Spec.Suspend)
+	job, err = resourceManager.ResumeJob(ctx, "admitted", "default")
+	require.NoError(t, err)
+	assert.False(t, job.Spec.Suspend)
+}
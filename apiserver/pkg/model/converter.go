@@ -12,6 +12,7 @@ import (
 	api "github.com/ray-project/kuberay/proto/go_client"
 	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 // Default annotations used by Ray nodes
@@ -25,6 +26,7 @@ func getNodeDefaultAnnotations() []string {
 		"cni.projectcalico.org/podIPs",
 		"cni.projectcalico.org/containerID",
 		"ray.io/compute-template",
+		"ray.io/image-template",
 		"k8s.v1.cni.cncf.io/network-status",
 		"k8s.v1.cni.cncf.io/networks-status",
 	}
@@ -96,19 +98,24 @@ func FromCrdToApiClusters(clusters []*rayv1api.RayCluster, clusterEventsMap map[
 
 func FromCrdToApiCluster(cluster *rayv1api.RayCluster, events []corev1.Event) *api.Cluster {
 	pbCluster := &api.Cluster{
-		Name:         cluster.Name,
-		Namespace:    cluster.Namespace,
-		Version:      cluster.Labels[util.RayClusterVersionLabelKey],
-		User:         cluster.Labels[util.RayClusterUserLabelKey],
-		Environment:  api.Cluster_Environment(api.Cluster_Environment_value[cluster.Labels[util.RayClusterEnvironmentLabelKey]]),
-		CreatedAt:    &timestamp.Timestamp{Seconds: cluster.CreationTimestamp.Unix()},
-		ClusterState: string(cluster.Status.State),
+		Name:            cluster.Name,
+		Namespace:       cluster.Namespace,
+		Version:         cluster.Labels[util.RayClusterVersionLabelKey],
+		User:            cluster.Labels[util.RayClusterUserLabelKey],
+		Environment:     api.Cluster_Environment(api.Cluster_Environment_value[cluster.Labels[util.RayClusterEnvironmentLabelKey]]),
+		CreatedAt:       &timestamp.Timestamp{Seconds: cluster.CreationTimestamp.Unix()},
+		ClusterState:    string(cluster.Status.State),
+		ResourceVersion: cluster.ResourceVersion,
 	}
 
 	if len(cluster.ObjectMeta.Annotations) > 0 {
 		pbCluster.Annotations = cluster.ObjectMeta.Annotations
 	}
 
+	if cluster.Spec.Suspend != nil {
+		pbCluster.Suspend = *cluster.Spec.Suspend
+	}
+
 	// loop container and find the resource
 	pbCluster.ClusterSpec = PopulateRayClusterSpec(cluster.Spec)
 
@@ -220,6 +227,7 @@ func PopulateHeadNodeSpec(spec rayv1api.HeadGroupSpec) *api.HeadGroupSpec {
 		RayStartParams:  spec.RayStartParams,
 		ServiceType:     string(spec.ServiceType),
 		Image:           spec.Template.Annotations[util.RayClusterImageAnnotationKey],
+		ImageTemplate:   spec.Template.Annotations[util.RayClusterImageTemplateAnnotationKey],
 		ComputeTemplate: spec.Template.Annotations[util.RayClusterComputeTemplateAnnotationKey],
 		Volumes:         PopulateVolumes(&spec.Template),
 	}
@@ -269,6 +277,7 @@ func PopulateWorkerNodeSpec(specs []rayv1api.WorkerGroupSpec) []*api.WorkerGroup
 			Replicas:        *spec.Replicas,
 			GroupName:       spec.GroupName,
 			Image:           spec.Template.Annotations[util.RayClusterImageAnnotationKey],
+			ImageTemplate:   spec.Template.Annotations[util.RayClusterImageTemplateAnnotationKey],
 			ComputeTemplate: spec.Template.Annotations[util.RayClusterComputeTemplateAnnotationKey],
 			Volumes:         PopulateVolumes(&spec.Template),
 		}
@@ -387,9 +396,33 @@ func FromKubeToAPIComputeTemplate(configMap *corev1.ConfigMap) *api.ComputeTempl
 				runtime.Tolerations, " error ", err)
 		}
 	}
+	unmarshalComputeTemplateField(configMap, "node_selector", &runtime.NodeSelector)
+	unmarshalComputeTemplateField(configMap, "affinity", &runtime.Affinity)
+	unmarshalComputeTemplateField(configMap, "extended_resources", &runtime.ExtendedResources)
+	if val, ok := configMap.Data["cpu_limit"]; ok {
+		cpuLimit, _ := strconv.ParseUint(val, 10, 32)
+		runtime.CpuLimit = uint32(cpuLimit)
+	}
+	if val, ok := configMap.Data["memory_limit"]; ok {
+		memoryLimit, _ := strconv.ParseUint(val, 10, 32)
+		runtime.MemoryLimit = uint32(memoryLimit)
+	}
+	runtime.PriorityClassName = configMap.Data["priority_class_name"]
+	runtime.SharedMemory = configMap.Data["shared_memory"]
 	return runtime
 }
 
+// Parse a json field of the compute template config map if it is present
+func unmarshalComputeTemplateField(configMap *corev1.ConfigMap, key string, value interface{}) {
+	val, ok := configMap.Data[key]
+	if !ok {
+		return
+	}
+	if err := json.Unmarshal([]byte(val), value); err != nil {
+		klog.Errorf("failed to unmarshall %s for compute template %s value %s error %v", key, configMap.Name, val, err)
+	}
+}
+
 func FromKubeToAPIComputeTemplates(configMaps []*corev1.ConfigMap) []*api.ComputeTemplate {
 	apiComputeTemplates := make([]*api.ComputeTemplate, 0)
 	for _, configMap := range configMaps {
@@ -398,6 +431,52 @@ func FromKubeToAPIComputeTemplates(configMaps []*corev1.ConfigMap) []*api.Comput
 	return apiComputeTemplates
 }
 
+func FromKubeToAPIImageTemplate(configMap *corev1.ConfigMap) *api.ImageTemplate {
+	imageTemplate := &api.ImageTemplate{}
+	imageTemplate.Name = configMap.Name
+	imageTemplate.Namespace = configMap.Namespace
+	imageTemplate.BaseImage = configMap.Data["base_image"]
+	imageTemplate.CustomCommands = configMap.Data["custom_commands"]
+	imageTemplate.Image = configMap.Data["image"]
+	unmarshal := func(key string, value interface{}) {
+		if val, ok := configMap.Data[key]; ok {
+			if err := json.Unmarshal([]byte(val), value); err != nil {
+				klog.Errorf("failed to unmarshall %s for image template %s, value %s, error %v", key, imageTemplate.Name, val, err)
+			}
+		}
+	}
+	unmarshal("pip_packages", &imageTemplate.PipPackages)
+	unmarshal("conda_packages", &imageTemplate.CondaPackages)
+	unmarshal("system_packages", &imageTemplate.SystemPackages)
+	unmarshal("environment_variables", &imageTemplate.EnvironmentVariables)
+	return imageTemplate
+}
+
+func FromKubeToAPIImageTemplates(configMaps []*corev1.ConfigMap) []*api.ImageTemplate {
+	apiImageTemplates := make([]*api.ImageTemplate, 0)
+	for _, configMap := range configMaps {
+		apiImageTemplates = append(apiImageTemplates, FromKubeToAPIImageTemplate(configMap))
+	}
+	return apiImageTemplates
+}
+
+// FromKubeToAPIWatchEventType converts the type of a Kubernetes watch event. It returns false for the events which are
+// not emitted to the API clients, e.g. bookmarks and errors.
+func FromKubeToAPIWatchEventType(eventType watch.EventType) (api.WatchEventType, bool) {
+	switch eventType {
+	case watch.Added:
+		return api.WatchEventType_ADDED, true
+	case watch.Modified:
+		return api.WatchEventType_MODIFIED, true
+	case watch.Deleted:
+		return api.WatchEventType_DELETED, true
+	case watch.Bookmark:
+		return api.WatchEventType_BOOKMARK, true
+	default:
+		return api.WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED, false
+	}
+}
+
 func FromCrdToApiJobs(jobs []*rayv1api.RayJob) []*api.RayJob {
 	apiJobs := make([]*api.RayJob, 0)
 	for _, job := range jobs {
@@ -427,6 +506,31 @@ func FromCrdToApiJob(job *rayv1api.RayJob) (pbJob *api.RayJob) {
 		JobStatus:                string(job.Status.JobStatus),
 		JobDeploymentStatus:      string(job.Status.JobDeploymentStatus),
 		Message:                  job.Status.Message,
+		Suspend:                  job.Spec.Suspend,
+		SubmissionMode:           string(job.Spec.SubmissionMode),
+		RayClusterPoolName:       job.Spec.RayClusterPoolName,
+		RayJobQueueName:          job.Spec.RayJobQueueName,
+		QueuePriority:            job.Spec.QueuePriority,
+		Reason:                   string(job.Status.Reason),
+		RayClusterName:           job.Status.RayClusterName,
+		DashboardUrl:             job.Status.DashboardURL,
+		ClusterState:             string(job.Status.RayClusterStatus.State),
+	}
+
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		pbJob.ActiveDeadlineSeconds = *job.Spec.ActiveDeadlineSeconds
+	}
+
+	if job.Spec.RuntimeEnv != nil {
+		pbJob.RuntimeEnvSpec = convertRuntimeEnv(job.Spec.RuntimeEnv)
+	}
+
+	if job.Status.StartTime != nil {
+		pbJob.StartTime = &timestamp.Timestamp{Seconds: job.Status.StartTime.Unix()}
+	}
+
+	if job.Status.EndTime != nil {
+		pbJob.EndTime = &timestamp.Timestamp{Seconds: job.Status.EndTime.Unix()}
 	}
 
 	// Add optional params
@@ -468,6 +572,39 @@ func FromCrdToApiJob(job *rayv1api.RayJob) (pbJob *api.RayJob) {
 	return pbJob
 }
 
+func convertRuntimeEnv(runtimeEnv *rayv1api.RuntimeEnv) *api.RuntimeEnv {
+	pbRuntimeEnv := &api.RuntimeEnv{
+		Pip:        runtimeEnv.Pip,
+		WorkingDir: runtimeEnv.WorkingDir,
+		PyModules:  runtimeEnv.PyModules,
+	}
+	if runtimeEnv.Conda != nil {
+		pbRuntimeEnv.Conda = &api.RuntimeEnvConda{
+			Name:         runtimeEnv.Conda.Name,
+			Channels:     runtimeEnv.Conda.Channels,
+			Dependencies: runtimeEnv.Conda.Dependencies,
+		}
+	}
+	for _, envVar := range runtimeEnv.EnvVars {
+		pbEnvVar := &api.RuntimeEnvVar{Name: envVar.Name, Value: envVar.Value}
+		if envVar.ValueFrom != nil {
+			if ref := envVar.ValueFrom.ConfigMapKeyRef; ref != nil {
+				pbEnvVar.ValueFrom = &api.EnvValueFrom{Source: api.EnvValueFrom_CONFIGMAP, Name: ref.Name, Key: ref.Key}
+			} else if ref := envVar.ValueFrom.SecretKeyRef; ref != nil {
+				pbEnvVar.ValueFrom = &api.EnvValueFrom{Source: api.EnvValueFrom_SECRET, Name: ref.Name, Key: ref.Key}
+			}
+		}
+		pbRuntimeEnv.EnvVars = append(pbRuntimeEnv.EnvVars, pbEnvVar)
+	}
+	if runtimeEnv.Container != nil {
+		pbRuntimeEnv.Container = &api.RuntimeEnvContainer{
+			Image:      runtimeEnv.Container.Image,
+			RunOptions: runtimeEnv.Container.RunOptions,
+		}
+	}
+	return pbRuntimeEnv
+}
+
 func FromCrdToApiServices(services []*rayv1api.RayService, serviceEventsMap map[string][]corev1.Event) []*api.RayService {
 	apiServices := make([]*api.RayService, 0)
 	for _, service := range services {
@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	util "github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
 	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/utils/pointer"
 )
 
@@ -574,6 +577,65 @@ func TestPopulateTemplate(t *testing.T) {
 	}
 }
 
+func TestPopulateTemplateSchedulingOptions(t *testing.T) {
+	expected := &api.ComputeTemplate{
+		Name:              "tpu-template",
+		Namespace:         "default",
+		Cpu:               8,
+		Memory:            32,
+		CpuLimit:          16,
+		MemoryLimit:       64,
+		NodeSelector:      map[string]string{"cloud.google.com/gke-nodepool": "tpu-pool"},
+		ExtendedResources: map[string]string{"google.com/tpu": "4", "rdma/hca": "1"},
+		PriorityClassName: "high-priority",
+		SharedMemory:      "16Gi",
+		Affinity: &api.PodAffinity{
+			NodeAffinity: []*api.NodeAffinityTerm{
+				{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "zone", Operator: "In", Values: []string{"a", "b"}}}},
+			},
+			PodAntiAffinity: []*api.PodAffinityTerm{
+				{MatchLabels: map[string]string{"app": "ray"}, TopologyKey: "kubernetes.io/hostname", Weight: 10},
+			},
+		},
+	}
+	configMap, err := util.NewComputeTemplate(expected)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(expected, FromKubeToAPIComputeTemplate(configMap)))
+
+	// Config maps of older compute templates have none of the options
+	template := FromKubeToAPIComputeTemplate(&configMapWithoutTolerations)
+	assert.Nil(t, template.Affinity)
+	assert.Empty(t, template.NodeSelector)
+	assert.Empty(t, template.ExtendedResources)
+	assert.Equal(t, uint32(0), template.CpuLimit)
+	assert.Equal(t, uint32(0), template.MemoryLimit)
+}
+
+func TestPopulateImageTemplate(t *testing.T) {
+	expected := &api.ImageTemplate{
+		Name:                 "image-template",
+		Namespace:            "default",
+		BaseImage:            "rayproject/ray:2.7.0",
+		PipPackages:          []string{"numpy"},
+		CondaPackages:        []string{"scipy"},
+		SystemPackages:       []string{"git"},
+		EnvironmentVariables: map[string]string{"foo": "bar"},
+		CustomCommands:       "echo done",
+	}
+	configMap, err := util.NewImageTemplate(expected)
+	assert.Nil(t, err)
+	assert.Equal(t, "image-template", configMap.Labels["ray.io/config-type"])
+	assert.Equal(t, expected, FromKubeToAPIImageTemplate(configMap))
+
+	// Empty lists are not stored.
+	configMap, err = util.NewImageTemplate(&api.ImageTemplate{Name: "image-template", Namespace: "default", Image: "rayproject/ray:2.7.0"})
+	assert.Nil(t, err)
+	assert.NotContains(t, configMap.Data, "pip_packages")
+	imageTemplate := FromKubeToAPIImageTemplate(configMap)
+	assert.Equal(t, "rayproject/ray:2.7.0", imageTemplate.Image)
+	assert.Empty(t, imageTemplate.PipPackages)
+}
+
 func tolerationToString(toleration *api.PodToleration) string {
 	return "Key: " + toleration.Key + " Operator: " + string(toleration.Operator) + " Effect: " + string(toleration.Effect)
 }
@@ -608,3 +670,66 @@ func TestPopulateJob(t *testing.T) {
 	assert.Equal(t, "image", job.JobSubmitter.Image)
 	assert.Equal(t, "2", job.JobSubmitter.Cpu)
 }
+
+func TestPopulateJobSpecAndStatus(t *testing.T) {
+	rayJob := JobNewClusterTest.DeepCopy()
+	deadline := int32(600)
+	startTime := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	rayJob.Spec.Suspend = true
+	rayJob.Spec.ActiveDeadlineSeconds = &deadline
+	rayJob.Spec.SubmissionMode = rayv1api.HTTPMode
+	rayJob.Spec.RuntimeEnv = &rayv1api.RuntimeEnv{
+		Conda: &rayv1api.RuntimeEnvConda{Name: "env"},
+		EnvVars: []rayv1api.RuntimeEnvVar{
+			{
+				Name: "token",
+				ValueFrom: &rayv1api.RuntimeEnvVarSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+						Key:                  "token",
+					},
+				},
+			},
+		},
+	}
+	rayJob.Status.JobDeploymentStatus = rayv1api.JobDeploymentStatusFailed
+	rayJob.Status.Reason = rayv1api.DeadlineExceeded
+	rayJob.Status.RayClusterName = "test-raycluster"
+	rayJob.Status.DashboardURL = "test-raycluster-head-svc.test.svc:8265"
+	rayJob.Status.StartTime = &startTime
+	rayJob.Status.RayClusterStatus.State = rayv1api.Ready
+
+	job := FromCrdToApiJob(rayJob)
+	assert.True(t, job.Suspend)
+	assert.Equal(t, int32(600), job.ActiveDeadlineSeconds)
+	assert.Equal(t, "HTTPMode", job.SubmissionMode)
+	assert.Equal(t, "env", job.RuntimeEnvSpec.Conda.Name)
+	assert.Equal(t, api.EnvValueFrom_CONFIGMAP, job.RuntimeEnvSpec.EnvVars[0].ValueFrom.Source)
+	assert.Equal(t, "config", job.RuntimeEnvSpec.EnvVars[0].ValueFrom.Name)
+	assert.Equal(t, "Failed", job.JobDeploymentStatus)
+	assert.Equal(t, "DeadlineExceeded", job.Reason)
+	assert.Equal(t, "test-raycluster", job.RayClusterName)
+	assert.Equal(t, "test-raycluster-head-svc.test.svc:8265", job.DashboardUrl)
+	assert.Equal(t, startTime.Unix(), job.StartTime.Seconds)
+	assert.Nil(t, job.EndTime)
+	assert.Equal(t, "ready", job.ClusterState)
+}
+
+func TestFromKubeToAPIWatchEventType(t *testing.T) {
+	tests := []struct {
+		eventType watch.EventType
+		expected  api.WatchEventType
+		emitted   bool
+	}{
+		{watch.Added, api.WatchEventType_ADDED, true},
+		{watch.Modified, api.WatchEventType_MODIFIED, true},
+		{watch.Deleted, api.WatchEventType_DELETED, true},
+		{watch.Bookmark, api.WatchEventType_BOOKMARK, true},
+		{watch.Error, api.WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED, false},
+	}
+	for _, tc := range tests {
+		eventType, emitted := FromKubeToAPIWatchEventType(tc.eventType)
+		assert.Equal(t, tc.expected, eventType, tc.eventType)
+		assert.Equal(t, tc.emitted, emitted, tc.eventType)
+	}
+}
@@ -2,12 +2,14 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/ray-project/kuberay/apiserver/pkg/manager"
 	"github.com/ray-project/kuberay/apiserver/pkg/model"
 	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 	corev1 "k8s.io/api/core/v1"
 	klog "k8s.io/klog/v2"
@@ -34,10 +36,13 @@ func (s *ClusterServer) CreateCluster(ctx context.Context, request *api.CreateCl
 	// use the namespace in the request to override the namespace in the cluster definition
 	request.Cluster.Namespace = request.Namespace
 
-	cluster, err := s.resourceManager.CreateCluster(ctx, request.Cluster)
+	cluster, err := s.resourceManager.CreateCluster(ctx, request.Cluster, request.DryRun)
 	if err != nil {
 		return nil, util.Wrap(err, "Create Cluster failed.")
 	}
+	if request.DryRun {
+		return dryRunCluster(cluster)
+	}
 	events, err := s.resourceManager.GetClusterEvents(ctx, cluster.Name, cluster.Namespace)
 	if err != nil {
 		klog.Warningf("Failed to get cluster's event, cluster: %s/%s, err: %v", cluster.Namespace, cluster.Name, err)
@@ -68,17 +73,103 @@ func (s *ClusterServer) GetCluster(ctx context.Context, request *api.GetClusterR
 	return model.FromCrdToApiCluster(cluster, events), nil
 }
 
-// Finds all Clusters in a given namespace.
-// TODO: Supports pagination and sorting on certain fields when we have DB support. request needs to be extended.
+// Replaces a Cluster with the given one.
+func (s *ClusterServer) UpdateCluster(ctx context.Context, request *api.UpdateClusterRequest) (*api.Cluster, error) {
+	if err := ValidateUpdateClusterRequest(request); err != nil {
+		return nil, util.Wrap(err, "Validate update cluster request failed.")
+	}
+
+	cluster, err := s.resourceManager.UpdateCluster(ctx, request.Cluster, request.DryRun)
+	if err != nil {
+		return nil, util.Wrap(err, "Update cluster failed.")
+	}
+	if request.DryRun {
+		return dryRunCluster(cluster)
+	}
+	events, err := s.resourceManager.GetClusterEvents(ctx, cluster.Name, cluster.Namespace)
+	if err != nil {
+		klog.Warningf("Failed to get cluster's event, cluster: %s/%s, err: %v", cluster.Namespace, cluster.Name, err)
+	}
+
+	return model.FromCrdToApiCluster(cluster, events), nil
+}
+
+// Updates the fields of a Cluster in the update mask.
+func (s *ClusterServer) PatchCluster(ctx context.Context, request *api.PatchClusterRequest) (*api.Cluster, error) {
+	if err := ValidatePatchClusterRequest(request); err != nil {
+		return nil, util.Wrap(err, "Validate patch cluster request failed.")
+	}
+
+	// use the name and namespace in the request, as the cluster only holds the fields to update
+	request.Cluster.Name = request.Name
+	request.Cluster.Namespace = request.Namespace
+
+	cluster, err := s.resourceManager.PatchCluster(ctx, request.Cluster, request.UpdateMask.GetPaths(), request.DryRun)
+	if err != nil {
+		return nil, util.Wrap(err, "Patch cluster failed.")
+	}
+	if request.DryRun {
+		return dryRunCluster(cluster)
+	}
+	events, err := s.resourceManager.GetClusterEvents(ctx, cluster.Name, cluster.Namespace)
+	if err != nil {
+		klog.Warningf("Failed to get cluster's event, cluster: %s/%s, err: %v", cluster.Namespace, cluster.Name, err)
+	}
+
+	return model.FromCrdToApiCluster(cluster, events), nil
+}
+
+// Returns the RayCluster of a Cluster as YAML.
+func (s *ClusterServer) GetClusterManifest(ctx context.Context, request *api.GetClusterManifestRequest) (*api.GetClusterManifestResponse, error) {
+	if request.Name == "" {
+		return nil, util.NewInvalidInputError("Cluster name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
+	}
+
+	cluster, err := s.resourceManager.GetCluster(ctx, request.Name, request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Get cluster manifest failed.")
+	}
+	manifest, err := util.ToManifest(cluster, util.RayClusterKind)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to render the manifest of cluster (%s/%s)", cluster.Namespace, cluster.Name)
+	}
+
+	return &api.GetClusterManifestResponse{Manifest: manifest}, nil
+}
+
+// dryRunCluster converts the RayCluster rendered by a dry-run request, which has no events yet.
+func dryRunCluster(cluster *rayv1api.RayCluster) (*api.Cluster, error) {
+	manifest, err := util.ToManifest(cluster, util.RayClusterKind)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to render the manifest of cluster (%s/%s)", cluster.Namespace, cluster.Name)
+	}
+	apiCluster := model.FromCrdToApiCluster(cluster, nil)
+	apiCluster.Manifest = manifest
+	return apiCluster, nil
+}
+
+// Finds a page of Clusters in a given namespace.
 func (s *ClusterServer) ListCluster(ctx context.Context, request *api.ListClustersRequest) (*api.ListClustersResponse, error) {
 	if request.Namespace == "" {
 		return nil, util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
 	}
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, request.User, request.SortBy)
+	if err != nil {
+		return nil, util.Wrap(err, "List clusters failed.")
+	}
+	if err := opts.ValidateFilter("cluster_state", request.ClusterState); err != nil {
+		return nil, util.Wrap(err, "List clusters failed.")
+	}
 
-	clusters, err := s.resourceManager.ListClusters(ctx, request.Namespace)
+	clusters, nextPageToken, err := s.resourceManager.ListClusters(ctx, request.Namespace, opts)
 	if err != nil {
 		return nil, util.Wrap(err, "List clusters failed.")
 	}
+	clusters = filterClustersByState(clusters, request.ClusterState)
 	clusterEventMap := make(map[string][]corev1.Event)
 	for _, cluster := range clusters {
 		clusterEvents, err := s.resourceManager.GetClusterEvents(ctx, cluster.Name, cluster.Namespace)
@@ -90,17 +181,26 @@ func (s *ClusterServer) ListCluster(ctx context.Context, request *api.ListCluste
 	}
 
 	return &api.ListClustersResponse{
-		Clusters: model.FromCrdToApiClusters(clusters, clusterEventMap),
+		Clusters:      model.FromCrdToApiClusters(clusters, clusterEventMap),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-// Finds all Clusters in all namespaces.
-// TODO: Supports pagination and sorting on certain fields when we have DB support. request needs to be extended.
+// Finds a page of Clusters in all namespaces.
 func (s *ClusterServer) ListAllClusters(ctx context.Context, request *api.ListAllClustersRequest) (*api.ListAllClustersResponse, error) {
-	clusters, err := s.resourceManager.ListAllClusters(ctx)
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, request.User, request.SortBy)
 	if err != nil {
 		return nil, util.Wrap(err, "List clusters from all namespaces failed.")
 	}
+	if err := opts.ValidateFilter("cluster_state", request.ClusterState); err != nil {
+		return nil, util.Wrap(err, "List clusters from all namespaces failed.")
+	}
+
+	clusters, nextPageToken, err := s.resourceManager.ListAllClusters(ctx, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "List clusters from all namespaces failed.")
+	}
+	clusters = filterClustersByState(clusters, request.ClusterState)
 	clusterEventMap := make(map[string][]corev1.Event)
 	for _, cluster := range clusters {
 		clusterEvents, err := s.resourceManager.GetClusterEvents(ctx, cluster.Name, cluster.Namespace)
@@ -112,10 +212,50 @@ func (s *ClusterServer) ListAllClusters(ctx context.Context, request *api.ListAl
 	}
 
 	return &api.ListAllClustersResponse{
-		Clusters: model.FromCrdToApiClusters(clusters, clusterEventMap),
+		Clusters:      model.FromCrdToApiClusters(clusters, clusterEventMap),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// Watches the Clusters in a given namespace, or in all namespaces if the namespace is empty.
+func (s *ClusterServer) WatchClusters(request *api.WatchClustersRequest, stream api.ClusterService_WatchClustersServer) error {
+	opts, err := util.NewListOptions("", 0, request.LabelSelector, request.User, "")
+	if err != nil {
+		return util.Wrap(err, "Watch clusters failed.")
+	}
+
+	ctx := stream.Context()
+	watcher, err := s.resourceManager.WatchClusters(ctx, request.Namespace, request.ResourceVersion, opts)
+	if err != nil {
+		return util.Wrap(err, "Watch clusters failed.")
+	}
+	return streamWatchEvents(ctx, watcher, func(eventType api.WatchEventType, cluster *rayv1api.RayCluster) error {
+		response := &api.WatchClustersResponse{
+			Type:            eventType,
+			ResourceVersion: cluster.ResourceVersion,
+		}
+		// Bookmarks only carry the resource version to resume the watch from.
+		if eventType != api.WatchEventType_BOOKMARK {
+			response.Cluster = model.FromCrdToApiCluster(cluster, nil)
+		}
+		return stream.Send(response)
+	})
+}
+
+// filterClustersByState keeps the clusters in the given state. All clusters are kept if the state is empty.
+func filterClustersByState(clusters []*rayv1api.RayCluster, state string) []*rayv1api.RayCluster {
+	if state == "" {
+		return clusters
+	}
+	var result []*rayv1api.RayCluster
+	for _, cluster := range clusters {
+		if strings.EqualFold(string(cluster.Status.State), state) {
+			result = append(result, cluster)
+		}
+	}
+	return result
+}
+
 // Deletes an Cluster without deleting the Cluster's runs and jobs. To
 // avoid unexpected behaviors, delete an Cluster's runs and jobs before
 // deleting the Cluster.
@@ -160,6 +300,77 @@ func ValidateCreateClusterRequest(request *api.CreateClusterRequest) error {
 	return nil
 }
 
+func ValidateUpdateClusterRequest(request *api.UpdateClusterRequest) error {
+	if request.Name == "" {
+		return util.NewInvalidInputError("Cluster name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
+	}
+
+	if request.Cluster == nil {
+		return util.NewInvalidInputError("Cluster is empty. Please input a valid payload.")
+	}
+
+	if request.Namespace != request.Cluster.Namespace {
+		return util.NewInvalidInputError("The namespace in the request is different from the namespace in the cluster definition.")
+	}
+
+	if request.Name != request.Cluster.Name {
+		return util.NewInvalidInputError("The name in the request is different from the name in the cluster definition.")
+	}
+
+	if request.Cluster.User == "" {
+		return util.NewInvalidInputError("User who create the cluster is empty. Please specify a valid value.")
+	}
+
+	if err := ValidateClusterSpec(request.Cluster.ClusterSpec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func ValidatePatchClusterRequest(request *api.PatchClusterRequest) error {
+	if request.Name == "" {
+		return util.NewInvalidInputError("Cluster name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
+	}
+
+	if request.Cluster == nil {
+		return util.NewInvalidInputError("Cluster is empty. Please input a valid payload.")
+	}
+
+	if len(request.UpdateMask.GetPaths()) == 0 {
+		return util.NewInvalidInputError("Update mask is empty. Please specify the fields to update.")
+	}
+
+	// Existing worker groups only update their replicas, so the compute template of the new ones is checked
+	// when the cluster is patched.
+	groupNames := map[string]bool{}
+	for index, spec := range request.Cluster.GetClusterSpec().GetWorkerGroupSpec() {
+		if len(spec.GroupName) == 0 {
+			return util.NewInvalidInputError("WorkerNodeSpec %d group name is empty. Please specify a valid value.", index)
+		}
+		if spec.MaxReplicas == 0 {
+			return util.NewInvalidInputError("WorkerNodeSpec %d MaxReplicas can not be 0. Please specify a valid value.", index)
+		}
+		if spec.MinReplicas > spec.MaxReplicas {
+			return util.NewInvalidInputError("WorkerNodeSpec %d MinReplica > MaxReplicas. Please specify a valid value.", index)
+		}
+		if groupNames[spec.GroupName] {
+			return util.NewInvalidInputError("WorkerNodeSpec %d group name %s is duplicated. Please specify a unique value.", index, spec.GroupName)
+		}
+		groupNames[spec.GroupName] = true
+	}
+
+	return nil
+}
+
 func NewClusterServer(resourceManager *manager.ResourceManager, options *ClusterServerOptions) *ClusterServer {
 	return &ClusterServer{resourceManager: resourceManager, options: options}
 }
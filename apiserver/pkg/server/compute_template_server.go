@@ -3,12 +3,17 @@ package server
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ray-project/kuberay/apiserver/pkg/manager"
 	"github.com/ray-project/kuberay/apiserver/pkg/model"
 	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
 	"google.golang.org/protobuf/types/known/emptypb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type ComputeTemplateServerOptions struct {
@@ -61,24 +66,36 @@ func (s *ComputeTemplateServer) ListComputeTemplates(ctx context.Context, reques
 		return nil, util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
 	}
 
-	runtimes, err := s.resourceManager.ListComputeTemplates(ctx, request.Namespace)
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, "", request.SortBy)
+	if err != nil {
+		return nil, util.Wrap(err, fmt.Sprintf("List compute templates in namespace %s failed.", request.Namespace))
+	}
+
+	runtimes, nextPageToken, err := s.resourceManager.ListComputeTemplates(ctx, request.Namespace, opts)
 	if err != nil {
 		return nil, util.Wrap(err, fmt.Sprintf("List compute templates in namespace %s failed.", request.Namespace))
 	}
 
 	return &api.ListComputeTemplatesResponse{
 		ComputeTemplates: model.FromKubeToAPIComputeTemplates(runtimes),
+		NextPageToken:    nextPageToken,
 	}, nil
 }
 
 func (s *ComputeTemplateServer) ListAllComputeTemplates(ctx context.Context, request *api.ListAllComputeTemplatesRequest) (*api.ListAllComputeTemplatesResponse, error) {
-	runtimes, err := s.resourceManager.ListAllComputeTemplates(ctx)
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, "", request.SortBy)
+	if err != nil {
+		return nil, util.Wrap(err, "List all compute templates from all namespaces failed.")
+	}
+
+	runtimes, nextPageToken, err := s.resourceManager.ListAllComputeTemplates(ctx, opts)
 	if err != nil {
 		return nil, util.Wrap(err, "List all compute templates from all namespaces failed.")
 	}
 
 	return &api.ListAllComputeTemplatesResponse{
 		ComputeTemplates: model.FromKubeToAPIComputeTemplates(runtimes),
+		NextPageToken:    nextPageToken,
 	}, nil
 }
 
@@ -119,6 +136,128 @@ func ValidateCreateComputeTemplateRequest(request *api.CreateComputeTemplateRequ
 		return util.NewInvalidInputError("Memory amount is zero. Please specify a valid value.")
 	}
 
+	return validateComputeTemplateScheduling(request.ComputeTemplate)
+}
+
+// validateComputeTemplateScheduling validates the limits, extended resources, shared memory and
+// scheduling options of the compute template
+func validateComputeTemplateScheduling(template *api.ComputeTemplate) error {
+	if template.CpuLimit != 0 && template.CpuLimit < template.Cpu {
+		return util.NewInvalidInputError("Cpu limit %d is smaller than the cpu amount %d. Please specify a valid value.", template.CpuLimit, template.Cpu)
+	}
+
+	if template.MemoryLimit != 0 && template.MemoryLimit < template.Memory {
+		return util.NewInvalidInputError("Memory limit %d is smaller than the memory amount %d. Please specify a valid value.", template.MemoryLimit, template.Memory)
+	}
+
+	for name, value := range template.ExtendedResources {
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return util.NewInvalidInputError("Extended resource name %s is invalid: %s", name, strings.Join(errs, ", "))
+		}
+		switch {
+		case name == string(corev1.ResourceCPU) || name == string(corev1.ResourceMemory):
+			return util.NewInvalidInputError("Extended resource %s conflicts with the %s amount. Please use the %s field.", name, name, name)
+		case template.Gpu != 0 && name == util.GetGpuResourceName(template):
+			return util.NewInvalidInputError("Extended resource %s conflicts with the gpu amount. Please use either gpu or extended resources.", name)
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil || quantity.Sign() <= 0 {
+			return util.NewInvalidInputError("Extended resource %s quantity %s is invalid. Please specify a positive quantity.", name, value)
+		}
+	}
+
+	if template.SharedMemory != "" {
+		size, err := resource.ParseQuantity(template.SharedMemory)
+		if err != nil || size.Sign() <= 0 {
+			return util.NewInvalidInputError("Shared memory %s is invalid. Please specify a positive quantity, e.g. 8Gi.", template.SharedMemory)
+		}
+		memoryLimit := template.MemoryLimit
+		if memoryLimit == 0 {
+			memoryLimit = template.Memory
+		}
+		if size.Cmp(resource.MustParse(fmt.Sprintf("%dGi", memoryLimit))) > 0 {
+			return util.NewInvalidInputError("Shared memory %s exceeds the memory limit %dGi. Please specify a valid value.", template.SharedMemory, memoryLimit)
+		}
+	}
+
+	if template.PriorityClassName != "" {
+		if errs := validation.IsDNS1123Subdomain(template.PriorityClassName); len(errs) > 0 {
+			return util.NewInvalidInputError("Priority class name %s is invalid: %s", template.PriorityClassName, strings.Join(errs, ", "))
+		}
+	}
+
+	for key, value := range template.NodeSelector {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return util.NewInvalidInputError("Node selector key %s is invalid: %s", key, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return util.NewInvalidInputError("Node selector value %s of key %s is invalid: %s", value, key, strings.Join(errs, ", "))
+		}
+	}
+
+	return validateComputeTemplateAffinity(template.Affinity)
+}
+
+// validateComputeTemplateAffinity validates the node affinity, pod affinity and pod anti-affinity terms
+func validateComputeTemplateAffinity(affinity *api.PodAffinity) error {
+	if affinity == nil {
+		return nil
+	}
+	for i, term := range affinity.NodeAffinity {
+		if term.Weight < 0 || term.Weight > 100 {
+			return util.NewInvalidInputError("Node affinity term %d weight %d is out of range. Please specify a value between 1 and 100, or 0 for a required term.", i, term.Weight)
+		}
+		if len(term.MatchExpressions) == 0 {
+			return util.NewInvalidInputError("Node affinity term %d has no match expressions. Please specify at least one.", i)
+		}
+		for _, expression := range term.MatchExpressions {
+			if err := validateNodeSelectorRequirement(i, expression); err != nil {
+				return err
+			}
+		}
+	}
+	for _, terms := range []struct {
+		kind  string
+		terms []*api.PodAffinityTerm
+	}{{"Pod affinity", affinity.PodAffinity}, {"Pod anti-affinity", affinity.PodAntiAffinity}} {
+		for i, term := range terms.terms {
+			if term.Weight < 0 || term.Weight > 100 {
+				return util.NewInvalidInputError("%s term %d weight %d is out of range. Please specify a value between 1 and 100, or 0 for a required term.", terms.kind, i, term.Weight)
+			}
+			if len(term.MatchLabels) == 0 {
+				return util.NewInvalidInputError("%s term %d has no match labels. Please specify at least one.", terms.kind, i)
+			}
+			if term.TopologyKey == "" {
+				return util.NewInvalidInputError("%s term %d topology key is empty. Please specify a valid value.", terms.kind, i)
+			}
+		}
+	}
+	return nil
+}
+
+func validateNodeSelectorRequirement(term int, expression *api.NodeSelectorRequirement) error {
+	if expression.Key == "" {
+		return util.NewInvalidInputError("Node affinity term %d has an expression with an empty key. Please specify a valid value.", term)
+	}
+	switch corev1.NodeSelectorOperator(expression.Operator) {
+	case corev1.NodeSelectorOpIn, corev1.NodeSelectorOpNotIn:
+		if len(expression.Values) == 0 {
+			return util.NewInvalidInputError("Node affinity term %d expression %s with operator %s has no values. Please specify at least one.", term, expression.Key, expression.Operator)
+		}
+	case corev1.NodeSelectorOpExists, corev1.NodeSelectorOpDoesNotExist:
+		if len(expression.Values) != 0 {
+			return util.NewInvalidInputError("Node affinity term %d expression %s with operator %s must not have values.", term, expression.Key, expression.Operator)
+		}
+	case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+		if len(expression.Values) != 1 {
+			return util.NewInvalidInputError("Node affinity term %d expression %s with operator %s must have a single value.", term, expression.Key, expression.Operator)
+		}
+		if _, err := strconv.ParseInt(expression.Values[0], 10, 64); err != nil {
+			return util.NewInvalidInputError("Node affinity term %d expression %s with operator %s must have an integer value.", term, expression.Key, expression.Operator)
+		}
+	default:
+		return util.NewInvalidInputError("Node affinity term %d expression %s operator %s is invalid. Please use one of In, NotIn, Exists, DoesNotExist, Gt and Lt.", term, expression.Key, expression.Operator)
+	}
 	return nil
 }
 
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/manager"
+	"github.com/ray-project/kuberay/apiserver/pkg/model"
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ImageTemplateServerOptions struct {
+	CollectMetrics bool
+}
+
+// implements `type ImageTemplateServiceServer interface` in config_grpc.pb.go
+// ImageTemplateServer is the server API for ImageTemplateService.
+type ImageTemplateServer struct {
+	resourceManager *manager.ResourceManager
+	options         *ImageTemplateServerOptions
+	api.UnimplementedImageTemplateServiceServer
+}
+
+func (s *ImageTemplateServer) CreateImageTemplate(ctx context.Context, request *api.CreateImageTemplateRequest) (*api.ImageTemplate, error) {
+	if err := ValidateCreateImageTemplateRequest(request); err != nil {
+		return nil, util.Wrap(err, "Validate image template request failed.")
+	}
+
+	// use the namespace in the request to override the namespace in the image template definition
+	request.ImageTemplate.Namespace = request.Namespace
+
+	configMap, err := s.resourceManager.CreateImageTemplate(ctx, request.ImageTemplate)
+	if err != nil {
+		return nil, util.Wrap(err, "Create image template failed.")
+	}
+
+	return model.FromKubeToAPIImageTemplate(configMap), nil
+}
+
+func (s *ImageTemplateServer) GetImageTemplate(ctx context.Context, request *api.GetImageTemplateRequest) (*api.ImageTemplate, error) {
+	if request.Name == "" {
+		return nil, util.NewInvalidInputError("Image template name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
+	}
+
+	configMap, err := s.resourceManager.GetImageTemplate(ctx, request.Name, request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Get image template failed.")
+	}
+
+	return model.FromKubeToAPIImageTemplate(configMap), nil
+}
+
+func (s *ImageTemplateServer) ListImageTemplates(ctx context.Context, request *api.ListImageTemplatesRequest) (*api.ListImageTemplatesResponse, error) {
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
+	}
+
+	configMaps, err := s.resourceManager.ListImageTemplates(ctx, request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, fmt.Sprintf("List image templates in namespace %s failed.", request.Namespace))
+	}
+
+	return &api.ListImageTemplatesResponse{
+		ImageTemplates: model.FromKubeToAPIImageTemplates(configMaps),
+	}, nil
+}
+
+func (s *ImageTemplateServer) ListAllImageTemplates(ctx context.Context, request *api.ListAllImageTemplatesRequest) (*api.ListAllImageTemplatesResponse, error) {
+	configMaps, err := s.resourceManager.ListAllImageTemplates(ctx)
+	if err != nil {
+		return nil, util.Wrap(err, "List all image templates from all namespaces failed.")
+	}
+
+	return &api.ListAllImageTemplatesResponse{
+		ImageTemplates: model.FromKubeToAPIImageTemplates(configMaps),
+	}, nil
+}
+
+func (s *ImageTemplateServer) DeleteImageTemplate(ctx context.Context, request *api.DeleteImageTemplateRequest) (*emptypb.Empty, error) {
+	if request.Name == "" {
+		return nil, util.NewInvalidInputError("Image template name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
+	}
+
+	if err := s.resourceManager.DeleteImageTemplate(ctx, request.Name, request.Namespace); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func ValidateCreateImageTemplateRequest(request *api.CreateImageTemplateRequest) error {
+	if request.Namespace == "" {
+		return util.NewInvalidInputError("Namespace is empty. Please specify a valid value.")
+	}
+
+	if request.ImageTemplate == nil {
+		return util.NewInvalidInputError("Image template is empty. Please specify a valid value.")
+	}
+
+	if request.ImageTemplate.Namespace != "" && request.Namespace != request.ImageTemplate.Namespace {
+		return util.NewInvalidInputError("The namespace in the request is different from the namespace defined in the image template.")
+	}
+
+	if request.ImageTemplate.Name == "" {
+		return util.NewInvalidInputError("Image template name is empty. Please specify a valid value.")
+	}
+
+	if request.ImageTemplate.BaseImage == "" && request.ImageTemplate.Image == "" {
+		return util.NewInvalidInputError("Image template requires a base image or an image. Please specify a valid value.")
+	}
+
+	return nil
+}
+
+func NewImageTemplateServer(resourceManager *manager.ResourceManager, options *ImageTemplateServerOptions) *ImageTemplateServer {
+	return &ImageTemplateServer{resourceManager: resourceManager, options: options}
+}
@@ -2,11 +2,13 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"github.com/ray-project/kuberay/apiserver/pkg/manager"
 	"github.com/ray-project/kuberay/apiserver/pkg/model"
 	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -36,10 +38,21 @@ func (s *RayJobServer) CreateRayJob(ctx context.Context, request *api.CreateRayJ
 	// use the namespace in the request to override the namespace in the job definition
 	request.Job.Namespace = request.Namespace
 
-	job, err := s.resourceManager.CreateJob(ctx, request.Job)
+	job, err := s.resourceManager.CreateJob(ctx, request.Job, request.DryRun)
 	if err != nil {
 		return nil, util.Wrap(err, "Create Job failed.")
 	}
+	if request.DryRun {
+		manifest, err := util.ToManifest(job, util.RayJobKind)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to render the manifest of job (%s/%s)", job.Namespace, job.Name)
+		}
+		apiJob := model.FromCrdToApiJob(job)
+		if apiJob != nil {
+			apiJob.Manifest = manifest
+		}
+		return apiJob, nil
+	}
 
 	return model.FromCrdToApiJob(job), nil
 }
@@ -62,34 +75,148 @@ func (s *RayJobServer) GetRayJob(ctx context.Context, request *api.GetRayJobRequ
 	return model.FromCrdToApiJob(job), nil
 }
 
-// Finds all Jobs in a given namespace.
+// Returns the RayJob of a job as YAML.
+func (s *RayJobServer) GetRayJobManifest(ctx context.Context, request *api.GetRayJobManifestRequest) (*api.GetRayJobManifestResponse, error) {
+	if request.Name == "" {
+		return nil, util.NewInvalidInputError("job name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("job namespace is empty. Please specify a valid value.")
+	}
+
+	job, err := s.resourceManager.GetJob(ctx, request.Name, request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Get job manifest failed.")
+	}
+	manifest, err := util.ToManifest(job, util.RayJobKind)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to render the manifest of job (%s/%s)", job.Namespace, job.Name)
+	}
+
+	return &api.GetRayJobManifestResponse{Manifest: manifest}, nil
+}
+
+// Finds a page of Jobs in a given namespace.
 func (s *RayJobServer) ListRayJobs(ctx context.Context, request *api.ListRayJobsRequest) (*api.ListRayJobsResponse, error) {
 	if request.Namespace == "" {
 		return nil, util.NewInvalidInputError("job namespace is empty. Please specify a valid value.")
 	}
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, request.User, request.SortBy)
+	if err != nil {
+		return nil, util.Wrap(err, "List jobs failed.")
+	}
+	if err := opts.ValidateFilter("job_status", request.JobStatus); err != nil {
+		return nil, util.Wrap(err, "List jobs failed.")
+	}
 
-	jobs, err := s.resourceManager.ListJobs(ctx, request.Namespace)
+	jobs, nextPageToken, err := s.resourceManager.ListJobs(ctx, request.Namespace, opts)
 	if err != nil {
 		return nil, util.Wrap(err, "List jobs failed.")
 	}
 
 	return &api.ListRayJobsResponse{
-		Jobs: model.FromCrdToApiJobs(jobs),
+		Jobs:          model.FromCrdToApiJobs(filterJobsByStatus(jobs, request.JobStatus)),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-// Finds all Jobs in all namespaces.
+// Finds a page of Jobs in all namespaces.
 func (s *RayJobServer) ListAllRayJobs(ctx context.Context, request *api.ListAllRayJobsRequest) (*api.ListAllRayJobsResponse, error) {
-	jobs, err := s.resourceManager.ListAllJobs(ctx)
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, request.User, request.SortBy)
+	if err != nil {
+		return nil, util.Wrap(err, "List jobs failed.")
+	}
+	if err := opts.ValidateFilter("job_status", request.JobStatus); err != nil {
+		return nil, util.Wrap(err, "List jobs failed.")
+	}
+
+	jobs, nextPageToken, err := s.resourceManager.ListAllJobs(ctx, opts)
 	if err != nil {
 		return nil, util.Wrap(err, "List jobs failed.")
 	}
 
 	return &api.ListAllRayJobsResponse{
-		Jobs: model.FromCrdToApiJobs(jobs),
+		Jobs:          model.FromCrdToApiJobs(filterJobsByStatus(jobs, request.JobStatus)),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// Watches the Jobs in a given namespace, or in all namespaces if the namespace is empty.
+func (s *RayJobServer) WatchRayJobs(request *api.WatchRayJobsRequest, stream api.RayJobService_WatchRayJobsServer) error {
+	opts, err := util.NewListOptions("", 0, request.LabelSelector, request.User, "")
+	if err != nil {
+		return util.Wrap(err, "Watch jobs failed.")
+	}
+
+	ctx := stream.Context()
+	watcher, err := s.resourceManager.WatchJobs(ctx, request.Namespace, request.ResourceVersion, opts)
+	if err != nil {
+		return util.Wrap(err, "Watch jobs failed.")
+	}
+	return streamWatchEvents(ctx, watcher, func(eventType api.WatchEventType, job *rayv1api.RayJob) error {
+		response := &api.WatchRayJobsResponse{
+			Type:            eventType,
+			ResourceVersion: job.ResourceVersion,
+		}
+		// Bookmarks only carry the resource version to resume the watch from.
+		if eventType != api.WatchEventType_BOOKMARK {
+			response.Job = model.FromCrdToApiJob(job)
+		}
+		return stream.Send(response)
+	})
+}
+
+// filterJobsByStatus keeps the jobs with the given Ray job status. All jobs are kept if the status is empty.
+func filterJobsByStatus(jobs []*rayv1api.RayJob, status string) []*rayv1api.RayJob {
+	if status == "" {
+		return jobs
+	}
+	var result []*rayv1api.RayJob
+	for _, job := range jobs {
+		if strings.EqualFold(string(job.Status.JobStatus), status) {
+			result = append(result, job)
+		}
+	}
+	return result
+}
+
+// Suspends a Job, deleting its RayCluster until it is resumed.
+func (s *RayJobServer) SuspendRayJob(ctx context.Context, request *api.SuspendRayJobRequest) (*api.RayJob, error) {
+	if request.Name == "" {
+		return nil, util.NewInvalidInputError("job name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("job namespace is empty. Please specify a valid value.")
+	}
+
+	job, err := s.resourceManager.SuspendJob(ctx, request.Name, request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Suspend job failed.")
+	}
+
+	return model.FromCrdToApiJob(job), nil
+}
+
+// Resumes a suspended Job.
+func (s *RayJobServer) ResumeRayJob(ctx context.Context, request *api.ResumeRayJobRequest) (*api.RayJob, error) {
+	if request.Name == "" {
+		return nil, util.NewInvalidInputError("job name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("job namespace is empty. Please specify a valid value.")
+	}
+
+	job, err := s.resourceManager.ResumeJob(ctx, request.Name, request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Resume job failed.")
+	}
+
+	return model.FromCrdToApiJob(job), nil
+}
+
 // Deletes an Job
 func (s *RayJobServer) DeleteRayJob(ctx context.Context, request *api.DeleteRayJobRequest) (*emptypb.Empty, error) {
 	if request.Name == "" {
@@ -124,6 +251,37 @@ func ValidateCreateJobRequest(request *api.CreateRayJobRequest) error {
 		return util.NewInvalidInputError("User who create the job is empty. Please specify a valid value.")
 	}
 
+	switch rayv1api.JobSubmissionMode(request.Job.SubmissionMode) {
+	case "", rayv1api.K8sJobMode, rayv1api.HTTPMode:
+	default:
+		return util.NewInvalidInputError("Job submission mode %s is not supported. Please specify %s or %s.", request.Job.SubmissionMode, rayv1api.K8sJobMode, rayv1api.HTTPMode)
+	}
+
+	if request.Job.ActiveDeadlineSeconds < 0 {
+		return util.NewInvalidInputError("Job active deadline seconds can not be negative. Please specify a valid value.")
+	}
+
+	if request.Job.RuntimeEnvSpec != nil {
+		if request.Job.RuntimeEnv != "" {
+			return util.NewInvalidInputError("Job runtime env and runtime env spec are mutually exclusive. Please specify only one of them.")
+		}
+		if len(request.Job.RuntimeEnvSpec.Pip) != 0 && request.Job.RuntimeEnvSpec.Conda != nil {
+			return util.NewInvalidInputError("Job runtime env pip and conda are mutually exclusive. Please specify only one of them.")
+		}
+		for _, envVar := range request.Job.RuntimeEnvSpec.EnvVars {
+			if envVar.Value != "" && envVar.ValueFrom != nil {
+				return util.NewInvalidInputError("Job runtime env var %s can not have both a value and a value from. Please specify only one of them.", envVar.Name)
+			}
+		}
+	}
+
+	if request.Job.RayClusterPoolName != "" {
+		if len(request.Job.ClusterSelector) != 0 || request.Job.ClusterSpec != nil {
+			return util.NewInvalidInputError("Job cluster pool can not be set together with a cluster selector or a cluster spec.")
+		}
+		return nil
+	}
+
 	if len(request.Job.ClusterSelector) != 0 {
 		return nil
 	}
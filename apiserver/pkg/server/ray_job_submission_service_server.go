@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zerologr"
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	"github.com/rs/zerolog"
@@ -133,6 +136,118 @@ func (s *RayJobSubmissionServiceServer) GetJobLog(ctx context.Context, req *api.
 	return &api.GetJobLogReply{Log: *jlog}, nil
 }
 
+// Follow Job log
+func (s *RayJobSubmissionServiceServer) TailJobLog(req *api.TailJobLogRequest, stream api.RayJobSubmissionService_TailJobLogServer) error {
+	s.log.Info("RayJobSubmissionService tail job log")
+	if req.Offset < 0 || req.TailLines < 0 {
+		return util.NewInvalidInputError("Offset and tail lines can not be negative. Please specify valid values.")
+	}
+	if req.Offset > 0 && req.TailLines > 0 {
+		return util.NewInvalidInputError("Offset and tail lines are mutually exclusive. Please specify only one of them.")
+	}
+	ctx := stream.Context()
+	clusterRequest := api.GetClusterRequest{Name: req.Clustername, Namespace: req.Namespace}
+	url, err := s.getRayClusterURL(ctx, &clusterRequest)
+	if err != nil {
+		return err
+	}
+	rayDashboardClient := s.dashboardClientFunc()
+	rayDashboardClient.InitClient(*url)
+	offset := req.Offset
+	if req.TailLines > 0 {
+		// The dashboard only follows the log from the beginning, so find where its last lines start.
+		jlog, err := rayDashboardClient.GetJobLog(ctx, req.Submissionid)
+		if err != nil {
+			return err
+		}
+		if jlog == nil {
+			return apierrors.NewNotFound(schema.GroupResource{Group: "RayJob", Resource: "JobSubmission"}, req.Submissionid)
+		}
+		offset = tailLinesOffset(*jlog, req.TailLines)
+	}
+	reader, err := rayDashboardClient.TailJobLog(ctx, req.Submissionid)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	err = streamJobLog(reader, offset, func(log string, offset int64) error {
+		return stream.Send(&api.TailJobLogReply{Log: log, Offset: offset})
+	})
+	if err != nil && ctx.Err() != nil {
+		// The reader is closed when the client goes away.
+		return ctx.Err()
+	}
+	return err
+}
+
+// tailLinesOffset returns the byte offset of the last lines of the log.
+func tailLinesOffset(log string, lines int64) int64 {
+	end := len(log)
+	if strings.HasSuffix(log, "\n") {
+		end--
+	}
+	for ; lines > 0; lines-- {
+		end = strings.LastIndexByte(log[:end], '\n')
+		if end < 0 {
+			return 0
+		}
+	}
+	return int64(end + 1)
+}
+
+// streamJobLog sends the log read from the reader in chunks, skipping the bytes before the offset. A multi-byte
+// character split between reads is held back until it is complete, as chunks are sent as UTF-8 strings.
+func streamJobLog(reader io.Reader, offset int64, send func(log string, offset int64) error) error {
+	buf := make([]byte, 32*1024)
+	var pending []byte
+	var position int64
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			data := buf[:n]
+			if skip := offset - position; skip > 0 {
+				if skip > int64(n) {
+					skip = int64(n)
+				}
+				data = data[skip:]
+				position += skip
+			}
+			pending = append(pending, data...)
+			complete := completeUTF8Prefix(pending)
+			if complete > 0 {
+				position += int64(complete)
+				if err := send(strings.ToValidUTF8(string(pending[:complete]), "\uFFFD"), position); err != nil {
+					return err
+				}
+				pending = append(pending[:0], pending[complete:]...)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			if len(pending) > 0 {
+				position += int64(len(pending))
+				return send(strings.ToValidUTF8(string(pending), "\uFFFD"), position)
+			}
+			return nil
+		}
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to follow the job log")
+		}
+	}
+}
+
+// completeUTF8Prefix returns the length of the prefix of the bytes that does not end with an incomplete character.
+func completeUTF8Prefix(b []byte) int {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if utf8.FullRune(b[i:]) {
+				return len(b)
+			}
+			return i
+		}
+	}
+	return len(b)
+}
+
 // List jobs
 func (s *RayJobSubmissionServiceServer) ListJobDetails(ctx context.Context, req *api.ListJobDetailsRequest) (*api.ListJobSubmissionInfo, error) {
 	s.log.Info("RayJobSubmissionService get jobs list")
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTailLinesOffset(t *testing.T) {
+	log := "a\nbb\nccc\n"
+	assert.Equal(t, int64(5), tailLinesOffset(log, 1))
+	assert.Equal(t, int64(2), tailLinesOffset(log, 2))
+	assert.Equal(t, int64(0), tailLinesOffset(log, 3))
+	assert.Equal(t, int64(0), tailLinesOffset(log, 10))
+	// The last line is not terminated yet
+	assert.Equal(t, int64(5), tailLinesOffset("a\nbb\nccc", 1))
+	assert.Equal(t, int64(0), tailLinesOffset("", 1))
+}
+
+func TestStreamJobLog(t *testing.T) {
+	log := "first line\nsecond line with ünïcödé\nthird line\n"
+	collect := func(offset int64) (string, []int64) {
+		var builder strings.Builder
+		var offsets []int64
+		// Read one byte at a time to split multi-byte characters between reads
+		err := streamJobLog(iotest.OneByteReader(strings.NewReader(log)), offset, func(chunk string, end int64) error {
+			builder.WriteString(chunk)
+			offsets = append(offsets, end)
+			return nil
+		})
+		require.NoError(t, err)
+		return builder.String(), offsets
+	}
+
+	streamed, offsets := collect(0)
+	assert.Equal(t, log, streamed)
+	assert.Equal(t, int64(len(log)), offsets[len(offsets)-1])
+	for i := 1; i < len(offsets); i++ {
+		assert.Greater(t, offsets[i], offsets[i-1])
+	}
+
+	offset := tailLinesOffset(log, 2)
+	streamed, _ = collect(offset)
+	assert.Equal(t, "second line with ünïcödé\nthird line\n", streamed)
+
+	// Resume from the offset of a chunk
+	streamed, _ = collect(offsets[4])
+	assert.Equal(t, log[offsets[4]:], streamed)
+
+	// Errors of the reader are returned, except io.EOF
+	err := streamJobLog(iotest.ErrReader(errors.New("connection reset")), 0, func(string, int64) error { return nil })
+	assert.Error(t, err)
+}
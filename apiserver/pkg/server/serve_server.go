@@ -2,11 +2,13 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"github.com/ray-project/kuberay/apiserver/pkg/manager"
 	"github.com/ray-project/kuberay/apiserver/pkg/model"
 	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 	corev1 "k8s.io/api/core/v1"
 	klog "k8s.io/klog/v2"
@@ -36,10 +38,13 @@ func (s *RayServiceServer) CreateRayService(ctx context.Context, request *api.Cr
 
 	request.Service.Namespace = request.Namespace
 
-	rayService, err := s.resourceManager.CreateService(ctx, request.Service)
+	rayService, err := s.resourceManager.CreateService(ctx, request.Service, request.DryRun)
 	if err != nil {
 		return nil, util.Wrap(err, "Create ray service failed.")
 	}
+	if request.DryRun {
+		return dryRunService(rayService)
+	}
 	events, err := s.resourceManager.GetServiceEvents(ctx, *rayService)
 	if err != nil {
 		klog.Warningf("failed to get rayService's event, service: %s/%s, err: %v", rayService.Namespace, rayService.Name, err)
@@ -53,10 +58,13 @@ func (s *RayServiceServer) UpdateRayService(ctx context.Context, request *api.Up
 	}
 	request.Service.Namespace = request.Namespace
 
-	rayService, err := s.resourceManager.UpdateRayService(ctx, request.Service)
+	rayService, err := s.resourceManager.UpdateRayService(ctx, request.Service, request.DryRun)
 	if err != nil {
 		return nil, util.Wrap(err, "Update ray service failed.")
 	}
+	if request.DryRun {
+		return dryRunService(rayService)
+	}
 	events, err := s.resourceManager.GetServiceEvents(ctx, *rayService)
 	if err != nil {
 		klog.Warningf("failed to get rayService's event, service: %s/%s, err: %v", rayService.Namespace, rayService.Name, err)
@@ -83,14 +91,55 @@ func (s *RayServiceServer) GetRayService(ctx context.Context, request *api.GetRa
 	return model.FromCrdToApiService(service, events), nil
 }
 
+// Returns the RayService of a ray service as YAML.
+func (s *RayServiceServer) GetRayServiceManifest(ctx context.Context, request *api.GetRayServiceManifestRequest) (*api.GetRayServiceManifestResponse, error) {
+	if request.Name == "" {
+		return nil, util.NewInvalidInputError("ray service name is empty. Please specify a valid value.")
+	}
+
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("ray service namespace is empty. Please specify a valid value.")
+	}
+	service, err := s.resourceManager.GetService(ctx, request.Name, request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "get ray service manifest failed")
+	}
+	manifest, err := util.ToManifest(service, util.RayServiceKind)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to render the manifest of service (%s/%s)", service.Namespace, service.Name)
+	}
+	return &api.GetRayServiceManifestResponse{Manifest: manifest}, nil
+}
+
+// dryRunService converts the RayService rendered by a dry-run request, which has no events yet.
+func dryRunService(rayService *rayv1api.RayService) (*api.RayService, error) {
+	manifest, err := util.ToManifest(rayService, util.RayServiceKind)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to render the manifest of service (%s/%s)", rayService.Namespace, rayService.Name)
+	}
+	apiService := model.FromCrdToApiService(rayService, nil)
+	if apiService != nil {
+		apiService.Manifest = manifest
+	}
+	return apiService, nil
+}
+
 func (s *RayServiceServer) ListRayServices(ctx context.Context, request *api.ListRayServicesRequest) (*api.ListRayServicesResponse, error) {
 	if request.Namespace == "" {
 		return nil, util.NewInvalidInputError("ray service namespace is empty. Please specify a valid value.")
 	}
-	services, err := s.resourceManager.ListServices(ctx, request.Namespace)
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, request.User, request.SortBy)
+	if err != nil {
+		return nil, util.Wrap(err, "failed to list rayservice.")
+	}
+	if err := opts.ValidateFilter("service_status", request.ServiceStatus); err != nil {
+		return nil, util.Wrap(err, "failed to list rayservice.")
+	}
+	services, nextPageToken, err := s.resourceManager.ListServices(ctx, request.Namespace, opts)
 	if err != nil {
 		return nil, util.Wrap(err, "failed to list rayservice.")
 	}
+	services = filterServicesByStatus(services, request.ServiceStatus)
 	serviceEventMap := make(map[string][]corev1.Event)
 	for _, service := range services {
 		serviceEvents, err := s.resourceManager.GetServiceEvents(ctx, *service)
@@ -101,15 +150,24 @@ func (s *RayServiceServer) ListRayServices(ctx context.Context, request *api.Lis
 		serviceEventMap[service.Name] = serviceEvents
 	}
 	return &api.ListRayServicesResponse{
-		Services: model.FromCrdToApiServices(services, serviceEventMap),
+		Services:      model.FromCrdToApiServices(services, serviceEventMap),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (s *RayServiceServer) ListAllRayServices(ctx context.Context, request *api.ListAllRayServicesRequest) (*api.ListAllRayServicesResponse, error) {
-	services, err := s.resourceManager.ListAllServices(ctx)
+	opts, err := util.NewListOptions(request.PageToken, request.PageSize, request.LabelSelector, request.User, request.SortBy)
+	if err != nil {
+		return nil, util.Wrap(err, "list all services failed.")
+	}
+	if err := opts.ValidateFilter("service_status", request.ServiceStatus); err != nil {
+		return nil, util.Wrap(err, "list all services failed.")
+	}
+	services, nextPageToken, err := s.resourceManager.ListAllServices(ctx, opts)
 	if err != nil {
 		return nil, util.Wrap(err, "list all services failed.")
 	}
+	services = filterServicesByStatus(services, request.ServiceStatus)
 	serviceEventMap := make(map[string][]corev1.Event)
 	for _, service := range services {
 		serviceEvents, err := s.resourceManager.GetServiceEvents(ctx, *service)
@@ -120,10 +178,49 @@ func (s *RayServiceServer) ListAllRayServices(ctx context.Context, request *api.
 		serviceEventMap[service.Name] = serviceEvents
 	}
 	return &api.ListAllRayServicesResponse{
-		Services: model.FromCrdToApiServices(services, serviceEventMap),
+		Services:      model.FromCrdToApiServices(services, serviceEventMap),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+func (s *RayServiceServer) WatchRayServices(request *api.WatchRayServicesRequest, stream api.RayServeService_WatchRayServicesServer) error {
+	opts, err := util.NewListOptions("", 0, request.LabelSelector, request.User, "")
+	if err != nil {
+		return util.Wrap(err, "watch rayservices failed.")
+	}
+
+	ctx := stream.Context()
+	watcher, err := s.resourceManager.WatchServices(ctx, request.Namespace, request.ResourceVersion, opts)
+	if err != nil {
+		return util.Wrap(err, "watch rayservices failed.")
+	}
+	return streamWatchEvents(ctx, watcher, func(eventType api.WatchEventType, service *rayv1api.RayService) error {
+		response := &api.WatchRayServicesResponse{
+			Type:            eventType,
+			ResourceVersion: service.ResourceVersion,
+		}
+		// Bookmarks only carry the resource version to resume the watch from.
+		if eventType != api.WatchEventType_BOOKMARK {
+			response.Service = model.FromCrdToApiService(service, nil)
+		}
+		return stream.Send(response)
+	})
+}
+
+// filterServicesByStatus keeps the services with the given service status. All services are kept if the status is empty.
+func filterServicesByStatus(services []*rayv1api.RayService, status string) []*rayv1api.RayService {
+	if status == "" {
+		return services
+	}
+	var result []*rayv1api.RayService
+	for _, service := range services {
+		if strings.EqualFold(string(service.Status.ServiceStatus), status) {
+			result = append(result, service)
+		}
+	}
+	return result
+}
+
 func (s *RayServiceServer) DeleteRayService(ctx context.Context, request *api.DeleteRayServiceRequest) (*emptypb.Empty, error) {
 	if request.Name == "" {
 		return nil, util.NewInvalidInputError("ray service name is empty. Please specify a valid value.")
@@ -190,6 +287,10 @@ func ValidateUpdateServiceRequest(request *api.UpdateRayServiceRequest) error {
 		return util.NewInvalidInputError("Service name is empty. Please specify a valid value.")
 	}
 
+	if request.Name != request.Service.Name {
+		return util.NewInvalidInputError("The name in the request is different from the name in the service definition.")
+	}
+
 	if request.Service.User == "" {
 		return util.NewInvalidInputError("User who create the Service is empty. Please specify a valid value.")
 	}
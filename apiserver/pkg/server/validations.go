@@ -20,20 +20,35 @@ func ValidateClusterSpec(clusterSpec *api.ClusterSpec) error {
 	if len(clusterSpec.HeadGroupSpec.RayStartParams) == 0 {
 		return util.NewInvalidInputError("HeadGroupSpec RayStartParams is empty. Please specify values.")
 	}
+	if len(clusterSpec.HeadGroupSpec.Image) != 0 && len(clusterSpec.HeadGroupSpec.ImageTemplate) != 0 {
+		return util.NewInvalidInputError("HeadGroupSpec image and image template are mutually exclusive. Please specify only one of them.")
+	}
+
+	return validateWorkerGroupSpecs(clusterSpec.WorkerGroupSpec)
+}
 
-	for index, spec := range clusterSpec.WorkerGroupSpec {
+func validateWorkerGroupSpecs(workerGroupSpecs []*api.WorkerGroupSpec) error {
+	groupNames := map[string]bool{}
+	for index, spec := range workerGroupSpecs {
 		if len(spec.GroupName) == 0 {
 			return util.NewInvalidInputError("WorkerNodeSpec %d group name is empty. Please specify a valid value.", index)
 		}
 		if len(spec.ComputeTemplate) == 0 {
 			return util.NewInvalidInputError("WorkerNodeSpec %d compute template is empty. Please specify a valid value.", index)
 		}
+		if len(spec.Image) != 0 && len(spec.ImageTemplate) != 0 {
+			return util.NewInvalidInputError("WorkerNodeSpec %d image and image template are mutually exclusive. Please specify only one of them.", index)
+		}
 		if spec.MaxReplicas == 0 {
 			return util.NewInvalidInputError("WorkerNodeSpec %d MaxReplicas can not be 0. Please specify a valid value.", index)
 		}
 		if spec.MinReplicas > spec.MaxReplicas {
 			return util.NewInvalidInputError("WorkerNodeSpec %d MinReplica > MaxReplicas. Please specify a valid value.", index)
 		}
+		if groupNames[spec.GroupName] {
+			return util.NewInvalidInputError("WorkerNodeSpec %d group name %s is duplicated. Please specify a unique value.", index, spec.GroupName)
+		}
+		groupNames[spec.GroupName] = true
 	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/ray-project/kuberay/apiserver/pkg/util"
 	api "github.com/ray-project/kuberay/proto/go_client"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestValidateClusterSpec(t *testing.T) {
@@ -189,6 +190,66 @@ func TestValidateClusterSpec(t *testing.T) {
 			},
 			expectedError: util.NewInvalidInputError("WorkerNodeSpec 0 MinReplica > MaxReplicas. Please specify a valid value."),
 		},
+		{
+			name: "A head group spec with both image and image template",
+			clusterSpec: &api.ClusterSpec{
+				HeadGroupSpec: &api.HeadGroupSpec{
+					ComputeTemplate: "a template",
+					Image:           "rayproject/ray:2.7.0",
+					ImageTemplate:   "an image template",
+					RayStartParams: map[string]string{
+						"dashboard-host": "0.0.0.0",
+					},
+				},
+			},
+			expectedError: util.NewInvalidInputError("HeadGroupSpec image and image template are mutually exclusive. Please specify only one of them."),
+		},
+		{
+			name: "A worker group spec with both image and image template",
+			clusterSpec: &api.ClusterSpec{
+				HeadGroupSpec: &api.HeadGroupSpec{
+					ComputeTemplate: "a template",
+					ImageTemplate:   "an image template",
+					RayStartParams: map[string]string{
+						"dashboard-host": "0.0.0.0",
+					},
+				},
+				WorkerGroupSpec: []*api.WorkerGroupSpec{
+					{
+						GroupName:       "group 1",
+						ComputeTemplate: "a template",
+						Image:           "rayproject/ray:2.7.0",
+						ImageTemplate:   "an image template",
+						MaxReplicas:     1,
+					},
+				},
+			},
+			expectedError: util.NewInvalidInputError("WorkerNodeSpec 0 image and image template are mutually exclusive. Please specify only one of them."),
+		},
+		{
+			name: "Worker group specs with a duplicated group name",
+			clusterSpec: &api.ClusterSpec{
+				HeadGroupSpec: &api.HeadGroupSpec{
+					ComputeTemplate: "a template",
+					RayStartParams: map[string]string{
+						"dashboard-host": "0.0.0.0",
+					},
+				},
+				WorkerGroupSpec: []*api.WorkerGroupSpec{
+					{
+						GroupName:       "group-1",
+						ComputeTemplate: "a template",
+						MaxReplicas:     1,
+					},
+					{
+						GroupName:       "group-1",
+						ComputeTemplate: "a template",
+						MaxReplicas:     1,
+					},
+				},
+			},
+			expectedError: util.NewInvalidInputError("WorkerNodeSpec 1 group name group-1 is duplicated. Please specify a unique value."),
+		},
 	}
 	// Execute tests sequentially
 	for _, tc := range tests {
@@ -337,3 +398,383 @@ func TestValidateCreateServiceRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUpdateServiceRequest(t *testing.T) {
+	validService := func() *api.RayService {
+		return &api.RayService{
+			Name:           "a-name",
+			Namespace:      "a-namespace",
+			User:           "a-user",
+			ServeConfig_V2: "some yaml",
+			ClusterSpec: &api.ClusterSpec{
+				HeadGroupSpec: &api.HeadGroupSpec{
+					ComputeTemplate: "a compute template name",
+					RayStartParams: map[string]string{
+						"dashboard-host": "0.0.0.0",
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name          string
+		request       *api.UpdateRayServiceRequest
+		expectedError error
+	}{
+		{
+			name: "A valid update service request",
+			request: &api.UpdateRayServiceRequest{
+				Service:   validService(),
+				Namespace: "a-namespace",
+				Name:      "a-name",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "An update service request with mismatching names",
+			request: &api.UpdateRayServiceRequest{
+				Service:   validService(),
+				Namespace: "a-namespace",
+				Name:      "another-name",
+			},
+			expectedError: util.NewInvalidInputError("The name in the request is different from the name in the service definition."),
+		},
+	}
+	// Execute tests sequentially
+	for _, tc := range tests {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := server.ValidateUpdateServiceRequest(tc.request)
+			if tc.expectedError == nil {
+				require.NoError(t, actualError, "No error expected.")
+			} else {
+				require.EqualError(t, actualError, tc.expectedError.Error(), "A matching error is expected")
+			}
+		})
+	}
+}
+
+func TestValidatePatchClusterRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *api.PatchClusterRequest
+		expectedError error
+	}{
+		{
+			name: "A valid patch cluster request",
+			request: &api.PatchClusterRequest{
+				Name:      "a-name",
+				Namespace: "a-namespace",
+				Cluster: &api.Cluster{
+					Suspend: true,
+					ClusterSpec: &api.ClusterSpec{
+						WorkerGroupSpec: []*api.WorkerGroupSpec{
+							{
+								GroupName:   "group-1",
+								Replicas:    2,
+								MaxReplicas: 4,
+							},
+						},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"suspend", "cluster_spec.worker_group_spec"}},
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "An empty patch cluster request",
+			request:       &api.PatchClusterRequest{},
+			expectedError: util.NewInvalidInputError("Cluster name is empty. Please specify a valid value."),
+		},
+		{
+			name: "A patch cluster request without a cluster",
+			request: &api.PatchClusterRequest{
+				Name:      "a-name",
+				Namespace: "a-namespace",
+			},
+			expectedError: util.NewInvalidInputError("Cluster is empty. Please input a valid payload."),
+		},
+		{
+			name: "A patch cluster request without an update mask",
+			request: &api.PatchClusterRequest{
+				Name:      "a-name",
+				Namespace: "a-namespace",
+				Cluster:   &api.Cluster{Suspend: true},
+			},
+			expectedError: util.NewInvalidInputError("Update mask is empty. Please specify the fields to update."),
+		},
+		{
+			name: "A patch cluster request with a duplicated worker group",
+			request: &api.PatchClusterRequest{
+				Name:      "a-name",
+				Namespace: "a-namespace",
+				Cluster: &api.Cluster{
+					ClusterSpec: &api.ClusterSpec{
+						WorkerGroupSpec: []*api.WorkerGroupSpec{
+							{GroupName: "group-1", MaxReplicas: 1},
+							{GroupName: "group-1", MaxReplicas: 2},
+						},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cluster_spec.worker_group_spec"}},
+			},
+			expectedError: util.NewInvalidInputError("WorkerNodeSpec 1 group name group-1 is duplicated. Please specify a unique value."),
+		},
+		{
+			name: "A patch cluster request with min replicas greater than max replicas",
+			request: &api.PatchClusterRequest{
+				Name:      "a-name",
+				Namespace: "a-namespace",
+				Cluster: &api.Cluster{
+					ClusterSpec: &api.ClusterSpec{
+						WorkerGroupSpec: []*api.WorkerGroupSpec{
+							{GroupName: "group-1", MinReplicas: 3, MaxReplicas: 2},
+						},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cluster_spec.worker_group_spec"}},
+			},
+			expectedError: util.NewInvalidInputError("WorkerNodeSpec 0 MinReplica > MaxReplicas. Please specify a valid value."),
+		},
+	}
+	// Execute tests sequentially
+	for _, tc := range tests {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := server.ValidatePatchClusterRequest(tc.request)
+			if tc.expectedError == nil {
+				require.NoError(t, actualError, "No error expected.")
+			} else {
+				require.EqualError(t, actualError, tc.expectedError.Error(), "A matching error is expected")
+			}
+		})
+	}
+}
+
+func TestValidateCreateJobRequest(t *testing.T) {
+	newRequest := func(update func(job *api.RayJob)) *api.CreateRayJobRequest {
+		job := &api.RayJob{
+			Name:            "a-name",
+			Namespace:       "a-namespace",
+			User:            "a-user",
+			Entrypoint:      "python sample_code.py",
+			ClusterSelector: map[string]string{"ray.io/cluster": "a-cluster"},
+		}
+		update(job)
+		return &api.CreateRayJobRequest{Namespace: "a-namespace", Job: job}
+	}
+	tests := []struct {
+		name          string
+		request       *api.CreateRayJobRequest
+		expectedError error
+	}{
+		{
+			name: "A valid create job request",
+			request: newRequest(func(job *api.RayJob) {
+				job.SubmissionMode = "HTTPMode"
+				job.ActiveDeadlineSeconds = 600
+				job.RuntimeEnvSpec = &api.RuntimeEnv{Pip: []string{"requests"}}
+			}),
+			expectedError: nil,
+		},
+		{
+			name: "A job with a cluster pool",
+			request: newRequest(func(job *api.RayJob) {
+				job.ClusterSelector = nil
+				job.RayClusterPoolName = "a-pool"
+			}),
+			expectedError: nil,
+		},
+		{
+			name: "A job with an unknown submission mode",
+			request: newRequest(func(job *api.RayJob) {
+				job.SubmissionMode = "SSHMode"
+			}),
+			expectedError: util.NewInvalidInputError("Job submission mode SSHMode is not supported. Please specify K8sJobMode or HTTPMode."),
+		},
+		{
+			name: "A job with a negative active deadline",
+			request: newRequest(func(job *api.RayJob) {
+				job.ActiveDeadlineSeconds = -1
+			}),
+			expectedError: util.NewInvalidInputError("Job active deadline seconds can not be negative. Please specify a valid value."),
+		},
+		{
+			name: "A job with both runtime env and runtime env spec",
+			request: newRequest(func(job *api.RayJob) {
+				job.RuntimeEnv = "pip:\n  - requests\n"
+				job.RuntimeEnvSpec = &api.RuntimeEnv{Pip: []string{"requests"}}
+			}),
+			expectedError: util.NewInvalidInputError("Job runtime env and runtime env spec are mutually exclusive. Please specify only one of them."),
+		},
+		{
+			name: "A job with both pip and conda",
+			request: newRequest(func(job *api.RayJob) {
+				job.RuntimeEnvSpec = &api.RuntimeEnv{Pip: []string{"requests"}, Conda: &api.RuntimeEnvConda{Name: "env"}}
+			}),
+			expectedError: util.NewInvalidInputError("Job runtime env pip and conda are mutually exclusive. Please specify only one of them."),
+		},
+		{
+			name: "A job with a runtime env var with both a value and a value from",
+			request: newRequest(func(job *api.RayJob) {
+				job.RuntimeEnvSpec = &api.RuntimeEnv{EnvVars: []*api.RuntimeEnvVar{{
+					Name:      "TOKEN",
+					Value:     "token",
+					ValueFrom: &api.EnvValueFrom{Source: api.EnvValueFrom_SECRET, Name: "tokens", Key: "token"},
+				}}}
+			}),
+			expectedError: util.NewInvalidInputError("Job runtime env var TOKEN can not have both a value and a value from. Please specify only one of them."),
+		},
+		{
+			name: "A job with both a cluster pool and a cluster selector",
+			request: newRequest(func(job *api.RayJob) {
+				job.RayClusterPoolName = "a-pool"
+			}),
+			expectedError: util.NewInvalidInputError("Job cluster pool can not be set together with a cluster selector or a cluster spec."),
+		},
+	}
+	// Execute tests sequentially
+	for _, tc := range tests {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := server.ValidateCreateJobRequest(tc.request)
+			if tc.expectedError == nil {
+				require.NoError(t, actualError, "No error expected.")
+			} else {
+				require.EqualError(t, actualError, tc.expectedError.Error(), "A matching error is expected")
+			}
+		})
+	}
+}
+
+func TestValidateCreateComputeTemplateRequest(t *testing.T) {
+	newRequest := func(modify func(template *api.ComputeTemplate)) *api.CreateComputeTemplateRequest {
+		template := &api.ComputeTemplate{
+			Name:      "a-template",
+			Namespace: "a-namespace",
+			Cpu:       2,
+			Memory:    8,
+		}
+		modify(template)
+		return &api.CreateComputeTemplateRequest{ComputeTemplate: template, Namespace: "a-namespace"}
+	}
+	tests := []struct {
+		name          string
+		request       *api.CreateComputeTemplateRequest
+		expectedError error
+	}{
+		{
+			name: "A valid compute template with scheduling options",
+			request: newRequest(func(template *api.ComputeTemplate) {
+				template.CpuLimit = 4
+				template.MemoryLimit = 16
+				template.Gpu = 1
+				template.ExtendedResources = map[string]string{"rdma/hca": "1", "google.com/tpu": "4"}
+				template.NodeSelector = map[string]string{"cloud.google.com/gke-nodepool": "gpu-pool"}
+				template.PriorityClassName = "high-priority"
+				template.SharedMemory = "16Gi"
+				template.Affinity = &api.PodAffinity{
+					NodeAffinity: []*api.NodeAffinityTerm{
+						{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "zone", Operator: "In", Values: []string{"a"}}}},
+						{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "generation", Operator: "Gt", Values: []string{"3"}}}, Weight: 10},
+					},
+					PodAntiAffinity: []*api.PodAffinityTerm{
+						{MatchLabels: map[string]string{"app": "ray"}, TopologyKey: "kubernetes.io/hostname"},
+					},
+				}
+			}),
+			expectedError: nil,
+		},
+		{
+			name:          "A cpu limit smaller than the cpu amount",
+			request:       newRequest(func(template *api.ComputeTemplate) { template.CpuLimit = 1 }),
+			expectedError: util.NewInvalidInputError("Cpu limit 1 is smaller than the cpu amount 2. Please specify a valid value."),
+		},
+		{
+			name:          "A memory limit smaller than the memory amount",
+			request:       newRequest(func(template *api.ComputeTemplate) { template.MemoryLimit = 4 }),
+			expectedError: util.NewInvalidInputError("Memory limit 4 is smaller than the memory amount 8. Please specify a valid value."),
+		},
+		{
+			name:          "An extended resource conflicting with cpu",
+			request:       newRequest(func(template *api.ComputeTemplate) { template.ExtendedResources = map[string]string{"cpu": "1"} }),
+			expectedError: util.NewInvalidInputError("Extended resource cpu conflicts with the cpu amount. Please use the cpu field."),
+		},
+		{
+			name: "An extended resource conflicting with the gpu accelerator",
+			request: newRequest(func(template *api.ComputeTemplate) {
+				template.Gpu = 1
+				template.ExtendedResources = map[string]string{"nvidia.com/gpu": "2"}
+			}),
+			expectedError: util.NewInvalidInputError("Extended resource nvidia.com/gpu conflicts with the gpu amount. Please use either gpu or extended resources."),
+		},
+		{
+			name:          "An invalid extended resource quantity",
+			request:       newRequest(func(template *api.ComputeTemplate) { template.ExtendedResources = map[string]string{"rdma/hca": "one"} }),
+			expectedError: util.NewInvalidInputError("Extended resource rdma/hca quantity one is invalid. Please specify a positive quantity."),
+		},
+		{
+			name:          "An invalid shared memory size",
+			request:       newRequest(func(template *api.ComputeTemplate) { template.SharedMemory = "lots" }),
+			expectedError: util.NewInvalidInputError("Shared memory lots is invalid. Please specify a positive quantity, e.g. 8Gi."),
+		},
+		{
+			name:          "A shared memory size exceeding the memory limit",
+			request:       newRequest(func(template *api.ComputeTemplate) { template.SharedMemory = "10Gi" }),
+			expectedError: util.NewInvalidInputError("Shared memory 10Gi exceeds the memory limit 8Gi. Please specify a valid value."),
+		},
+		{
+			name:          "An invalid priority class name",
+			request:       newRequest(func(template *api.ComputeTemplate) { template.PriorityClassName = "High_Priority" }),
+			expectedError: util.NewInvalidInputError("Priority class name High_Priority is invalid: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+		},
+		{
+			name: "A node affinity expression with an invalid operator",
+			request: newRequest(func(template *api.ComputeTemplate) {
+				template.Affinity = &api.PodAffinity{NodeAffinity: []*api.NodeAffinityTerm{
+					{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "zone", Operator: "Equals", Values: []string{"a"}}}},
+				}}
+			}),
+			expectedError: util.NewInvalidInputError("Node affinity term 0 expression zone operator Equals is invalid. Please use one of In, NotIn, Exists, DoesNotExist, Gt and Lt."),
+		},
+		{
+			name: "A node affinity expression with values for Exists",
+			request: newRequest(func(template *api.ComputeTemplate) {
+				template.Affinity = &api.PodAffinity{NodeAffinity: []*api.NodeAffinityTerm{
+					{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "zone", Operator: "Exists", Values: []string{"a"}}}},
+				}}
+			}),
+			expectedError: util.NewInvalidInputError("Node affinity term 0 expression zone with operator Exists must not have values."),
+		},
+		{
+			name: "A node affinity term with an invalid weight",
+			request: newRequest(func(template *api.ComputeTemplate) {
+				template.Affinity = &api.PodAffinity{NodeAffinity: []*api.NodeAffinityTerm{
+					{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "zone", Operator: "Exists"}}, Weight: 101},
+				}}
+			}),
+			expectedError: util.NewInvalidInputError("Node affinity term 0 weight 101 is out of range. Please specify a value between 1 and 100, or 0 for a required term."),
+		},
+		{
+			name: "A pod anti-affinity term without topology key",
+			request: newRequest(func(template *api.ComputeTemplate) {
+				template.Affinity = &api.PodAffinity{PodAntiAffinity: []*api.PodAffinityTerm{
+					{MatchLabels: map[string]string{"app": "ray"}},
+				}}
+			}),
+			expectedError: util.NewInvalidInputError("Pod anti-affinity term 0 topology key is empty. Please specify a valid value."),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := server.ValidateCreateComputeTemplateRequest(tc.request)
+			if tc.expectedError == nil {
+				require.NoError(t, actualError, "No error expected.")
+			} else {
+				require.EqualError(t, actualError, tc.expectedError.Error(), "A matching error is expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/model"
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	klog "k8s.io/klog/v2"
+)
+
+// streamWatchEvents sends the events of the Kubernetes watch to the client until the watch ends or the client goes
+// away. The watch is stopped when it returns. Bookmark events are sent too, so that the client can resume the watch
+// from a recent resource version even if the watched objects don't change.
+func streamWatchEvents[T runtime.Object](ctx context.Context, watcher watch.Interface, send func(api.WatchEventType, T) error) error {
+	defer watcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// The Kubernetes watch timed out, the client resumes it from the last resource version.
+				return nil
+			}
+			if event.Type == watch.Error {
+				err := errors.FromObject(event.Object)
+				if errors.IsResourceExpired(err) || errors.IsGone(err) {
+					return util.NewInvalidInputError("Resource version is too old. Please watch again without a resource version.")
+				}
+				return util.NewInternalServerError(err, "Watch failed")
+			}
+			eventType, ok := model.FromKubeToAPIWatchEventType(event.Type)
+			if !ok {
+				continue
+			}
+			object, ok := event.Object.(T)
+			if !ok {
+				klog.Warningf("Unexpected object type %T in watch event %s", event.Object, event.Type)
+				continue
+			}
+			if err := send(eventType, object); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ray-project/kuberay/apiserver/pkg/util"
+	api "github.com/ray-project/kuberay/proto/go_client"
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type sentWatchEvent struct {
+	eventType       api.WatchEventType
+	name            string
+	resourceVersion string
+}
+
+func newRayCluster(name string, resourceVersion string) *rayv1api.RayCluster {
+	return &rayv1api.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: resourceVersion}}
+}
+
+func TestStreamWatchEvents(t *testing.T) {
+	watcher := watch.NewFakeWithChanSize(10, false)
+	watcher.Add(newRayCluster("cluster-1", "1"))
+	watcher.Modify(newRayCluster("cluster-1", "2"))
+	// Objects of another type are skipped.
+	watcher.Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", ResourceVersion: "3"}})
+	watcher.Action(watch.Bookmark, newRayCluster("", "4"))
+	watcher.Delete(newRayCluster("cluster-1", "5"))
+	watcher.Stop()
+
+	var sent []sentWatchEvent
+	err := streamWatchEvents(context.Background(), watcher, func(eventType api.WatchEventType, cluster *rayv1api.RayCluster) error {
+		sent = append(sent, sentWatchEvent{eventType, cluster.Name, cluster.ResourceVersion})
+		return nil
+	})
+	// The stream ends without an error when the Kubernetes watch ends, so that the client resumes it.
+	require.NoError(t, err)
+	assert.Equal(t, []sentWatchEvent{
+		{api.WatchEventType_ADDED, "cluster-1", "1"},
+		{api.WatchEventType_MODIFIED, "cluster-1", "2"},
+		{api.WatchEventType_BOOKMARK, "", "4"},
+		{api.WatchEventType_DELETED, "cluster-1", "5"},
+	}, sent)
+	assert.True(t, watcher.IsStopped())
+}
+
+func TestStreamWatchEventsErrors(t *testing.T) {
+	send := func(api.WatchEventType, *rayv1api.RayCluster) error { return nil }
+
+	// A resource version that is too old is reported as an invalid argument.
+	watcher := watch.NewFakeWithChanSize(1, false)
+	expired := apierrors.NewResourceExpired("too old resource version: 1 (100)")
+	watcher.Error(&expired.ErrStatus)
+	err := streamWatchEvents(context.Background(), watcher, send)
+	require.Error(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+	assert.True(t, watcher.IsStopped())
+
+	watcher = watch.NewFakeWithChanSize(1, false)
+	gone := apierrors.NewGone("gone")
+	watcher.Error(&gone.ErrStatus)
+	err = streamWatchEvents(context.Background(), watcher, send)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	// Other watch errors are internal errors.
+	watcher = watch.NewFakeWithChanSize(1, false)
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Group: "ray.io", Resource: "rayclusters"}, "", errors.New("denied"))
+	watcher.Error(&forbidden.ErrStatus)
+	err = streamWatchEvents(context.Background(), watcher, send)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.Internal))
+
+	// An error sending the event to the client ends the stream.
+	watcher = watch.NewFakeWithChanSize(1, false)
+	watcher.Add(newRayCluster("cluster-1", "1"))
+	sendErr := errors.New("client went away")
+	err = streamWatchEvents(context.Background(), watcher, func(api.WatchEventType, *rayv1api.RayCluster) error { return sendErr })
+	assert.Equal(t, sendErr, err)
+	assert.True(t, watcher.IsStopped())
+
+	// The stream ends when the client goes away.
+	watcher = watch.NewFake()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = streamWatchEvents(ctx, watcher, send)
+	require.NoError(t, err)
+	assert.True(t, watcher.IsStopped())
+}
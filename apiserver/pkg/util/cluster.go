@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
+	"strings"
 
 	klog "k8s.io/klog/v2"
 
@@ -22,7 +24,7 @@ type RayCluster struct {
 
 // NewRayCluster creates a RayCluster.
 // func NewRayCluster(apiCluster *api.Cluster, clusterRuntime *api.ClusterRuntime, computeRuntime *api.ComputeRuntime) *RayCluster {
-func NewRayCluster(apiCluster *api.Cluster, computeTemplateMap map[string]*api.ComputeTemplate) (*RayCluster, error) {
+func NewRayCluster(apiCluster *api.Cluster, computeTemplateMap map[string]*api.ComputeTemplate, imageTemplateMap map[string]*api.ImageTemplate) (*RayCluster, error) {
 	// Check for "ray.io/enable-serve-service=true"
 	enableServeService := false
 	if enableServeServiceValue, exist := apiCluster.Annotations["ray.io/enable-serve-service"]; exist && enableServeServiceValue == "true" {
@@ -30,10 +32,13 @@ func NewRayCluster(apiCluster *api.Cluster, computeTemplateMap map[string]*api.C
 	}
 
 	// Build cluster spec
-	spec, err := buildRayClusterSpec(apiCluster.Version, apiCluster.Envs, apiCluster.ClusterSpec, computeTemplateMap, enableServeService)
+	spec, err := buildRayClusterSpec(apiCluster.Version, apiCluster.Envs, apiCluster.ClusterSpec, computeTemplateMap, imageTemplateMap, enableServeService)
 	if err != nil {
 		return nil, err
 	}
+	if apiCluster.Suspend {
+		spec.Suspend = &apiCluster.Suspend
+	}
 	// Build cluster
 	rayCluster := &rayv1api.RayCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -70,9 +75,13 @@ func buildRayClusterAnnotations(cluster *api.Cluster) map[string]string {
 
 // TODO(Basasuya & MissionToMars): The job spec depends on ClusterSpec which not all cluster-related configs are included,
 // such as `metadata` and `envs`. We just put `imageVersion` and `envs` in the arguments list, and should be refactored later.
-func buildRayClusterSpec(imageVersion string, envs *api.EnvironmentVariables, clusterSpec *api.ClusterSpec, computeTemplateMap map[string]*api.ComputeTemplate, enableServeService bool) (*rayv1api.RayClusterSpec, error) {
+func buildRayClusterSpec(imageVersion string, envs *api.EnvironmentVariables, clusterSpec *api.ClusterSpec, computeTemplateMap map[string]*api.ComputeTemplate, imageTemplateMap map[string]*api.ImageTemplate, enableServeService bool) (*rayv1api.RayClusterSpec, error) {
 	computeTemplate := computeTemplateMap[clusterSpec.HeadGroupSpec.ComputeTemplate]
-	headPodTemplate, err := buildHeadPodTemplate(imageVersion, envs, clusterSpec.HeadGroupSpec, computeTemplate, enableServeService)
+	imageTemplate, err := getImageTemplate(imageTemplateMap, clusterSpec.HeadGroupSpec.ImageTemplate)
+	if err != nil {
+		return nil, err
+	}
+	headPodTemplate, err := buildHeadPodTemplate(imageVersion, envs, clusterSpec.HeadGroupSpec, computeTemplate, imageTemplate, enableServeService)
 	if err != nil {
 		return nil, err
 	}
@@ -93,31 +102,11 @@ func buildRayClusterSpec(imageVersion string, envs *api.EnvironmentVariables, cl
 
 	// Build worker groups
 	for _, spec := range clusterSpec.WorkerGroupSpec {
-		computeTemplate = computeTemplateMap[spec.ComputeTemplate]
-		workerPodTemplate, err := buildWorkerPodTemplate(imageVersion, envs, spec, computeTemplate)
+		workerNodeSpec, err := buildWorkerGroupSpec(imageVersion, envs, spec, computeTemplateMap, imageTemplateMap)
 		if err != nil {
 			return nil, err
 		}
-
-		minReplicas := spec.Replicas
-		maxReplicas := spec.Replicas
-		if spec.MinReplicas != 0 {
-			minReplicas = spec.MinReplicas
-		}
-		if spec.MaxReplicas != 0 {
-			maxReplicas = spec.MaxReplicas
-		}
-
-		workerNodeSpec := rayv1api.WorkerGroupSpec{
-			GroupName:      spec.GroupName,
-			MinReplicas:    intPointer(minReplicas),
-			MaxReplicas:    intPointer(maxReplicas),
-			Replicas:       intPointer(spec.Replicas),
-			RayStartParams: spec.RayStartParams,
-			Template:       *workerPodTemplate,
-		}
-
-		rayClusterSpec.WorkerGroupSpecs = append(rayClusterSpec.WorkerGroupSpecs, workerNodeSpec)
+		rayClusterSpec.WorkerGroupSpecs = append(rayClusterSpec.WorkerGroupSpecs, *workerNodeSpec)
 	}
 
 	if clusterSpec.EnableInTreeAutoscaling {
@@ -135,24 +124,173 @@ func buildRayClusterSpec(imageVersion string, envs *api.EnvironmentVariables, cl
 	return rayClusterSpec, nil
 }
 
+// Build a worker group
+func buildWorkerGroupSpec(imageVersion string, envs *api.EnvironmentVariables, spec *api.WorkerGroupSpec, computeTemplateMap map[string]*api.ComputeTemplate, imageTemplateMap map[string]*api.ImageTemplate) (*rayv1api.WorkerGroupSpec, error) {
+	computeTemplate := computeTemplateMap[spec.ComputeTemplate]
+	imageTemplate, err := getImageTemplate(imageTemplateMap, spec.ImageTemplate)
+	if err != nil {
+		return nil, err
+	}
+	workerPodTemplate, err := buildWorkerPodTemplate(imageVersion, envs, spec, computeTemplate, imageTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	workerNodeSpec := &rayv1api.WorkerGroupSpec{
+		GroupName:      spec.GroupName,
+		RayStartParams: spec.RayStartParams,
+		Template:       *workerPodTemplate,
+	}
+	setWorkerGroupReplicas(workerNodeSpec, spec)
+	return workerNodeSpec, nil
+}
+
+// setWorkerGroupReplicas sets the replicas of a worker group. Min and max replicas default to the replicas.
+func setWorkerGroupReplicas(workerGroupSpec *rayv1api.WorkerGroupSpec, spec *api.WorkerGroupSpec) {
+	minReplicas := spec.Replicas
+	maxReplicas := spec.Replicas
+	if spec.MinReplicas != 0 {
+		minReplicas = spec.MinReplicas
+	}
+	if spec.MaxReplicas != 0 {
+		maxReplicas = spec.MaxReplicas
+	}
+
+	workerGroupSpec.MinReplicas = intPointer(minReplicas)
+	workerGroupSpec.MaxReplicas = intPointer(maxReplicas)
+	workerGroupSpec.Replicas = intPointer(spec.Replicas)
+}
+
+// PatchRayClusterWorkerGroupSpecs returns the worker groups of a RayCluster patched to the given ones, matched by
+// group name. Existing groups keep their pod template and only update their replicas, new groups are built from
+// the templates, and the groups that are not given are removed.
+func PatchRayClusterWorkerGroupSpecs(imageVersion string, envs *api.EnvironmentVariables, specs []*api.WorkerGroupSpec, workerGroupSpecs []rayv1api.WorkerGroupSpec, computeTemplateMap map[string]*api.ComputeTemplate, imageTemplateMap map[string]*api.ImageTemplate) ([]rayv1api.WorkerGroupSpec, error) {
+	existing := map[string]rayv1api.WorkerGroupSpec{}
+	for _, workerGroupSpec := range workerGroupSpecs {
+		existing[workerGroupSpec.GroupName] = workerGroupSpec
+	}
+
+	patched := []rayv1api.WorkerGroupSpec{}
+	for _, spec := range specs {
+		if workerGroupSpec, ok := existing[spec.GroupName]; ok {
+			workerGroupSpec = *workerGroupSpec.DeepCopy()
+			setWorkerGroupReplicas(&workerGroupSpec, spec)
+			patched = append(patched, workerGroupSpec)
+			continue
+		}
+		workerGroupSpec, err := buildWorkerGroupSpec(imageVersion, envs, spec, computeTemplateMap, imageTemplateMap)
+		if err != nil {
+			return nil, err
+		}
+		patched = append(patched, *workerGroupSpec)
+	}
+	return patched, nil
+}
+
 // Annotations common to both head and worker nodes
-func buildNodeGroupAnnotations(computeTemplate *api.ComputeTemplate, image string) map[string]string {
+func buildNodeGroupAnnotations(computeTemplate *api.ComputeTemplate, image string, imageTemplate *api.ImageTemplate) map[string]string {
 	annotations := map[string]string{}
 	annotations[RayClusterComputeTemplateAnnotationKey] = computeTemplate.Name
 	annotations[RayClusterImageAnnotationKey] = image
+	if imageTemplate != nil {
+		annotations[RayClusterImageTemplateAnnotationKey] = imageTemplate.Name
+	}
 	return annotations
 }
 
+// getImageTemplate returns the image template with the given name. It returns nil if the name is empty.
+func getImageTemplate(imageTemplateMap map[string]*api.ImageTemplate, name string) (*api.ImageTemplate, error) {
+	if name == "" {
+		return nil, nil
+	}
+	imageTemplate, ok := imageTemplateMap[name]
+	if !ok {
+		return nil, fmt.Errorf("image template %s is not found", name)
+	}
+	return imageTemplate, nil
+}
+
+// getImageTemplateImage returns the image of the image template: the prebuilt image if it is set, otherwise the base image.
+func getImageTemplateImage(imageTemplate *api.ImageTemplate) string {
+	if imageTemplate.Image != "" {
+		return imageTemplate.Image
+	}
+	return imageTemplate.BaseImage
+}
+
+// buildImageTemplateEnv converts the environment variables of the image template, sorted by name.
+func buildImageTemplateEnv(imageTemplate *api.ImageTemplate) []corev1.EnvVar {
+	names := make([]string, 0, len(imageTemplate.EnvironmentVariables))
+	for name := range imageTemplate.EnvironmentVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	env := make([]corev1.EnvVar, 0, len(names))
+	for _, name := range names {
+		env = append(env, corev1.EnvVar{Name: name, Value: imageTemplate.EnvironmentVariables[name]})
+	}
+	return env
+}
+
+// buildImageTemplateSetupCommand returns the command that installs the packages of the image template and runs its
+// custom commands. The KubeRay operator runs it in the Ray container before `ray start`. A prebuilt image already
+// contains the packages, so the command is empty if the image template has one.
+func buildImageTemplateSetupCommand(imageTemplate *api.ImageTemplate) string {
+	if imageTemplate.Image != "" {
+		return ""
+	}
+
+	var commands []string
+	if len(imageTemplate.SystemPackages) > 0 {
+		commands = append(commands, "sudo apt-get update", "sudo apt-get install -y "+shellQuoteAll(imageTemplate.SystemPackages))
+	}
+	if len(imageTemplate.CondaPackages) > 0 {
+		commands = append(commands, "conda install -y "+shellQuoteAll(imageTemplate.CondaPackages))
+	}
+	if len(imageTemplate.PipPackages) > 0 {
+		commands = append(commands, "pip install "+shellQuoteAll(imageTemplate.PipPackages))
+	}
+	if imageTemplate.CustomCommands != "" {
+		commands = append(commands, imageTemplate.CustomCommands)
+	}
+	return strings.Join(commands, " && ")
+}
+
+// shellQuoteAll quotes each value so that package specifiers like `numpy>=1.24` are not interpreted by the shell.
+func shellQuoteAll(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, "'"+strings.ReplaceAll(value, "'", `'\''`)+"'")
+	}
+	return strings.Join(quoted, " ")
+}
+
+// applyImageTemplate adds the environment variables and the setup command of the image template to the Ray container.
+func applyImageTemplate(container *corev1.Container, imageTemplate *api.ImageTemplate) {
+	if imageTemplate == nil {
+		return
+	}
+	container.Env = append(container.Env, buildImageTemplateEnv(imageTemplate)...)
+	if setupCommand := buildImageTemplateSetupCommand(imageTemplate); setupCommand != "" {
+		container.Args = []string{setupCommand}
+	}
+}
+
 // Build head node template
-func buildHeadPodTemplate(imageVersion string, envs *api.EnvironmentVariables, spec *api.HeadGroupSpec, computeRuntime *api.ComputeTemplate, enableServeService bool) (*corev1.PodTemplateSpec, error) {
+func buildHeadPodTemplate(imageVersion string, envs *api.EnvironmentVariables, spec *api.HeadGroupSpec, computeRuntime *api.ComputeTemplate, imageTemplate *api.ImageTemplate, enableServeService bool) (*corev1.PodTemplateSpec, error) {
 	image := constructRayImage(RayClusterDefaultImageRepository, imageVersion)
 	if len(spec.Image) != 0 {
 		image = spec.Image
+	} else if imageTemplate != nil {
+		image = getImageTemplateImage(imageTemplate)
 	}
 
 	// calculate resources
-	cpu := fmt.Sprint(computeRuntime.GetCpu())
-	memory := fmt.Sprintf("%d%s", computeRuntime.GetMemory(), "Gi")
+	resources, err := buildResourceRequirements(computeRuntime)
+	if err != nil {
+		return nil, err
+	}
 
 	// build volume and volumeMounts
 	volMounts := buildVolumeMounts(spec.Volumes)
@@ -163,7 +301,7 @@ func buildHeadPodTemplate(imageVersion string, envs *api.EnvironmentVariables, s
 
 	podTemplateSpec := corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
-			Annotations: buildNodeGroupAnnotations(computeRuntime, spec.Image),
+			Annotations: buildNodeGroupAnnotations(computeRuntime, spec.Image, imageTemplate),
 			Labels:      map[string]string{},
 		},
 		Spec: corev1.PodSpec{
@@ -202,16 +340,7 @@ func buildHeadPodTemplate(imageVersion string, envs *api.EnvironmentVariables, s
 							ContainerPort: 8080,
 						},
 					},
-					Resources: corev1.ResourceRequirements{
-						Limits: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse(cpu),
-							corev1.ResourceMemory: resource.MustParse(memory),
-						},
-						Requests: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse(cpu),
-							corev1.ResourceMemory: resource.MustParse(memory),
-						},
-					},
+					Resources:    resources,
 					VolumeMounts: volMounts,
 				},
 			},
@@ -222,20 +351,14 @@ func buildHeadPodTemplate(imageVersion string, envs *api.EnvironmentVariables, s
 	// We are filtering container by name `ray-head`. If container with this name does not exist
 	// (should never happen) we are not adding container specific parameters
 	if container, index, ok := GetContainerByName(podTemplateSpec.Spec.Containers, "ray-head"); ok {
-		if computeRuntime.GetGpu() != 0 {
-			gpu := computeRuntime.GetGpu()
-			accelerator := "nvidia.com/gpu"
-			if len(computeRuntime.GetGpuAccelerator()) != 0 {
-				accelerator = computeRuntime.GetGpuAccelerator()
-			}
-			container.Resources.Requests[corev1.ResourceName(accelerator)] = resource.MustParse(fmt.Sprint(gpu))
-			container.Resources.Limits[corev1.ResourceName(accelerator)] = resource.MustParse(fmt.Sprint(gpu))
-		}
 		globalEnv := convertEnvironmentVariables(envs)
 		if len(globalEnv) > 0 {
 			container.Env = append(container.Env, globalEnv...)
 		}
 
+		// Add the environments and the setup command of the image template
+		applyImageTemplate(&container, imageTemplate)
+
 		// Add specific environments
 		specEnv := convertEnvironmentVariables(spec.Environment)
 		if len(specEnv) > 0 {
@@ -248,6 +371,11 @@ func buildHeadPodTemplate(imageVersion string, envs *api.EnvironmentVariables, s
 			container.Ports = append(container.Ports, corev1.ContainerPort{Name: "serve", ContainerPort: 8000})
 		}
 
+		// Add the shared memory volume of the compute template
+		if err := applySharedMemory(&podTemplateSpec.Spec, &container, computeRuntime.GetSharedMemory()); err != nil {
+			return nil, err
+		}
+
 		// Replace container
 		podTemplateSpec.Spec.Containers[index] = container
 	}
@@ -275,6 +403,9 @@ func buildHeadPodTemplate(imageVersion string, envs *api.EnvironmentVariables, s
 		}
 	}
 
+	// Add node selector, affinity and priority class
+	applyComputeTemplateScheduling(&podTemplateSpec.Spec, computeRuntime)
+
 	// If service account is specified, add it to the pod spec.
 	if len(spec.ServiceAccount) > 1 {
 		podTemplateSpec.Spec.ServiceAccountName = spec.ServiceAccount
@@ -378,23 +509,189 @@ func convertTaintEffect(val string) corev1.TaintEffect {
 	return corev1.TaintEffectPreferNoSchedule
 }
 
+// GetGpuResourceName returns the resource name of the gpus of the compute template
+func GetGpuResourceName(computeRuntime *api.ComputeTemplate) string {
+	if len(computeRuntime.GetGpuAccelerator()) != 0 {
+		return computeRuntime.GetGpuAccelerator()
+	}
+	return "nvidia.com/gpu"
+}
+
+// Build the resources of the ray container. Cpu and memory limits default to the requests,
+// gpus and extended resources use the same value for requests and limits.
+func buildResourceRequirements(computeRuntime *api.ComputeTemplate) (corev1.ResourceRequirements, error) {
+	cpuLimit := computeRuntime.GetCpuLimit()
+	if cpuLimit == 0 {
+		cpuLimit = computeRuntime.GetCpu()
+	}
+	memoryLimit := computeRuntime.GetMemoryLimit()
+	if memoryLimit == 0 {
+		memoryLimit = computeRuntime.GetMemory()
+	}
+	resources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(fmt.Sprint(cpuLimit)),
+			corev1.ResourceMemory: resource.MustParse(fmt.Sprintf("%d%s", memoryLimit, "Gi")),
+		},
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(fmt.Sprint(computeRuntime.GetCpu())),
+			corev1.ResourceMemory: resource.MustParse(fmt.Sprintf("%d%s", computeRuntime.GetMemory(), "Gi")),
+		},
+	}
+
+	if computeRuntime.GetGpu() != 0 {
+		gpu := resource.MustParse(fmt.Sprint(computeRuntime.GetGpu()))
+		accelerator := corev1.ResourceName(GetGpuResourceName(computeRuntime))
+		resources.Requests[accelerator] = gpu
+		resources.Limits[accelerator] = gpu
+	}
+
+	for name, value := range computeRuntime.GetExtendedResources() {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return resources, fmt.Errorf("invalid quantity %s of extended resource %s in compute template %s: %w", value, name, computeRuntime.GetName(), err)
+		}
+		resources.Requests[corev1.ResourceName(name)] = quantity
+		resources.Limits[corev1.ResourceName(name)] = quantity
+	}
+	return resources, nil
+}
+
+// Add a memory backed /dev/shm volume of the given size, unless the container already mounts /dev/shm.
+// Without it the operator adds a /dev/shm volume sized after the memory request.
+func applySharedMemory(podSpec *corev1.PodSpec, container *corev1.Container, sharedMemory string) error {
+	if len(sharedMemory) == 0 {
+		return nil
+	}
+	for _, mount := range container.VolumeMounts {
+		if mount.MountPath == SharedMemoryVolumeMountPath {
+			return nil
+		}
+	}
+	size, err := resource.ParseQuantity(sharedMemory)
+	if err != nil {
+		return fmt.Errorf("invalid shared memory size %s: %w", sharedMemory, err)
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: SharedMemoryVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium:    corev1.StorageMediumMemory,
+				SizeLimit: &size,
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      SharedMemoryVolumeName,
+		MountPath: SharedMemoryVolumeMountPath,
+	})
+	return nil
+}
+
+// Set the node selector, the affinity and the priority class of the compute template
+func applyComputeTemplateScheduling(podSpec *corev1.PodSpec, computeRuntime *api.ComputeTemplate) {
+	if len(computeRuntime.GetNodeSelector()) > 0 {
+		podSpec.NodeSelector = make(map[string]string, len(computeRuntime.GetNodeSelector()))
+		for k, v := range computeRuntime.GetNodeSelector() {
+			podSpec.NodeSelector[k] = v
+		}
+	}
+	podSpec.Affinity = buildAffinity(computeRuntime.GetAffinity())
+	if len(computeRuntime.GetPriorityClassName()) != 0 {
+		podSpec.PriorityClassName = computeRuntime.GetPriorityClassName()
+	}
+}
+
+// Convert the affinity of the compute template. Terms without weight are required, the other ones are preferred.
+func buildAffinity(affinity *api.PodAffinity) *corev1.Affinity {
+	if affinity == nil {
+		return nil
+	}
+	result := &corev1.Affinity{}
+	if len(affinity.NodeAffinity) > 0 {
+		result.NodeAffinity = &corev1.NodeAffinity{}
+		for _, term := range affinity.NodeAffinity {
+			selectorTerm := corev1.NodeSelectorTerm{}
+			for _, expression := range term.MatchExpressions {
+				selectorTerm.MatchExpressions = append(selectorTerm.MatchExpressions, corev1.NodeSelectorRequirement{
+					Key:      expression.Key,
+					Operator: corev1.NodeSelectorOperator(expression.Operator),
+					Values:   expression.Values,
+				})
+			}
+			if term.Weight == 0 {
+				if result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+					result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+				}
+				required := result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+				required.NodeSelectorTerms = append(required.NodeSelectorTerms, selectorTerm)
+			} else {
+				result.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+					result.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+					corev1.PreferredSchedulingTerm{Weight: term.Weight, Preference: selectorTerm})
+			}
+		}
+	}
+	if len(affinity.PodAffinity) > 0 {
+		required, preferred := buildPodAffinityTerms(affinity.PodAffinity)
+		result.PodAffinity = &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+	if len(affinity.PodAntiAffinity) > 0 {
+		required, preferred := buildPodAffinityTerms(affinity.PodAntiAffinity)
+		result.PodAntiAffinity = &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+	if result.NodeAffinity == nil && result.PodAffinity == nil && result.PodAntiAffinity == nil {
+		return nil
+	}
+	return result
+}
+
+// Convert pod (anti-)affinity terms into required and preferred terms
+func buildPodAffinityTerms(terms []*api.PodAffinityTerm) ([]corev1.PodAffinityTerm, []corev1.WeightedPodAffinityTerm) {
+	var required []corev1.PodAffinityTerm
+	var preferred []corev1.WeightedPodAffinityTerm
+	for _, term := range terms {
+		affinityTerm := corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: term.MatchLabels},
+			TopologyKey:   term.TopologyKey,
+			Namespaces:    term.Namespaces,
+		}
+		if term.Weight == 0 {
+			required = append(required, affinityTerm)
+		} else {
+			preferred = append(preferred, corev1.WeightedPodAffinityTerm{Weight: term.Weight, PodAffinityTerm: affinityTerm})
+		}
+	}
+	return required, preferred
+}
+
 // Construct Ray image
 func constructRayImage(containerImage string, version string) string {
 	return fmt.Sprintf("%s:%s", containerImage, version)
 }
 
 // Build worker pod template
-func buildWorkerPodTemplate(imageVersion string, envs *api.EnvironmentVariables, spec *api.WorkerGroupSpec, computeRuntime *api.ComputeTemplate) (*corev1.PodTemplateSpec, error) {
-	// If user doesn't provide the image, let's use the default image instead.
+func buildWorkerPodTemplate(imageVersion string, envs *api.EnvironmentVariables, spec *api.WorkerGroupSpec, computeRuntime *api.ComputeTemplate, imageTemplate *api.ImageTemplate) (*corev1.PodTemplateSpec, error) {
+	// If user doesn't provide the image or the image template, let's use the default image instead.
 	// TODO: verify the versions in the range
 	image := constructRayImage(RayClusterDefaultImageRepository, imageVersion)
 	if len(spec.Image) != 0 {
 		image = spec.Image
+	} else if imageTemplate != nil {
+		image = getImageTemplateImage(imageTemplate)
 	}
 
 	// calculate resources
-	cpu := fmt.Sprint(computeRuntime.GetCpu())
-	memory := fmt.Sprintf("%d%s", computeRuntime.GetMemory(), "Gi")
+	resources, err := buildResourceRequirements(computeRuntime)
+	if err != nil {
+		return nil, err
+	}
 
 	// build volume and volumeMounts
 	volMounts := buildVolumeMounts(spec.Volumes)
@@ -405,7 +702,7 @@ func buildWorkerPodTemplate(imageVersion string, envs *api.EnvironmentVariables,
 
 	podTemplateSpec := corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
-			Annotations: buildNodeGroupAnnotations(computeRuntime, spec.Image),
+			Annotations: buildNodeGroupAnnotations(computeRuntime, spec.Image, imageTemplate),
 			Labels:      map[string]string{},
 		},
 		Spec: corev1.PodSpec{
@@ -490,16 +787,7 @@ func buildWorkerPodTemplate(imageVersion string, envs *api.EnvironmentVariables,
 							},
 						},
 					},
-					Resources: corev1.ResourceRequirements{
-						Limits: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse(cpu),
-							corev1.ResourceMemory: resource.MustParse(memory),
-						},
-						Requests: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse(cpu),
-							corev1.ResourceMemory: resource.MustParse(memory),
-						},
-					},
+					Resources:    resources,
 					VolumeMounts: volMounts,
 				},
 			},
@@ -510,29 +798,25 @@ func buildWorkerPodTemplate(imageVersion string, envs *api.EnvironmentVariables,
 	// We are filtering container by name `ray-worker`. If container with this name does not exist
 	// (should never happen) we are not adding container specific parameters
 	if container, index, ok := GetContainerByName(podTemplateSpec.Spec.Containers, "ray-worker"); ok {
-		if computeRuntime.GetGpu() != 0 {
-			gpu := computeRuntime.GetGpu()
-			accelerator := "nvidia.com/gpu"
-			if len(computeRuntime.GetGpuAccelerator()) != 0 {
-				accelerator = computeRuntime.GetGpuAccelerator()
-			}
-
-			// need smarter algorithm to filter main container. for example filter by name `ray-worker`
-			container.Resources.Requests[corev1.ResourceName(accelerator)] = resource.MustParse(fmt.Sprint(gpu))
-			container.Resources.Limits[corev1.ResourceName(accelerator)] = resource.MustParse(fmt.Sprint(gpu))
-		}
-
 		globalEnv := convertEnvironmentVariables(envs)
 		if len(globalEnv) > 0 {
 			container.Env = append(container.Env, globalEnv...)
 		}
 
+		// Add the environments and the setup command of the image template
+		applyImageTemplate(&container, imageTemplate)
+
 		// Add specific environments
 		specEnv := convertEnvironmentVariables(spec.Environment)
 		if len(specEnv) > 0 {
 			container.Env = append(container.Env, specEnv...)
 		}
 
+		// Add the shared memory volume of the compute template
+		if err := applySharedMemory(&podTemplateSpec.Spec, &container, computeRuntime.GetSharedMemory()); err != nil {
+			return nil, err
+		}
+
 		// Replace container
 		podTemplateSpec.Spec.Containers[index] = container
 	}
@@ -560,6 +844,9 @@ func buildWorkerPodTemplate(imageVersion string, envs *api.EnvironmentVariables,
 		}
 	}
 
+	// Add node selector, affinity and priority class
+	applyComputeTemplateScheduling(&podTemplateSpec.Spec, computeRuntime)
+
 	// If service account is specified, add it to the pod spec.
 	if len(spec.ServiceAccount) > 1 {
 		podTemplateSpec.Spec.ServiceAccountName = spec.ServiceAccount
@@ -801,6 +1088,28 @@ func NewComputeTemplate(runtime *api.ComputeTemplate) (*corev1.ConfigMap, error)
 			dmap["tolerations"] = string(t)
 		}
 	}
+	// Add scheduling options and resources if defined
+	if len(runtime.NodeSelector) > 0 {
+		marshalComputeTemplateField(dmap, "node_selector", runtime.NodeSelector, runtime.Name)
+	}
+	if runtime.Affinity != nil {
+		marshalComputeTemplateField(dmap, "affinity", runtime.Affinity, runtime.Name)
+	}
+	if len(runtime.ExtendedResources) > 0 {
+		marshalComputeTemplateField(dmap, "extended_resources", runtime.ExtendedResources, runtime.Name)
+	}
+	if runtime.CpuLimit != 0 {
+		dmap["cpu_limit"] = strconv.FormatUint(uint64(runtime.CpuLimit), 10)
+	}
+	if runtime.MemoryLimit != 0 {
+		dmap["memory_limit"] = strconv.FormatUint(uint64(runtime.MemoryLimit), 10)
+	}
+	if len(runtime.PriorityClassName) != 0 {
+		dmap["priority_class_name"] = runtime.PriorityClassName
+	}
+	if len(runtime.SharedMemory) != 0 {
+		dmap["shared_memory"] = runtime.SharedMemory
+	}
 
 	config := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -817,6 +1126,71 @@ func NewComputeTemplate(runtime *api.ComputeTemplate) (*corev1.ConfigMap, error)
 	return config, nil
 }
 
+// Store a field of the compute template as json in the config map data
+func marshalComputeTemplateField(dmap map[string]string, key string, value interface{}, name string) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		klog.Errorf("failed to marshall %s %v for compute template %s error %v", key, value, name, err)
+		return
+	}
+	dmap[key] = string(data)
+}
+
+// Build image template
+func NewImageTemplate(imageTemplate *api.ImageTemplate) (*corev1.ConfigMap, error) {
+	// Create data map
+	dmap := map[string]string{
+		"name":            imageTemplate.Name,
+		"namespace":       imageTemplate.Namespace,
+		"base_image":      imageTemplate.BaseImage,
+		"custom_commands": imageTemplate.CustomCommands,
+		"image":           imageTemplate.Image,
+	}
+	// Add the packages and environment variables if defined
+	addJSON := func(key string, value interface{}) error {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s of image template %s: %w", key, imageTemplate.Name, err)
+		}
+		dmap[key] = string(data)
+		return nil
+	}
+	if len(imageTemplate.PipPackages) > 0 {
+		if err := addJSON("pip_packages", imageTemplate.PipPackages); err != nil {
+			return nil, err
+		}
+	}
+	if len(imageTemplate.CondaPackages) > 0 {
+		if err := addJSON("conda_packages", imageTemplate.CondaPackages); err != nil {
+			return nil, err
+		}
+	}
+	if len(imageTemplate.SystemPackages) > 0 {
+		if err := addJSON("system_packages", imageTemplate.SystemPackages); err != nil {
+			return nil, err
+		}
+	}
+	if len(imageTemplate.EnvironmentVariables) > 0 {
+		if err := addJSON("environment_variables", imageTemplate.EnvironmentVariables); err != nil {
+			return nil, err
+		}
+	}
+
+	config := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      imageTemplate.Name,
+			Namespace: imageTemplate.Namespace,
+			Labels: map[string]string{
+				"ray.io/config-type":    "image-template",
+				"ray.io/image-template": imageTemplate.Name,
+			},
+		},
+		Data: dmap,
+	}
+
+	return config, nil
+}
+
 // GetNodeHostIP returns the provided node's IP, based on the priority:
 // 1. NodeInternalIP
 // 2. NodeExternalIP
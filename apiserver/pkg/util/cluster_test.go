@@ -7,6 +7,8 @@ import (
 
 	api "github.com/ray-project/kuberay/proto/go_client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -508,7 +510,7 @@ func TestBuildVolumeMounts(t *testing.T) {
 }
 
 func TestBuildHeadPodTemplate(t *testing.T) {
-	podSpec, err := buildHeadPodTemplate("2.4", &api.EnvironmentVariables{}, &headGroup, &template, false)
+	podSpec, err := buildHeadPodTemplate("2.4", &api.EnvironmentVariables{}, &headGroup, &template, nil, false)
 	assert.Nil(t, err)
 
 	if podSpec.Spec.ServiceAccountName != "account" {
@@ -549,7 +551,7 @@ func TestBuildHeadPodTemplate(t *testing.T) {
 		t.Errorf("failed to convert labels, got %v, expected %v", podSpec.Labels, expectedLabels)
 	}
 
-	podSpec, err = buildHeadPodTemplate("2.4", &api.EnvironmentVariables{}, &headGroup, &template, true)
+	podSpec, err = buildHeadPodTemplate("2.4", &api.EnvironmentVariables{}, &headGroup, &template, nil, true)
 	assert.Nil(t, err)
 	if len(podSpec.Spec.Containers[0].Ports) != 6 {
 		t.Errorf("failed build ports")
@@ -569,7 +571,7 @@ func TestConvertAutoscalerOptions(t *testing.T) {
 }
 
 func TestBuildRayCluster(t *testing.T) {
-	cluster, err := NewRayCluster(&rayCluster, map[string]*api.ComputeTemplate{"foo": &template})
+	cluster, err := NewRayCluster(&rayCluster, map[string]*api.ComputeTemplate{"foo": &template}, nil)
 	assert.Nil(t, err)
 	if len(cluster.ObjectMeta.Annotations) != 1 {
 		t.Errorf("failed to propagate annotations")
@@ -578,14 +580,59 @@ func TestBuildRayCluster(t *testing.T) {
 		t.Errorf("failed to propagate create Ingress")
 	}
 	assert.Equal(t, cluster.Spec.EnableInTreeAutoscaling, (*bool)(nil))
-	cluster, err = NewRayCluster(&rayClusterAutoScaler, map[string]*api.ComputeTemplate{"foo": &template})
+	cluster, err = NewRayCluster(&rayClusterAutoScaler, map[string]*api.ComputeTemplate{"foo": &template}, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, *cluster.Spec.EnableInTreeAutoscaling, true)
 	assert.NotEqual(t, cluster.Spec.AutoscalerOptions, nil)
 }
 
+func TestBuildSuspendedRayCluster(t *testing.T) {
+	suspended := proto.Clone(&rayCluster).(*api.Cluster)
+	suspended.Suspend = true
+	cluster, err := NewRayCluster(suspended, map[string]*api.ComputeTemplate{"foo": &template}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, cluster.Spec.Suspend)
+	assert.True(t, *cluster.Spec.Suspend)
+
+	cluster, err = NewRayCluster(&rayCluster, map[string]*api.ComputeTemplate{"foo": &template}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, cluster.Spec.Suspend)
+}
+
+func TestPatchRayClusterWorkerGroupSpecs(t *testing.T) {
+	cluster, err := NewRayCluster(&rayCluster, map[string]*api.ComputeTemplate{"foo": &template}, nil)
+	require.NoError(t, err)
+	existing := cluster.Spec.WorkerGroupSpecs
+
+	specs := []*api.WorkerGroupSpec{
+		{GroupName: "new", ComputeTemplate: "foo", Image: "bar", Replicas: 1, MaxReplicas: 3},
+		{GroupName: "wg", Replicas: 2, MinReplicas: 1, MaxReplicas: 4},
+	}
+	patched, err := PatchRayClusterWorkerGroupSpecs("2.9.0", nil, specs, existing, map[string]*api.ComputeTemplate{"foo": &template}, nil)
+	require.NoError(t, err)
+	require.Len(t, patched, 2)
+
+	assert.Equal(t, "new", patched[0].GroupName)
+	assert.Equal(t, int32(1), *patched[0].Replicas)
+	assert.Equal(t, int32(1), *patched[0].MinReplicas)
+	assert.Equal(t, int32(3), *patched[0].MaxReplicas)
+	assert.Equal(t, "bar", patched[0].Template.Spec.Containers[0].Image)
+
+	assert.Equal(t, "wg", patched[1].GroupName)
+	assert.Equal(t, int32(2), *patched[1].Replicas)
+	assert.Equal(t, int32(1), *patched[1].MinReplicas)
+	assert.Equal(t, int32(4), *patched[1].MaxReplicas)
+	assert.Equal(t, existing[0].Template, patched[1].Template)
+	// The existing worker groups are not modified in place.
+	assert.Equal(t, int32(5), *existing[0].Replicas)
+
+	patched, err = PatchRayClusterWorkerGroupSpecs("2.9.0", nil, nil, existing, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, patched)
+}
+
 func TestBuilWorkerPodTemplate(t *testing.T) {
-	podSpec, err := buildWorkerPodTemplate("2.4", &api.EnvironmentVariables{}, &workerGroup, &template)
+	podSpec, err := buildWorkerPodTemplate("2.4", &api.EnvironmentVariables{}, &workerGroup, &template, nil)
 	assert.Nil(t, err)
 
 	if podSpec.Spec.ServiceAccountName != "account" {
@@ -612,6 +659,124 @@ func TestBuilWorkerPodTemplate(t *testing.T) {
 	}
 }
 
+func TestBuildPodTemplateWithSchedulingOptions(t *testing.T) {
+	computeTemplate := &api.ComputeTemplate{
+		Name:              "tpu-template",
+		Namespace:         "default",
+		Cpu:               2,
+		Memory:            8,
+		CpuLimit:          4,
+		Gpu:               1,
+		ExtendedResources: map[string]string{"rdma/hca": "1"},
+		NodeSelector:      map[string]string{"cloud.google.com/gke-nodepool": "gpu-pool"},
+		PriorityClassName: "high-priority",
+		SharedMemory:      "2Gi",
+		Affinity: &api.PodAffinity{
+			NodeAffinity: []*api.NodeAffinityTerm{
+				{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "zone", Operator: "In", Values: []string{"a"}}}},
+				{MatchExpressions: []*api.NodeSelectorRequirement{{Key: "spot", Operator: "DoesNotExist"}}, Weight: 50},
+			},
+			PodAntiAffinity: []*api.PodAffinityTerm{
+				{MatchLabels: map[string]string{"app": "ray"}, TopologyKey: "kubernetes.io/hostname"},
+			},
+		},
+	}
+	head := &api.HeadGroupSpec{ComputeTemplate: computeTemplate.Name}
+	headSpec, err := buildHeadPodTemplate("2.4", &api.EnvironmentVariables{}, head, computeTemplate, nil, false)
+	require.NoError(t, err)
+	worker := &api.WorkerGroupSpec{GroupName: "group", ComputeTemplate: computeTemplate.Name}
+	workerSpec, err := buildWorkerPodTemplate("2.4", &api.EnvironmentVariables{}, worker, computeTemplate, nil)
+	require.NoError(t, err)
+
+	for _, podSpec := range []*corev1.PodTemplateSpec{headSpec, workerSpec} {
+		resources := podSpec.Spec.Containers[0].Resources
+		assert.Equal(t, resource.MustParse("2"), resources.Requests[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("4"), resources.Limits[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("8Gi"), resources.Requests[corev1.ResourceMemory])
+		assert.Equal(t, resource.MustParse("8Gi"), resources.Limits[corev1.ResourceMemory])
+		assert.Equal(t, resource.MustParse("1"), resources.Requests["nvidia.com/gpu"])
+		assert.Equal(t, resource.MustParse("1"), resources.Requests["rdma/hca"])
+		assert.Equal(t, resource.MustParse("1"), resources.Limits["rdma/hca"])
+
+		assert.Equal(t, computeTemplate.NodeSelector, podSpec.Spec.NodeSelector)
+		assert.Equal(t, "high-priority", podSpec.Spec.PriorityClassName)
+		nodeAffinity := podSpec.Spec.Affinity.NodeAffinity
+		require.Len(t, nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms, 1)
+		assert.Equal(t, corev1.NodeSelectorOpIn, nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Operator)
+		require.Len(t, nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, 1)
+		assert.Equal(t, int32(50), nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Weight)
+		assert.Nil(t, podSpec.Spec.Affinity.PodAffinity)
+		require.Len(t, podSpec.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+		assert.Equal(t, "kubernetes.io/hostname", podSpec.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0].TopologyKey)
+
+		volume := podSpec.Spec.Volumes[len(podSpec.Spec.Volumes)-1]
+		assert.Equal(t, SharedMemoryVolumeName, volume.Name)
+		assert.Equal(t, corev1.StorageMediumMemory, volume.EmptyDir.Medium)
+		assert.Equal(t, resource.MustParse("2Gi"), *volume.EmptyDir.SizeLimit)
+		mounts := podSpec.Spec.Containers[0].VolumeMounts
+		assert.Equal(t, SharedMemoryVolumeMountPath, mounts[len(mounts)-1].MountPath)
+	}
+
+	// The default compute template has no scheduling options
+	podSpec, err := buildWorkerPodTemplate("2.4", &api.EnvironmentVariables{}, &workerGroup, &template, nil)
+	require.NoError(t, err)
+	assert.Nil(t, podSpec.Spec.Affinity)
+	assert.Empty(t, podSpec.Spec.NodeSelector)
+	assert.Empty(t, podSpec.Spec.PriorityClassName)
+
+	computeTemplate.ExtendedResources["rdma/hca"] = "one"
+	_, err = buildWorkerPodTemplate("2.4", &api.EnvironmentVariables{}, worker, computeTemplate, nil)
+	assert.Error(t, err)
+}
+
+func TestBuildPodTemplateWithImageTemplate(t *testing.T) {
+	imageTemplate := &api.ImageTemplate{
+		Name:                 "image-template",
+		Namespace:            "default",
+		BaseImage:            "rayproject/ray:2.7.0",
+		PipPackages:          []string{"numpy>=1.24", "pandas"},
+		SystemPackages:       []string{"git"},
+		EnvironmentVariables: map[string]string{"B": "2", "A": "1"},
+		CustomCommands:       "echo done",
+	}
+	head := &api.HeadGroupSpec{ComputeTemplate: "foo", ImageTemplate: imageTemplate.Name}
+	podSpec, err := buildHeadPodTemplate("2.4", &api.EnvironmentVariables{}, head, &template, imageTemplate, false)
+	assert.Nil(t, err)
+	container := podSpec.Spec.Containers[0]
+	assert.Equal(t, "rayproject/ray:2.7.0", container.Image)
+	assert.Equal(t, []string{"sudo apt-get update && sudo apt-get install -y 'git' && pip install 'numpy>=1.24' 'pandas' && echo done"}, container.Args)
+	assert.Equal(t, []corev1.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}, container.Env[len(container.Env)-2:])
+	assert.Equal(t, imageTemplate.Name, podSpec.Annotations[RayClusterImageTemplateAnnotationKey])
+
+	// A prebuilt image already contains the packages, so only the environment variables are applied.
+	imageTemplate.Image = "my-registry/ray-with-packages:2.7.0"
+	worker := &api.WorkerGroupSpec{GroupName: "group", ComputeTemplate: "foo", ImageTemplate: imageTemplate.Name}
+	podSpec, err = buildWorkerPodTemplate("2.4", &api.EnvironmentVariables{}, worker, &template, imageTemplate)
+	assert.Nil(t, err)
+	container = podSpec.Spec.Containers[0]
+	assert.Equal(t, imageTemplate.Image, container.Image)
+	assert.Empty(t, container.Args)
+	assert.True(t, containsEnv(container.Env, "A", "1"))
+
+	// The image template must be populated.
+	cluster := &api.Cluster{
+		Name:      "cluster",
+		Namespace: "default",
+		ClusterSpec: &api.ClusterSpec{
+			HeadGroupSpec: head,
+		},
+	}
+	_, err = NewRayCluster(cluster, map[string]*api.ComputeTemplate{"foo": &template}, nil)
+	assert.NotNil(t, err)
+	rayCluster, err := NewRayCluster(cluster, map[string]*api.ComputeTemplate{"foo": &template}, map[string]*api.ImageTemplate{imageTemplate.Name: imageTemplate})
+	assert.Nil(t, err)
+	assert.Equal(t, imageTemplate.Image, rayCluster.Spec.HeadGroupSpec.Template.Spec.Containers[0].Image)
+}
+
+func TestShellQuoteAll(t *testing.T) {
+	assert.Equal(t, `'torch==2.0' 'it'\''s'`, shellQuoteAll([]string{"torch==2.0", "it's"}))
+}
+
 func containsEnv(envs []corev1.EnvVar, key string, val string) bool {
 	for _, env := range envs {
 		if env.Name == key && env.Value == val {
@@ -20,8 +20,13 @@ const (
 	// Role level
 	RayClusterComputeTemplateAnnotationKey = "ray.io/compute-template"
 	RayClusterImageAnnotationKey           = "ray.io/compute-image"
+	RayClusterImageTemplateAnnotationKey   = "ray.io/image-template"
 
 	RayClusterDefaultImageRepository = "rayproject/ray"
+
+	// Shared memory volume of the ray container, same as the one added by the operator
+	SharedMemoryVolumeName      = "shared-mem"
+	SharedMemoryVolumeMountPath = "/dev/shm"
 )
 
 const (
@@ -15,7 +15,7 @@ type RayJob struct {
 }
 
 // NewRayJob creates a RayJob.
-func NewRayJob(apiJob *api.RayJob, computeTemplateMap map[string]*api.ComputeTemplate) (*RayJob, error) {
+func NewRayJob(apiJob *api.RayJob, computeTemplateMap map[string]*api.ComputeTemplate, imageTemplateMap map[string]*api.ImageTemplate) (*RayJob, error) {
 	rayJob := &rayv1api.RayJob{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        apiJob.Name,
@@ -32,10 +32,25 @@ func NewRayJob(apiJob *api.RayJob, computeTemplateMap map[string]*api.ComputeTem
 			JobId:                    apiJob.JobId,
 			RayClusterSpec:           nil,
 			ClusterSelector:          apiJob.ClusterSelector,
+			RayClusterPoolName:       apiJob.RayClusterPoolName,
+			RayJobQueueName:          apiJob.RayJobQueueName,
+			QueuePriority:            apiJob.QueuePriority,
+			SubmissionMode:           rayv1api.JobSubmissionMode(apiJob.SubmissionMode),
+			Suspend:                  apiJob.Suspend,
 		},
 	}
+	if apiJob.ActiveDeadlineSeconds > 0 {
+		rayJob.Spec.ActiveDeadlineSeconds = &apiJob.ActiveDeadlineSeconds
+	}
+	if apiJob.RuntimeEnvSpec != nil {
+		runtimeEnv, err := buildRuntimeEnv(apiJob.RuntimeEnvSpec)
+		if err != nil {
+			return nil, err
+		}
+		rayJob.Spec.RuntimeEnv = runtimeEnv
+	}
 	if apiJob.ClusterSpec != nil {
-		clusterSpec, err := buildRayClusterSpec(apiJob.Version, nil, apiJob.ClusterSpec, computeTemplateMap, false)
+		clusterSpec, err := buildRayClusterSpec(apiJob.Version, nil, apiJob.ClusterSpec, computeTemplateMap, imageTemplateMap, false)
 		if err != nil {
 			return nil, err
 		}
@@ -89,7 +104,7 @@ func NewRayJob(apiJob *api.RayJob, computeTemplateMap map[string]*api.ComputeTem
 	}
 	if apiJob.EntrypointNumGpus > 0 {
 		// Entry point number of GPUs
-		rayJob.Spec.DeepCopy().EntrypointNumGpus = apiJob.EntrypointNumGpus
+		rayJob.Spec.EntrypointNumGpus = apiJob.EntrypointNumGpus
 	}
 	if apiJob.EntrypointResources != "" {
 		// Entry point resources
@@ -99,6 +114,53 @@ func NewRayJob(apiJob *api.RayJob, computeTemplateMap map[string]*api.ComputeTem
 	return &RayJob{rayJob}, nil
 }
 
+// Build the structured runtime environment
+func buildRuntimeEnv(apiRuntimeEnv *api.RuntimeEnv) (*rayv1api.RuntimeEnv, error) {
+	runtimeEnv := &rayv1api.RuntimeEnv{
+		Pip:        apiRuntimeEnv.Pip,
+		WorkingDir: apiRuntimeEnv.WorkingDir,
+		PyModules:  apiRuntimeEnv.PyModules,
+	}
+	if apiRuntimeEnv.Conda != nil {
+		runtimeEnv.Conda = &rayv1api.RuntimeEnvConda{
+			Name:         apiRuntimeEnv.Conda.Name,
+			Channels:     apiRuntimeEnv.Conda.Channels,
+			Dependencies: apiRuntimeEnv.Conda.Dependencies,
+		}
+	}
+	for _, envVar := range apiRuntimeEnv.EnvVars {
+		runtimeEnvVar := rayv1api.RuntimeEnvVar{Name: envVar.Name, Value: envVar.Value}
+		if envVar.ValueFrom != nil {
+			switch envVar.ValueFrom.Source {
+			case api.EnvValueFrom_CONFIGMAP:
+				runtimeEnvVar.ValueFrom = &rayv1api.RuntimeEnvVarSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: envVar.ValueFrom.Name},
+						Key:                  envVar.ValueFrom.Key,
+					},
+				}
+			case api.EnvValueFrom_SECRET:
+				runtimeEnvVar.ValueFrom = &rayv1api.RuntimeEnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: envVar.ValueFrom.Name},
+						Key:                  envVar.ValueFrom.Key,
+					},
+				}
+			default:
+				return nil, fmt.Errorf("runtime environment variable %s can only be set from a config map or a secret, got %s", envVar.Name, envVar.ValueFrom.Source)
+			}
+		}
+		runtimeEnv.EnvVars = append(runtimeEnv.EnvVars, runtimeEnvVar)
+	}
+	if apiRuntimeEnv.Container != nil {
+		runtimeEnv.Container = &rayv1api.RuntimeEnvContainer{
+			Image:      apiRuntimeEnv.Container.Image,
+			RunOptions: apiRuntimeEnv.Container.RunOptions,
+		}
+	}
+	return runtimeEnv, nil
+}
+
 func (j *RayJob) Get() *rayv1api.RayJob {
 	return j.RayJob
 }
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	api "github.com/ray-project/kuberay/proto/go_client"
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var apiJobNewCluster = &api.RayJob{
@@ -75,7 +77,7 @@ var apiJobExistingClusterSubmitterBadParams = &api.RayJob{
 
 func TestBuildRayJob(t *testing.T) {
 	// Test request with cluster creation
-	job, err := NewRayJob(apiJobNewCluster, map[string]*api.ComputeTemplate{"foo": &template})
+	job, err := NewRayJob(apiJobNewCluster, map[string]*api.ComputeTemplate{"foo": &template}, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, "test", job.ObjectMeta.Name)
 	assert.Equal(t, "test", job.ObjectMeta.Namespace)
@@ -88,7 +90,7 @@ func TestBuildRayJob(t *testing.T) {
 	assert.NotNil(t, job.Spec.RayClusterSpec)
 
 	// Test request without cluster creation
-	job, err = NewRayJob(apiJobExistingCluster, map[string]*api.ComputeTemplate{"foo": &template})
+	job, err = NewRayJob(apiJobExistingCluster, map[string]*api.ComputeTemplate{"foo": &template}, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, "test", job.ObjectMeta.Name)
 	assert.Equal(t, "test", job.ObjectMeta.Namespace)
@@ -100,7 +102,7 @@ func TestBuildRayJob(t *testing.T) {
 	assert.Nil(t, job.Spec.SubmitterPodTemplate)
 
 	// Test request without cluster creation with submitter
-	job, err = NewRayJob(apiJobExistingClusterSubmitter, map[string]*api.ComputeTemplate{"foo": &template})
+	job, err = NewRayJob(apiJobExistingClusterSubmitter, map[string]*api.ComputeTemplate{"foo": &template}, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, "test", job.ObjectMeta.Name)
 	assert.Equal(t, "test", job.ObjectMeta.Namespace)
@@ -119,6 +121,53 @@ func TestBuildRayJob(t *testing.T) {
 	assert.Equal(t, "150Mi", job.Spec.SubmitterPodTemplate.Spec.Containers[0].Resources.Requests.Memory().String())
 
 	// Test request without cluster creation with submitter bad parameters
-	_, err = NewRayJob(apiJobExistingClusterSubmitterBadParams, map[string]*api.ComputeTemplate{"foo": &template})
+	_, err = NewRayJob(apiJobExistingClusterSubmitterBadParams, map[string]*api.ComputeTemplate{"foo": &template}, nil)
 	assert.NotNil(t, err)
 }
+
+func TestBuildRayJobSpecFields(t *testing.T) {
+	apiJob := &api.RayJob{
+		Name:                     "test",
+		Namespace:                "test",
+		User:                     "test",
+		Entrypoint:               "python /home/ray/samples/sample_code.py",
+		ShutdownAfterJobFinishes: true,
+		ClusterSpec:              rayCluster.ClusterSpec,
+		Suspend:                  true,
+		ActiveDeadlineSeconds:    600,
+		SubmissionMode:           string(rayv1api.HTTPMode),
+		RayJobQueueName:          "queue",
+		QueuePriority:            10,
+		EntrypointNumGpus:        1,
+		RuntimeEnvSpec: &api.RuntimeEnv{
+			Pip: []string{"requests==2.26.0"},
+			EnvVars: []*api.RuntimeEnvVar{
+				{Name: "counter_name", Value: "test_counter"},
+				{Name: "token", ValueFrom: &api.EnvValueFrom{Source: api.EnvValueFrom_SECRET, Name: "secret", Key: "token"}},
+			},
+			WorkingDir: "s3://bucket/code.zip",
+		},
+	}
+	job, err := NewRayJob(apiJob, map[string]*api.ComputeTemplate{"foo": &template}, nil)
+	require.NoError(t, err)
+	assert.True(t, job.Spec.Suspend)
+	require.NotNil(t, job.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, int32(600), *job.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, rayv1api.HTTPMode, job.Spec.SubmissionMode)
+	assert.Equal(t, "queue", job.Spec.RayJobQueueName)
+	assert.Equal(t, int32(10), job.Spec.QueuePriority)
+	assert.Equal(t, float32(1), job.Spec.EntrypointNumGpus)
+	require.NotNil(t, job.Spec.RuntimeEnv)
+	assert.Equal(t, []string{"requests==2.26.0"}, job.Spec.RuntimeEnv.Pip)
+	assert.Equal(t, "s3://bucket/code.zip", job.Spec.RuntimeEnv.WorkingDir)
+	require.Len(t, job.Spec.RuntimeEnv.EnvVars, 2)
+	assert.Equal(t, "test_counter", job.Spec.RuntimeEnv.EnvVars[0].Value)
+	require.NotNil(t, job.Spec.RuntimeEnv.EnvVars[1].ValueFrom.SecretKeyRef)
+	assert.Equal(t, "secret", job.Spec.RuntimeEnv.EnvVars[1].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "token", job.Spec.RuntimeEnv.EnvVars[1].ValueFrom.SecretKeyRef.Key)
+
+	// Only config maps and secrets are supported sources of runtime environment variables
+	apiJob.RuntimeEnvSpec.EnvVars[1].ValueFrom.Source = api.EnvValueFrom_FIELD
+	_, err = NewRayJob(apiJob, map[string]*api.ComputeTemplate{"foo": &template}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package util
+
+import (
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// Fields that the objects of a List request can be sorted by.
+const (
+	SortByName      = "name"
+	SortByNamespace = "namespace"
+	SortByCreatedAt = "created_at"
+)
+
+// ListOptions are the options to list the objects managed by the API server page by page.
+type ListOptions struct {
+	// PageToken is the Kubernetes continue token of the page. The first page is listed if it is empty.
+	PageToken string
+	// PageSize is the maximum number of objects in the page. All objects are listed if it is 0.
+	PageSize int32
+	// LabelSelector filters the objects by their labels.
+	LabelSelector labels.Selector
+	// SortBy is the field to sort the objects by, see SortObjects. It can't be combined with pagination.
+	SortBy string
+}
+
+// NewListOptions validates the pagination, filtering and sorting parameters of a List request. The objects created by
+// the user are selected by the user label. The objects are sorted after they are listed from Kubernetes, which returns
+// them in its own order page by page, so sorting is rejected for paginated requests.
+func NewListOptions(pageToken string, pageSize int32, labelSelector string, user string, sortBy string) (*ListOptions, error) {
+	if pageSize < 0 {
+		return nil, NewInvalidInputError("Page size %d is negative. Please specify a valid value.", pageSize)
+	}
+
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, NewInvalidInputError("Label selector %q is invalid: %v", labelSelector, err)
+	}
+	if user != "" {
+		requirement, err := labels.NewRequirement(RayClusterUserLabelKey, selection.Equals, []string{user})
+		if err != nil {
+			return nil, NewInvalidInputError("User %q is invalid: %v", user, err)
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	switch strings.TrimPrefix(sortBy, "-") {
+	case "", SortByName, SortByNamespace, SortByCreatedAt:
+	default:
+		return nil, NewInvalidInputError("Sort field %q is not supported. Please specify one of %s, %s and %s.", sortBy, SortByName, SortByNamespace, SortByCreatedAt)
+	}
+	if sortBy != "" && (pageSize > 0 || pageToken != "") {
+		return nil, NewInvalidInputError("Sort field %q can't be combined with pagination. Please unset the page size and the page token.", sortBy)
+	}
+
+	return &ListOptions{
+		PageToken:     pageToken,
+		PageSize:      pageSize,
+		LabelSelector: selector,
+		SortBy:        sortBy,
+	}, nil
+}
+
+// ValidateFilter rejects a filter that is applied after the objects are listed from Kubernetes, e.g. a filter on the
+// status, if the request is paginated, because the filter would be applied to each page separately.
+func (o *ListOptions) ValidateFilter(filter string, value string) error {
+	if value != "" && (o.PageSize > 0 || o.PageToken != "") {
+		return NewInvalidInputError("Filter %s=%q can't be combined with pagination. Please unset the page size and the page token.", filter, value)
+	}
+	return nil
+}
+
+// ToKubeListOptions returns the Kubernetes list options of the page. The objects must also have the given labels.
+// The options select all objects if they are nil.
+func (o *ListOptions) ToKubeListOptions(matchLabels map[string]string) metav1.ListOptions {
+	selector := labels.SelectorFromSet(matchLabels)
+	listOptions := metav1.ListOptions{}
+	if o != nil {
+		if o.LabelSelector != nil {
+			requirements, _ := o.LabelSelector.Requirements()
+			selector = selector.Add(requirements...)
+		}
+		listOptions.Continue = o.PageToken
+		listOptions.Limit = int64(o.PageSize)
+	}
+	listOptions.LabelSelector = selector.String()
+	return listOptions
+}
+
+// SortObjects sorts all the listed objects by `name`, `namespace` or `created_at`, in descending order if the field is
+// prefixed with `-`. Ties are broken by namespace and name. The order of the objects is kept if the field is empty.
+func SortObjects[T metav1.Object](objects []T, sortBy string) {
+	if sortBy == "" {
+		return
+	}
+	descending := strings.HasPrefix(sortBy, "-")
+	field := strings.TrimPrefix(sortBy, "-")
+
+	compareNames := func(a, b metav1.Object) int {
+		if c := strings.Compare(a.GetNamespace(), b.GetNamespace()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetName(), b.GetName())
+	}
+	compare := func(a, b metav1.Object) int {
+		switch field {
+		case SortByName:
+			if c := strings.Compare(a.GetName(), b.GetName()); c != 0 {
+				return c
+			}
+		case SortByCreatedAt:
+			aTime, bTime := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+			if aTime.Before(&bTime) {
+				return -1
+			}
+			if bTime.Before(&aTime) {
+				return 1
+			}
+		}
+		return compareNames(a, b)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		c := compare(objects[i], objects[j])
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+}
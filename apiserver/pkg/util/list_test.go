@@ -0,0 +1,88 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewListOptions(t *testing.T) {
+	opts, err := NewListOptions("token", 10, "app=ray,tier in (gpu)", "alice", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "token", opts.PageToken)
+	assert.Equal(t, int32(10), opts.PageSize)
+
+	kubeOpts := opts.ToKubeListOptions(map[string]string{KubernetesManagedByLabelKey: ComponentName})
+	assert.Equal(t, "app=ray,app.kubernetes.io/managed-by=kuberay-apiserver,ray.io/user=alice,tier in (gpu)", kubeOpts.LabelSelector)
+	assert.Equal(t, "token", kubeOpts.Continue)
+	assert.Equal(t, int64(10), kubeOpts.Limit)
+
+	_, err = NewListOptions("", -1, "", "", "")
+	assert.NotNil(t, err)
+	_, err = NewListOptions("", 0, "app in ray", "", "")
+	assert.NotNil(t, err)
+	_, err = NewListOptions("", 0, "", "not a valid user", "")
+	assert.NotNil(t, err)
+	_, err = NewListOptions("", 0, "", "", "size")
+	assert.NotNil(t, err)
+
+	// Sorting and the filters applied after listing can't be combined with pagination.
+	sortedOpts, err := NewListOptions("", 0, "", "", "-created_at")
+	assert.Nil(t, err)
+	assert.Equal(t, "-created_at", sortedOpts.SortBy)
+	assert.Nil(t, sortedOpts.ValidateFilter("cluster_state", "ready"))
+	_, err = NewListOptions("", 10, "", "", "-created_at")
+	assert.NotNil(t, err)
+	_, err = NewListOptions("token", 0, "", "", "name")
+	assert.NotNil(t, err)
+	assert.NotNil(t, opts.ValidateFilter("cluster_state", "ready"))
+	assert.Nil(t, opts.ValidateFilter("cluster_state", ""))
+
+	var nilOpts *ListOptions
+	kubeOpts = nilOpts.ToKubeListOptions(map[string]string{"ray.io/config-type": "compute-template"})
+	assert.Equal(t, metav1.ListOptions{LabelSelector: "ray.io/config-type=compute-template"}, kubeOpts)
+}
+
+func TestSortObjects(t *testing.T) {
+	now := time.Now()
+	newConfigMap := func(namespace, name string, age time.Duration) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+		}
+	}
+	a := newConfigMap("ns2", "a", time.Hour)
+	b := newConfigMap("ns1", "b", time.Minute)
+	c := newConfigMap("ns1", "c", 2*time.Hour)
+	names := func(objects []*corev1.ConfigMap) []string {
+		var result []string
+		for _, object := range objects {
+			result = append(result, object.Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		sortBy   string
+		expected []string
+	}{
+		{"", []string{"a", "b", "c"}},
+		{SortByName, []string{"a", "b", "c"}},
+		{"-" + SortByName, []string{"c", "b", "a"}},
+		{SortByNamespace, []string{"b", "c", "a"}},
+		{"-" + SortByNamespace, []string{"a", "c", "b"}},
+		{SortByCreatedAt, []string{"c", "a", "b"}},
+		{"-" + SortByCreatedAt, []string{"b", "a", "c"}},
+	}
+	for _, tc := range tests {
+		objects := []*corev1.ConfigMap{a, b, c}
+		SortObjects(objects, tc.sortBy)
+		assert.Equal(t, tc.expected, names(objects), tc.sortBy)
+	}
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// Kinds of the Ray custom resources rendered by ToManifest.
+const (
+	RayClusterKind = "RayCluster"
+	RayJobKind     = "RayJob"
+	RayServiceKind = "RayService"
+)
+
+// DryRunOptions returns the Kubernetes dry-run option of a create or update request.
+func DryRunOptions(dryRun bool) []string {
+	if dryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// ToManifest renders a Ray custom resource of the given kind as YAML. Like `kubectl get -o yaml`, the API version
+// and kind are filled in, as the typed clients clear them, and the managed fields are left out.
+func ToManifest(obj runtime.Object, kind string) (string, error) {
+	obj = obj.DeepCopyObject()
+	obj.GetObjectKind().SetGroupVersionKind(rayv1api.GroupVersion.WithKind(kind))
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	accessor.SetManagedFields(nil)
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
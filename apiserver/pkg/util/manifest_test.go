@@ -0,0 +1,40 @@
+package util
+
+import (
+	"testing"
+
+	rayv1api "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestDryRunOptions(t *testing.T) {
+	assert.Equal(t, []string{metav1.DryRunAll}, DryRunOptions(true))
+	assert.Nil(t, DryRunOptions(false))
+}
+
+func TestToManifest(t *testing.T) {
+	cluster := &rayv1api.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "cluster",
+			Namespace:     "default",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kuberay-apiserver"}},
+		},
+		Spec: rayv1api.RayClusterSpec{RayVersion: "2.9.0"},
+	}
+	manifest, err := ToManifest(cluster, "RayCluster")
+	require.NoError(t, err)
+
+	var rendered rayv1api.RayCluster
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &rendered))
+	assert.Equal(t, "ray.io/v1", rendered.APIVersion)
+	assert.Equal(t, "RayCluster", rendered.Kind)
+	assert.Equal(t, "cluster", rendered.Name)
+	assert.Equal(t, "2.9.0", rendered.Spec.RayVersion)
+	assert.Empty(t, rendered.ManagedFields)
+	// The given object is left unchanged
+	assert.Empty(t, cluster.Kind)
+	assert.Len(t, cluster.ManagedFields, 1)
+}
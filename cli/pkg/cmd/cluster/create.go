@@ -22,10 +22,12 @@ type CreateOptions struct {
 	user                  string
 	headComputeTemplate   string
 	headImage             string
+	headImageTemplate     string
 	headServiceType       string
 	workerGroupName       string
 	workerComputeTemplate string
 	workerImage           string
+	workerImageTemplate   string
 	workerReplicas        uint32
 }
 
@@ -49,10 +51,12 @@ func newCmdCreate() *cobra.Command {
 	cmd.Flags().StringVar(&opts.user, "user", "", "SSO username of ray cluster creator")
 	cmd.Flags().StringVar(&opts.headComputeTemplate, "head-compute-template", "", "compute template name for ray head")
 	cmd.Flags().StringVar(&opts.headImage, "head-image", "", "ray head image")
+	cmd.Flags().StringVar(&opts.headImageTemplate, "head-image-template", "", "image template name for ray head, used instead of the head image")
 	cmd.Flags().StringVar(&opts.headServiceType, "head-service-type", "ClusterIP", "ray head service type (ClusterIP, NodePort, LoadBalancer)")
 	cmd.Flags().StringVar(&opts.workerGroupName, "worker-group-name", "", "first worker group name")
 	cmd.Flags().StringVar(&opts.workerComputeTemplate, "worker-compute-template", "", "compute template name of worker in the first worker group")
 	cmd.Flags().StringVar(&opts.workerImage, "worker-image", "", "image of worker in the first worker group")
+	cmd.Flags().StringVar(&opts.workerImageTemplate, "worker-image-template", "", "image template name of worker in the first worker group, used instead of the worker image")
 	cmd.Flags().Uint32Var(&opts.workerReplicas, "worker-replicas", 1, "pod replicas of workers in the first worker group")
 	if err := cmd.MarkFlagRequired("namespace"); err != nil {
 		klog.Warning(err)
@@ -63,15 +67,9 @@ func newCmdCreate() *cobra.Command {
 	if err := cmd.MarkFlagRequired("user"); err != nil {
 		klog.Warning(err)
 	}
-	if err := cmd.MarkFlagRequired("head-image"); err != nil {
-		klog.Warning(err)
-	}
 	if err := cmd.MarkFlagRequired("head-compute-template"); err != nil {
 		klog.Warning(err)
 	}
-	if err := cmd.MarkFlagRequired("worker-image"); err != nil {
-		klog.Warning(err)
-	}
 	if err := cmd.MarkFlagRequired("worker-compute-template"); err != nil {
 		klog.Warning(err)
 	}
@@ -102,6 +100,15 @@ func createCluster(opts CreateOptions) error {
 		os.Exit(1)
 	}
 
+	if (opts.headImage == "") == (opts.headImageTemplate == "") {
+		fmt.Fprintf(os.Stderr, "error: Exactly one of --head-image and --head-image-template must be specified\n")
+		os.Exit(1)
+	}
+	if (opts.workerImage == "") == (opts.workerImageTemplate == "") {
+		fmt.Fprintf(os.Stderr, "error: Exactly one of --worker-image and --worker-image-template must be specified\n")
+		os.Exit(1)
+	}
+
 	headStartParams := make(map[string]string)
 	headStartParams["port"] = "6379"
 	headStartParams["dashboard-host"] = "0.0.0.0"
@@ -111,6 +118,7 @@ func createCluster(opts CreateOptions) error {
 	headSpec := &go_client.HeadGroupSpec{
 		ComputeTemplate: opts.headComputeTemplate,
 		Image:           opts.headImage,
+		ImageTemplate:   opts.headImageTemplate,
 		ServiceType:     opts.headServiceType,
 		RayStartParams:  headStartParams,
 	}
@@ -124,6 +132,7 @@ func createCluster(opts CreateOptions) error {
 		GroupName:       opts.workerGroupName,
 		ComputeTemplate: opts.workerComputeTemplate,
 		Image:           opts.workerImage,
+		ImageTemplate:   opts.workerImageTemplate,
 		Replicas:        int32(opts.workerReplicas),
 		MinReplicas:     int32(opts.workerReplicas),
 		MaxReplicas:     int32(opts.workerReplicas),
@@ -0,0 +1,97 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/ray-project/kuberay/cli/pkg/cmdutil"
+	"github.com/ray-project/kuberay/proto/go_client"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+)
+
+type CreateOptions struct {
+	name           string
+	namespace      string
+	baseImage      string
+	image          string
+	pipPackages    []string
+	condaPackages  []string
+	systemPackages []string
+	env            map[string]string
+	customCommands string
+}
+
+func newCmdCreate() *cobra.Command {
+	opts := CreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an image template",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return createImageTemplate(opts)
+		},
+	}
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "kubernetes namespace where the image template will be stored")
+	cmd.Flags().StringVar(&opts.name, "name", "", "name of the image template")
+	cmd.Flags().StringVar(&opts.baseImage, "base-image", "", "base image on which the packages are installed")
+	cmd.Flags().StringVar(&opts.image, "image", "", "prebuilt image with the packages installed, used instead of the base image")
+	cmd.Flags().StringSliceVar(&opts.pipPackages, "pip-packages", nil, "pip packages to install")
+	cmd.Flags().StringSliceVar(&opts.condaPackages, "conda-packages", nil, "conda packages to install")
+	cmd.Flags().StringSliceVar(&opts.systemPackages, "system-packages", nil, "system packages to install")
+	cmd.Flags().StringToStringVar(&opts.env, "env", nil, "environment variables to set, e.g. KEY1=VALUE1,KEY2=VALUE2")
+	cmd.Flags().StringVar(&opts.customCommands, "custom-commands", "", "commands to execute after the packages are installed")
+	if err := cmd.MarkFlagRequired("namespace"); err != nil {
+		klog.Warning(err)
+	}
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		klog.Warning(err)
+	}
+
+	return cmd
+}
+
+func createImageTemplate(opts CreateOptions) error {
+	if opts.baseImage == "" && opts.image == "" {
+		fmt.Fprintf(os.Stderr, "error: One of --base-image and --image must be specified\n")
+		os.Exit(1)
+	}
+
+	conn, err := cmdutil.GetGrpcConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// build gRPC client
+	client := go_client.NewImageTemplateServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	imageTemplate := &go_client.ImageTemplate{
+		Name:                 opts.name,
+		Namespace:            opts.namespace,
+		BaseImage:            opts.baseImage,
+		Image:                opts.image,
+		PipPackages:          opts.pipPackages,
+		CondaPackages:        opts.condaPackages,
+		SystemPackages:       opts.systemPackages,
+		EnvironmentVariables: opts.env,
+		CustomCommands:       opts.customCommands,
+	}
+
+	r, err := client.CreateImageTemplate(ctx, &go_client.CreateImageTemplateRequest{
+		Namespace:     opts.namespace,
+		ImageTemplate: imageTemplate,
+	})
+	if err != nil {
+		log.Fatalf("could not create image template %v", err)
+	}
+
+	log.Printf("image template %v has been created in %v", r.Name, r.Namespace)
+	return nil
+}
@@ -0,0 +1,62 @@
+package image
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ray-project/kuberay/cli/pkg/cmdutil"
+	"github.com/ray-project/kuberay/proto/go_client"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+)
+
+type DeleteOptions struct {
+	namespace string
+}
+
+func newCmdDelete() *cobra.Command {
+	opts := DeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <image template name>",
+		Short: "Delete an image template by name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteImageTemplate(args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "",
+		"kubernetes namespace where the image template is stored")
+	if err := cmd.MarkFlagRequired("namespace"); err != nil {
+		klog.Warning(err)
+	}
+
+	return cmd
+}
+
+func deleteImageTemplate(name string, opts DeleteOptions) error {
+	// Get gRPC connection
+	conn, err := cmdutil.GetGrpcConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// build gRPC client
+	client := go_client.NewImageTemplateServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	request := &go_client.DeleteImageTemplateRequest{
+		Name:      name,
+		Namespace: opts.namespace,
+	}
+	if _, err := client.DeleteImageTemplate(ctx, request); err != nil {
+		log.Fatalf("could not delete image template %v", err)
+	}
+
+	log.Printf("image template %v has been deleted", name)
+	return nil
+}
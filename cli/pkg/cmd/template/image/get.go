@@ -0,0 +1,72 @@
+package image
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/ray-project/kuberay/cli/pkg/cmdutil"
+	"github.com/ray-project/kuberay/proto/go_client"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+)
+
+type GetOptions struct {
+	namespace string
+}
+
+func newCmdGet() *cobra.Command {
+	opts := GetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <image template name>",
+		Short: "Get an image template by name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return getImageTemplate(args[0], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "",
+		"kubernetes namespace where the image template is stored")
+	if err := cmd.MarkFlagRequired("namespace"); err != nil {
+		klog.Warning(err)
+	}
+
+	return cmd
+}
+
+func getImageTemplate(name string, opts GetOptions) error {
+	// Get gRPC connection
+	conn, err := cmdutil.GetGrpcConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// build gRPC client
+	client := go_client.NewImageTemplateServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	r, err := client.GetImageTemplate(ctx, &go_client.GetImageTemplateRequest{
+		Name:      name,
+		Namespace: opts.namespace,
+	})
+	if err != nil {
+		log.Fatalf("could not get image template %v", err)
+	}
+
+	rows := [][]string{
+		convertImageTemplateToString(r),
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(imageTemplateHeader)
+	table.AppendBulk(rows)
+	table.Render()
+
+	return nil
+}
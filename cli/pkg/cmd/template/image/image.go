@@ -0,0 +1,20 @@
+package image
+
+import "github.com/spf13/cobra"
+
+func NewCmdImageTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "image <command>",
+		Short: "Manage image template",
+		Annotations: map[string]string{
+			"IsCore": "true",
+		},
+	}
+
+	cmd.AddCommand(newCmdGet())
+	cmd.AddCommand(newCmdList())
+	cmd.AddCommand(newCmdCreate())
+	cmd.AddCommand(newCmdDelete())
+
+	return cmd
+}
@@ -0,0 +1,104 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/ray-project/kuberay/cli/pkg/cmdutil"
+	"github.com/ray-project/kuberay/proto/go_client"
+	"github.com/spf13/cobra"
+)
+
+var imageTemplateHeader = []string{"Name", "Namespace", "Base-Image", "Image", "Pip-Packages", "Conda-Packages", "System-Packages", "Environment", "Custom-Commands"}
+
+type ListOptions struct {
+	namespace string
+}
+
+func newCmdList() *cobra.Command {
+	opts := ListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all image templates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listImageTemplates(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "",
+		"kubernetes namespace where the image template is stored")
+
+	return cmd
+}
+
+func listImageTemplates(opts ListOptions) error {
+	// Get gRPC connection
+	conn, err := cmdutil.GetGrpcConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// build gRPC client
+	client := go_client.NewImageTemplateServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var imageTemplates []*go_client.ImageTemplate
+	if len(opts.namespace) == 0 {
+		r, err := client.ListAllImageTemplates(ctx, &go_client.ListAllImageTemplatesRequest{})
+		if err != nil {
+			log.Fatalf("could not list all image templates %v", err)
+		}
+		imageTemplates = r.GetImageTemplates()
+	} else {
+		r, err := client.ListImageTemplates(ctx, &go_client.ListImageTemplatesRequest{
+			Namespace: opts.namespace,
+		})
+		if err != nil {
+			log.Fatalf("could not list image templates %v", err)
+		}
+		imageTemplates = r.GetImageTemplates()
+	}
+	rows := convertImageTemplatesToStrings(imageTemplates)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(imageTemplateHeader)
+	table.AppendBulk(rows)
+	table.Render()
+
+	return nil
+}
+
+func convertImageTemplatesToStrings(imageTemplates []*go_client.ImageTemplate) [][]string {
+	var data [][]string
+
+	for _, r := range imageTemplates {
+		data = append(data, convertImageTemplateToString(r))
+	}
+
+	return data
+}
+
+func convertImageTemplateToString(r *go_client.ImageTemplate) []string {
+	env := make([]string, 0, len(r.GetEnvironmentVariables()))
+	for k, v := range r.GetEnvironmentVariables() {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(env)
+
+	line := []string{
+		r.GetName(), r.GetNamespace(), r.GetBaseImage(), r.GetImage(),
+		strings.Join(r.GetPipPackages(), ","), strings.Join(r.GetCondaPackages(), ","),
+		strings.Join(r.GetSystemPackages(), ","), strings.Join(env, ","), r.GetCustomCommands(),
+	}
+	return line
+}
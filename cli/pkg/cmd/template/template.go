@@ -2,13 +2,14 @@ package template
 
 import (
 	"github.com/ray-project/kuberay/cli/pkg/cmd/template/compute"
+	"github.com/ray-project/kuberay/cli/pkg/cmd/template/image"
 	"github.com/spf13/cobra"
 )
 
 func NewCmdTemplate() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "template <command>",
-		Short: "Manage templates (compute)",
+		Short: "Manage templates (compute, image)",
 		Long:  ``,
 		Annotations: map[string]string{
 			"IsCore": "true",
@@ -16,6 +17,7 @@ func NewCmdTemplate() *cobra.Command {
 	}
 
 	cmd.AddCommand(compute.NewCmdComputeTemplate())
+	cmd.AddCommand(image.NewCmdImageTemplate())
 
 	return cmd
 }
@@ -27,10 +27,23 @@ type ClusterServiceClient interface {
 	ListCluster(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
 	// Finds all Clusters in all namespaces. Supports pagination, and sorting on certain fields.
 	ListAllClusters(ctx context.Context, in *ListAllClustersRequest, opts ...grpc.CallOption) (*ListAllClustersResponse, error)
+	// Replaces a Cluster with the given one. The cluster is rebuilt from the compute and image templates, so
+	// set resource_version to the one of the cluster that was read to fail if it was modified in between.
+	UpdateCluster(ctx context.Context, in *UpdateClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
+	// Updates the fields of a Cluster in the update mask: `annotations`, `suspend` and
+	// `cluster_spec.worker_group_spec`. Worker groups are matched by name, existing groups keep their
+	// pod template and only update their replicas, min and max replicas.
+	PatchCluster(ctx context.Context, in *PatchClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
+	// Returns the live RayCluster custom resource of a Cluster as YAML.
+	GetClusterManifest(ctx context.Context, in *GetClusterManifestRequest, opts ...grpc.CallOption) (*GetClusterManifestResponse, error)
 	// Deletes an cluster without deleting the cluster's runs and jobs. To
 	// avoid unexpected behaviors, delete an cluster's runs and jobs before
 	// deleting the cluster.
 	DeleteCluster(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Watches the Clusters in a given namespace, or in all namespaces. The stream emits an event whenever a
+	// cluster is added, modified or deleted, and ends when the underlying Kubernetes watch times out.
+	// Resume it from the resource_version of the last received event.
+	WatchClusters(ctx context.Context, in *WatchClustersRequest, opts ...grpc.CallOption) (ClusterService_WatchClustersClient, error)
 }
 
 type clusterServiceClient struct {
@@ -77,6 +90,33 @@ func (c *clusterServiceClient) ListAllClusters(ctx context.Context, in *ListAllC
 	return out, nil
 }
 
+func (c *clusterServiceClient) UpdateCluster(ctx context.Context, in *UpdateClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/proto.ClusterService/UpdateCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) PatchCluster(ctx context.Context, in *PatchClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/proto.ClusterService/PatchCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetClusterManifest(ctx context.Context, in *GetClusterManifestRequest, opts ...grpc.CallOption) (*GetClusterManifestResponse, error) {
+	out := new(GetClusterManifestResponse)
+	err := c.cc.Invoke(ctx, "/proto.ClusterService/GetClusterManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) DeleteCluster(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/proto.ClusterService/DeleteCluster", in, out, opts...)
@@ -86,6 +126,38 @@ func (c *clusterServiceClient) DeleteCluster(ctx context.Context, in *DeleteClus
 	return out, nil
 }
 
+func (c *clusterServiceClient) WatchClusters(ctx context.Context, in *WatchClustersRequest, opts ...grpc.CallOption) (ClusterService_WatchClustersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ClusterService_ServiceDesc.Streams[0], "/proto.ClusterService/WatchClusters", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clusterServiceWatchClustersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClusterService_WatchClustersClient interface {
+	Recv() (*WatchClustersResponse, error)
+	grpc.ClientStream
+}
+
+type clusterServiceWatchClustersClient struct {
+	grpc.ClientStream
+}
+
+func (x *clusterServiceWatchClustersClient) Recv() (*WatchClustersResponse, error) {
+	m := new(WatchClustersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 // All implementations must embed UnimplementedClusterServiceServer
 // for forward compatibility
@@ -98,10 +170,23 @@ type ClusterServiceServer interface {
 	ListCluster(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
 	// Finds all Clusters in all namespaces. Supports pagination, and sorting on certain fields.
 	ListAllClusters(context.Context, *ListAllClustersRequest) (*ListAllClustersResponse, error)
+	// Replaces a Cluster with the given one. The cluster is rebuilt from the compute and image templates, so
+	// set resource_version to the one of the cluster that was read to fail if it was modified in between.
+	UpdateCluster(context.Context, *UpdateClusterRequest) (*Cluster, error)
+	// Updates the fields of a Cluster in the update mask: `annotations`, `suspend` and
+	// `cluster_spec.worker_group_spec`. Worker groups are matched by name, existing groups keep their
+	// pod template and only update their replicas, min and max replicas.
+	PatchCluster(context.Context, *PatchClusterRequest) (*Cluster, error)
+	// Returns the live RayCluster custom resource of a Cluster as YAML.
+	GetClusterManifest(context.Context, *GetClusterManifestRequest) (*GetClusterManifestResponse, error)
 	// Deletes an cluster without deleting the cluster's runs and jobs. To
 	// avoid unexpected behaviors, delete an cluster's runs and jobs before
 	// deleting the cluster.
 	DeleteCluster(context.Context, *DeleteClusterRequest) (*emptypb.Empty, error)
+	// Watches the Clusters in a given namespace, or in all namespaces. The stream emits an event whenever a
+	// cluster is added, modified or deleted, and ends when the underlying Kubernetes watch times out.
+	// Resume it from the resource_version of the last received event.
+	WatchClusters(*WatchClustersRequest, ClusterService_WatchClustersServer) error
 	mustEmbedUnimplementedClusterServiceServer()
 }
 
@@ -121,9 +206,21 @@ func (UnimplementedClusterServiceServer) ListCluster(context.Context, *ListClust
 func (UnimplementedClusterServiceServer) ListAllClusters(context.Context, *ListAllClustersRequest) (*ListAllClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllClusters not implemented")
 }
+func (UnimplementedClusterServiceServer) UpdateCluster(context.Context, *UpdateClusterRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) PatchCluster(context.Context, *PatchClusterRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) GetClusterManifest(context.Context, *GetClusterManifestRequest) (*GetClusterManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterManifest not implemented")
+}
 func (UnimplementedClusterServiceServer) DeleteCluster(context.Context, *DeleteClusterRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
 }
+func (UnimplementedClusterServiceServer) WatchClusters(*WatchClustersRequest, ClusterService_WatchClustersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchClusters not implemented")
+}
 func (UnimplementedClusterServiceServer) mustEmbedUnimplementedClusterServiceServer() {}
 
 // UnsafeClusterServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -209,6 +306,60 @@ func _ClusterService_ListAllClusters_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_UpdateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UpdateCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ClusterService/UpdateCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UpdateCluster(ctx, req.(*UpdateClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_PatchCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).PatchCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ClusterService/PatchCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).PatchCluster(ctx, req.(*PatchClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetClusterManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetClusterManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ClusterService/GetClusterManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetClusterManifest(ctx, req.(*GetClusterManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterService_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteClusterRequest)
 	if err := dec(in); err != nil {
@@ -227,6 +378,27 @@ func _ClusterService_DeleteCluster_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_WatchClusters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClustersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServiceServer).WatchClusters(m, &clusterServiceWatchClustersServer{stream})
+}
+
+type ClusterService_WatchClustersServer interface {
+	Send(*WatchClustersResponse) error
+	grpc.ServerStream
+}
+
+type clusterServiceWatchClustersServer struct {
+	grpc.ServerStream
+}
+
+func (x *clusterServiceWatchClustersServer) Send(m *WatchClustersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -250,11 +422,29 @@ var ClusterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAllClusters",
 			Handler:    _ClusterService_ListAllClusters_Handler,
 		},
+		{
+			MethodName: "UpdateCluster",
+			Handler:    _ClusterService_UpdateCluster_Handler,
+		},
+		{
+			MethodName: "PatchCluster",
+			Handler:    _ClusterService_PatchCluster_Handler,
+		},
+		{
+			MethodName: "GetClusterManifest",
+			Handler:    _ClusterService_GetClusterManifest_Handler,
+		},
 		{
 			MethodName: "DeleteCluster",
 			Handler:    _ClusterService_DeleteCluster_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchClusters",
+			Handler:       _ClusterService_WatchClusters_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cluster.proto",
 }
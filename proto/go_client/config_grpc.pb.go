@@ -23,9 +23,9 @@ type ComputeTemplateServiceClient interface {
 	CreateComputeTemplate(ctx context.Context, in *CreateComputeTemplateRequest, opts ...grpc.CallOption) (*ComputeTemplate, error)
 	// Finds a specific compute template by its name and namespace.
 	GetComputeTemplate(ctx context.Context, in *GetComputeTemplateRequest, opts ...grpc.CallOption) (*ComputeTemplate, error)
-	// Finds all compute templates in a given namespace. Supports pagination, and sorting on certain fields.
+	// Finds all compute templates in a given namespace. Supports pagination, label selectors, and sorting on certain fields.
 	ListComputeTemplates(ctx context.Context, in *ListComputeTemplatesRequest, opts ...grpc.CallOption) (*ListComputeTemplatesResponse, error)
-	// Finds all compute templates in all namespaces. Supports pagination, and sorting on certain fields.
+	// Finds all compute templates in all namespaces. Supports pagination, label selectors, and sorting on certain fields.
 	ListAllComputeTemplates(ctx context.Context, in *ListAllComputeTemplatesRequest, opts ...grpc.CallOption) (*ListAllComputeTemplatesResponse, error)
 	// Deletes a compute template by its name and namespace
 	DeleteComputeTemplate(ctx context.Context, in *DeleteComputeTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -92,9 +92,9 @@ type ComputeTemplateServiceServer interface {
 	CreateComputeTemplate(context.Context, *CreateComputeTemplateRequest) (*ComputeTemplate, error)
 	// Finds a specific compute template by its name and namespace.
 	GetComputeTemplate(context.Context, *GetComputeTemplateRequest) (*ComputeTemplate, error)
-	// Finds all compute templates in a given namespace. Supports pagination, and sorting on certain fields.
+	// Finds all compute templates in a given namespace. Supports pagination, label selectors, and sorting on certain fields.
 	ListComputeTemplates(context.Context, *ListComputeTemplatesRequest) (*ListComputeTemplatesResponse, error)
-	// Finds all compute templates in all namespaces. Supports pagination, and sorting on certain fields.
+	// Finds all compute templates in all namespaces. Supports pagination, label selectors, and sorting on certain fields.
 	ListAllComputeTemplates(context.Context, *ListAllComputeTemplatesRequest) (*ListAllComputeTemplatesResponse, error)
 	// Deletes a compute template by its name and namespace
 	DeleteComputeTemplate(context.Context, *DeleteComputeTemplateRequest) (*emptypb.Empty, error)
@@ -260,13 +260,15 @@ var ComputeTemplateService_ServiceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ImageTemplateServiceClient interface {
-	// Not implemented. Creates a new ImageTemplate.
+	// Creates a new image template.
 	CreateImageTemplate(ctx context.Context, in *CreateImageTemplateRequest, opts ...grpc.CallOption) (*ImageTemplate, error)
-	// Not implemented. Finds a specific ImageTemplate by ID.
+	// Finds a specific image template by its name and namespace.
 	GetImageTemplate(ctx context.Context, in *GetImageTemplateRequest, opts ...grpc.CallOption) (*ImageTemplate, error)
-	// Not Implemented. Finds all ImageTemplates. Supports pagination, and sorting on certain fields.
+	// Finds all image templates in a given namespace.
 	ListImageTemplates(ctx context.Context, in *ListImageTemplatesRequest, opts ...grpc.CallOption) (*ListImageTemplatesResponse, error)
-	// Not implemented. Deletes an ImageTemplate.
+	// Finds all image templates in all namespaces.
+	ListAllImageTemplates(ctx context.Context, in *ListAllImageTemplatesRequest, opts ...grpc.CallOption) (*ListAllImageTemplatesResponse, error)
+	// Deletes an image template by its name and namespace.
 	DeleteImageTemplate(ctx context.Context, in *DeleteImageTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -305,6 +307,15 @@ func (c *imageTemplateServiceClient) ListImageTemplates(ctx context.Context, in
 	return out, nil
 }
 
+func (c *imageTemplateServiceClient) ListAllImageTemplates(ctx context.Context, in *ListAllImageTemplatesRequest, opts ...grpc.CallOption) (*ListAllImageTemplatesResponse, error) {
+	out := new(ListAllImageTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/proto.ImageTemplateService/ListAllImageTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageTemplateServiceClient) DeleteImageTemplate(ctx context.Context, in *DeleteImageTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/proto.ImageTemplateService/DeleteImageTemplate", in, out, opts...)
@@ -318,13 +329,15 @@ func (c *imageTemplateServiceClient) DeleteImageTemplate(ctx context.Context, in
 // All implementations must embed UnimplementedImageTemplateServiceServer
 // for forward compatibility
 type ImageTemplateServiceServer interface {
-	// Not implemented. Creates a new ImageTemplate.
+	// Creates a new image template.
 	CreateImageTemplate(context.Context, *CreateImageTemplateRequest) (*ImageTemplate, error)
-	// Not implemented. Finds a specific ImageTemplate by ID.
+	// Finds a specific image template by its name and namespace.
 	GetImageTemplate(context.Context, *GetImageTemplateRequest) (*ImageTemplate, error)
-	// Not Implemented. Finds all ImageTemplates. Supports pagination, and sorting on certain fields.
+	// Finds all image templates in a given namespace.
 	ListImageTemplates(context.Context, *ListImageTemplatesRequest) (*ListImageTemplatesResponse, error)
-	// Not implemented. Deletes an ImageTemplate.
+	// Finds all image templates in all namespaces.
+	ListAllImageTemplates(context.Context, *ListAllImageTemplatesRequest) (*ListAllImageTemplatesResponse, error)
+	// Deletes an image template by its name and namespace.
 	DeleteImageTemplate(context.Context, *DeleteImageTemplateRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedImageTemplateServiceServer()
 }
@@ -342,6 +355,9 @@ func (UnimplementedImageTemplateServiceServer) GetImageTemplate(context.Context,
 func (UnimplementedImageTemplateServiceServer) ListImageTemplates(context.Context, *ListImageTemplatesRequest) (*ListImageTemplatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImageTemplates not implemented")
 }
+func (UnimplementedImageTemplateServiceServer) ListAllImageTemplates(context.Context, *ListAllImageTemplatesRequest) (*ListAllImageTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllImageTemplates not implemented")
+}
 func (UnimplementedImageTemplateServiceServer) DeleteImageTemplate(context.Context, *DeleteImageTemplateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteImageTemplate not implemented")
 }
@@ -412,6 +428,24 @@ func _ImageTemplateService_ListImageTemplates_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageTemplateService_ListAllImageTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllImageTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageTemplateServiceServer).ListAllImageTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ImageTemplateService/ListAllImageTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageTemplateServiceServer).ListAllImageTemplates(ctx, req.(*ListAllImageTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImageTemplateService_DeleteImageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteImageTemplateRequest)
 	if err := dec(in); err != nil {
@@ -449,6 +483,10 @@ var ImageTemplateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListImageTemplates",
 			Handler:    _ImageTemplateService_ListImageTemplates_Handler,
 		},
+		{
+			MethodName: "ListAllImageTemplates",
+			Handler:    _ImageTemplateService_ListAllImageTemplates_Handler,
+		},
 		{
 			MethodName: "DeleteImageTemplate",
 			Handler:    _ImageTemplateService_DeleteImageTemplate_Handler,
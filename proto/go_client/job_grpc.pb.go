@@ -23,12 +23,23 @@ type RayJobServiceClient interface {
 	CreateRayJob(ctx context.Context, in *CreateRayJobRequest, opts ...grpc.CallOption) (*RayJob, error)
 	// Finds a specific job by its name and namespace.
 	GetRayJob(ctx context.Context, in *GetRayJobRequest, opts ...grpc.CallOption) (*RayJob, error)
+	// Returns the live RayJob custom resource of a job as YAML.
+	GetRayJobManifest(ctx context.Context, in *GetRayJobManifestRequest, opts ...grpc.CallOption) (*GetRayJobManifestResponse, error)
 	// Finds all job in a given namespace. Supports pagination, and sorting on certain fields.
 	ListRayJobs(ctx context.Context, in *ListRayJobsRequest, opts ...grpc.CallOption) (*ListRayJobsResponse, error)
 	// Finds all job in all namespaces. Supports pagination, and sorting on certain fields.
 	ListAllRayJobs(ctx context.Context, in *ListAllRayJobsRequest, opts ...grpc.CallOption) (*ListAllRayJobsResponse, error)
 	// Deletes a job by its name and namespace.
 	DeleteRayJob(ctx context.Context, in *DeleteRayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Suspends a job, deleting its RayCluster until it is resumed. Only jobs that shut down their
+	// cluster after they finish, and do not run on a selected cluster or a cluster pool, can be suspended.
+	SuspendRayJob(ctx context.Context, in *SuspendRayJobRequest, opts ...grpc.CallOption) (*RayJob, error)
+	// Resumes a suspended job, creating a new RayCluster to run it.
+	ResumeRayJob(ctx context.Context, in *ResumeRayJobRequest, opts ...grpc.CallOption) (*RayJob, error)
+	// Watches the RayJobs in a given namespace, or in all namespaces. The stream emits an event whenever a
+	// job is added, modified or deleted, and ends when the underlying Kubernetes watch times out.
+	// Resume it from the resource_version of the last received event.
+	WatchRayJobs(ctx context.Context, in *WatchRayJobsRequest, opts ...grpc.CallOption) (RayJobService_WatchRayJobsClient, error)
 }
 
 type rayJobServiceClient struct {
@@ -57,6 +68,15 @@ func (c *rayJobServiceClient) GetRayJob(ctx context.Context, in *GetRayJobReques
 	return out, nil
 }
 
+func (c *rayJobServiceClient) GetRayJobManifest(ctx context.Context, in *GetRayJobManifestRequest, opts ...grpc.CallOption) (*GetRayJobManifestResponse, error) {
+	out := new(GetRayJobManifestResponse)
+	err := c.cc.Invoke(ctx, "/proto.RayJobService/GetRayJobManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rayJobServiceClient) ListRayJobs(ctx context.Context, in *ListRayJobsRequest, opts ...grpc.CallOption) (*ListRayJobsResponse, error) {
 	out := new(ListRayJobsResponse)
 	err := c.cc.Invoke(ctx, "/proto.RayJobService/ListRayJobs", in, out, opts...)
@@ -84,6 +104,56 @@ func (c *rayJobServiceClient) DeleteRayJob(ctx context.Context, in *DeleteRayJob
 	return out, nil
 }
 
+func (c *rayJobServiceClient) SuspendRayJob(ctx context.Context, in *SuspendRayJobRequest, opts ...grpc.CallOption) (*RayJob, error) {
+	out := new(RayJob)
+	err := c.cc.Invoke(ctx, "/proto.RayJobService/SuspendRayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rayJobServiceClient) ResumeRayJob(ctx context.Context, in *ResumeRayJobRequest, opts ...grpc.CallOption) (*RayJob, error) {
+	out := new(RayJob)
+	err := c.cc.Invoke(ctx, "/proto.RayJobService/ResumeRayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rayJobServiceClient) WatchRayJobs(ctx context.Context, in *WatchRayJobsRequest, opts ...grpc.CallOption) (RayJobService_WatchRayJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RayJobService_ServiceDesc.Streams[0], "/proto.RayJobService/WatchRayJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rayJobServiceWatchRayJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RayJobService_WatchRayJobsClient interface {
+	Recv() (*WatchRayJobsResponse, error)
+	grpc.ClientStream
+}
+
+type rayJobServiceWatchRayJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rayJobServiceWatchRayJobsClient) Recv() (*WatchRayJobsResponse, error) {
+	m := new(WatchRayJobsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RayJobServiceServer is the server API for RayJobService service.
 // All implementations must embed UnimplementedRayJobServiceServer
 // for forward compatibility
@@ -92,12 +162,23 @@ type RayJobServiceServer interface {
 	CreateRayJob(context.Context, *CreateRayJobRequest) (*RayJob, error)
 	// Finds a specific job by its name and namespace.
 	GetRayJob(context.Context, *GetRayJobRequest) (*RayJob, error)
+	// Returns the live RayJob custom resource of a job as YAML.
+	GetRayJobManifest(context.Context, *GetRayJobManifestRequest) (*GetRayJobManifestResponse, error)
 	// Finds all job in a given namespace. Supports pagination, and sorting on certain fields.
 	ListRayJobs(context.Context, *ListRayJobsRequest) (*ListRayJobsResponse, error)
 	// Finds all job in all namespaces. Supports pagination, and sorting on certain fields.
 	ListAllRayJobs(context.Context, *ListAllRayJobsRequest) (*ListAllRayJobsResponse, error)
 	// Deletes a job by its name and namespace.
 	DeleteRayJob(context.Context, *DeleteRayJobRequest) (*emptypb.Empty, error)
+	// Suspends a job, deleting its RayCluster until it is resumed. Only jobs that shut down their
+	// cluster after they finish, and do not run on a selected cluster or a cluster pool, can be suspended.
+	SuspendRayJob(context.Context, *SuspendRayJobRequest) (*RayJob, error)
+	// Resumes a suspended job, creating a new RayCluster to run it.
+	ResumeRayJob(context.Context, *ResumeRayJobRequest) (*RayJob, error)
+	// Watches the RayJobs in a given namespace, or in all namespaces. The stream emits an event whenever a
+	// job is added, modified or deleted, and ends when the underlying Kubernetes watch times out.
+	// Resume it from the resource_version of the last received event.
+	WatchRayJobs(*WatchRayJobsRequest, RayJobService_WatchRayJobsServer) error
 	mustEmbedUnimplementedRayJobServiceServer()
 }
 
@@ -111,6 +192,9 @@ func (UnimplementedRayJobServiceServer) CreateRayJob(context.Context, *CreateRay
 func (UnimplementedRayJobServiceServer) GetRayJob(context.Context, *GetRayJobRequest) (*RayJob, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRayJob not implemented")
 }
+func (UnimplementedRayJobServiceServer) GetRayJobManifest(context.Context, *GetRayJobManifestRequest) (*GetRayJobManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRayJobManifest not implemented")
+}
 func (UnimplementedRayJobServiceServer) ListRayJobs(context.Context, *ListRayJobsRequest) (*ListRayJobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRayJobs not implemented")
 }
@@ -120,6 +204,15 @@ func (UnimplementedRayJobServiceServer) ListAllRayJobs(context.Context, *ListAll
 func (UnimplementedRayJobServiceServer) DeleteRayJob(context.Context, *DeleteRayJobRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRayJob not implemented")
 }
+func (UnimplementedRayJobServiceServer) SuspendRayJob(context.Context, *SuspendRayJobRequest) (*RayJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendRayJob not implemented")
+}
+func (UnimplementedRayJobServiceServer) ResumeRayJob(context.Context, *ResumeRayJobRequest) (*RayJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRayJob not implemented")
+}
+func (UnimplementedRayJobServiceServer) WatchRayJobs(*WatchRayJobsRequest, RayJobService_WatchRayJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRayJobs not implemented")
+}
 func (UnimplementedRayJobServiceServer) mustEmbedUnimplementedRayJobServiceServer() {}
 
 // UnsafeRayJobServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -169,6 +262,24 @@ func _RayJobService_GetRayJob_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RayJobService_GetRayJobManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRayJobManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RayJobServiceServer).GetRayJobManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RayJobService/GetRayJobManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RayJobServiceServer).GetRayJobManifest(ctx, req.(*GetRayJobManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RayJobService_ListRayJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRayJobsRequest)
 	if err := dec(in); err != nil {
@@ -223,6 +334,63 @@ func _RayJobService_DeleteRayJob_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RayJobService_SuspendRayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendRayJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RayJobServiceServer).SuspendRayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RayJobService/SuspendRayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RayJobServiceServer).SuspendRayJob(ctx, req.(*SuspendRayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RayJobService_ResumeRayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRayJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RayJobServiceServer).ResumeRayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RayJobService/ResumeRayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RayJobServiceServer).ResumeRayJob(ctx, req.(*ResumeRayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RayJobService_WatchRayJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRayJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RayJobServiceServer).WatchRayJobs(m, &rayJobServiceWatchRayJobsServer{stream})
+}
+
+type RayJobService_WatchRayJobsServer interface {
+	Send(*WatchRayJobsResponse) error
+	grpc.ServerStream
+}
+
+type rayJobServiceWatchRayJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rayJobServiceWatchRayJobsServer) Send(m *WatchRayJobsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RayJobService_ServiceDesc is the grpc.ServiceDesc for RayJobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -238,6 +406,10 @@ var RayJobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRayJob",
 			Handler:    _RayJobService_GetRayJob_Handler,
 		},
+		{
+			MethodName: "GetRayJobManifest",
+			Handler:    _RayJobService_GetRayJobManifest_Handler,
+		},
 		{
 			MethodName: "ListRayJobs",
 			Handler:    _RayJobService_ListRayJobs_Handler,
@@ -250,7 +422,21 @@ var RayJobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRayJob",
 			Handler:    _RayJobService_DeleteRayJob_Handler,
 		},
+		{
+			MethodName: "SuspendRayJob",
+			Handler:    _RayJobService_SuspendRayJob_Handler,
+		},
+		{
+			MethodName: "ResumeRayJob",
+			Handler:    _RayJobService_ResumeRayJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRayJobs",
+			Handler:       _RayJobService_WatchRayJobs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "job.proto",
 }
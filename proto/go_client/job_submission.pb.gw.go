@@ -303,6 +303,71 @@ func local_request_RayJobSubmissionService_GetJobLog_0(ctx context.Context, mars
 
 }
 
+var (
+	filter_RayJobSubmissionService_TailJobLog_0 = &utilities.DoubleArray{Encoding: map[string]int{"namespace": 0, "clustername": 1, "submissionid": 2}, Base: []int{1, 1, 2, 3, 0, 0, 0}, Check: []int{0, 1, 1, 1, 2, 3, 4}}
+)
+
+func request_RayJobSubmissionService_TailJobLog_0(ctx context.Context, marshaler runtime.Marshaler, client RayJobSubmissionServiceClient, req *http.Request, pathParams map[string]string) (RayJobSubmissionService_TailJobLogClient, runtime.ServerMetadata, error) {
+	var protoReq TailJobLogRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["namespace"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "namespace")
+	}
+
+	protoReq.Namespace, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "namespace", err)
+	}
+
+	val, ok = pathParams["clustername"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "clustername")
+	}
+
+	protoReq.Clustername, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "clustername", err)
+	}
+
+	val, ok = pathParams["submissionid"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "submissionid")
+	}
+
+	protoReq.Submissionid, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "submissionid", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_RayJobSubmissionService_TailJobLog_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.TailJobLog(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 func request_RayJobSubmissionService_ListJobDetails_0(ctx context.Context, marshaler runtime.Marshaler, client RayJobSubmissionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ListJobDetailsRequest
 	var metadata runtime.ServerMetadata
@@ -634,6 +699,13 @@ func RegisterRayJobSubmissionServiceHandlerServer(ctx context.Context, mux *runt
 
 	})
 
+	mux.Handle("GET", pattern_RayJobSubmissionService_TailJobLog_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	mux.Handle("GET", pattern_RayJobSubmissionService_ListJobDetails_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -804,6 +876,26 @@ func RegisterRayJobSubmissionServiceHandlerClient(ctx context.Context, mux *runt
 
 	})
 
+	mux.Handle("GET", pattern_RayJobSubmissionService_TailJobLog_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req, "/proto.RayJobSubmissionService/TailJobLog", runtime.WithHTTPPathPattern("/apis/v1/namespaces/{namespace}/jobsubmissions/{clustername}/log/{submissionid}/tail"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_RayJobSubmissionService_TailJobLog_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_RayJobSubmissionService_TailJobLog_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("GET", pattern_RayJobSubmissionService_ListJobDetails_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -874,6 +966,8 @@ var (
 
 	pattern_RayJobSubmissionService_GetJobLog_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6, 1, 0, 4, 1, 5, 7}, []string{"apis", "v1", "namespaces", "namespace", "jobsubmissions", "clustername", "log", "submissionid"}, ""))
 
+	pattern_RayJobSubmissionService_TailJobLog_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6, 1, 0, 4, 1, 5, 7, 2, 8}, []string{"apis", "v1", "namespaces", "namespace", "jobsubmissions", "clustername", "log", "submissionid", "tail"}, ""))
+
 	pattern_RayJobSubmissionService_ListJobDetails_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"apis", "v1", "namespaces", "namespace", "jobsubmissions", "clustername"}, ""))
 
 	pattern_RayJobSubmissionService_StopRayJob_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 1, 0, 4, 1, 5, 6}, []string{"apis", "v1", "namespaces", "namespace", "jobsubmissions", "clustername", "submissionid"}, ""))
@@ -888,6 +982,8 @@ var (
 
 	forward_RayJobSubmissionService_GetJobLog_0 = runtime.ForwardResponseMessage
 
+	forward_RayJobSubmissionService_TailJobLog_0 = runtime.ForwardResponseStream
+
 	forward_RayJobSubmissionService_ListJobDetails_0 = runtime.ForwardResponseMessage
 
 	forward_RayJobSubmissionService_StopRayJob_0 = runtime.ForwardResponseMessage
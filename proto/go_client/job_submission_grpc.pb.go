@@ -25,6 +25,9 @@ type RayJobSubmissionServiceClient interface {
 	GetJobDetails(ctx context.Context, in *GetJobDetailsRequest, opts ...grpc.CallOption) (*JobSubmissionInfo, error)
 	// Gets a specific job log by its submissionid for the cluster with name and namespace.
 	GetJobLog(ctx context.Context, in *GetJobLogRequest, opts ...grpc.CallOption) (*GetJobLogReply, error)
+	// Follows a specific job log by its submissionid for the cluster with name and namespace. The stream
+	// emits the log as it is written, and ends when the job reaches a terminal state.
+	TailJobLog(ctx context.Context, in *TailJobLogRequest, opts ...grpc.CallOption) (RayJobSubmissionService_TailJobLogClient, error)
 	// List all job in a given a given cluster in a namespace. Supports pagination, and sorting on certain fields.
 	ListJobDetails(ctx context.Context, in *ListJobDetailsRequest, opts ...grpc.CallOption) (*ListJobSubmissionInfo, error)
 	// Stops a job by its name and namespace.
@@ -68,6 +71,38 @@ func (c *rayJobSubmissionServiceClient) GetJobLog(ctx context.Context, in *GetJo
 	return out, nil
 }
 
+func (c *rayJobSubmissionServiceClient) TailJobLog(ctx context.Context, in *TailJobLogRequest, opts ...grpc.CallOption) (RayJobSubmissionService_TailJobLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RayJobSubmissionService_ServiceDesc.Streams[0], "/proto.RayJobSubmissionService/TailJobLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rayJobSubmissionServiceTailJobLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RayJobSubmissionService_TailJobLogClient interface {
+	Recv() (*TailJobLogReply, error)
+	grpc.ClientStream
+}
+
+type rayJobSubmissionServiceTailJobLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *rayJobSubmissionServiceTailJobLogClient) Recv() (*TailJobLogReply, error) {
+	m := new(TailJobLogReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *rayJobSubmissionServiceClient) ListJobDetails(ctx context.Context, in *ListJobDetailsRequest, opts ...grpc.CallOption) (*ListJobSubmissionInfo, error) {
 	out := new(ListJobSubmissionInfo)
 	err := c.cc.Invoke(ctx, "/proto.RayJobSubmissionService/ListJobDetails", in, out, opts...)
@@ -105,6 +140,9 @@ type RayJobSubmissionServiceServer interface {
 	GetJobDetails(context.Context, *GetJobDetailsRequest) (*JobSubmissionInfo, error)
 	// Gets a specific job log by its submissionid for the cluster with name and namespace.
 	GetJobLog(context.Context, *GetJobLogRequest) (*GetJobLogReply, error)
+	// Follows a specific job log by its submissionid for the cluster with name and namespace. The stream
+	// emits the log as it is written, and ends when the job reaches a terminal state.
+	TailJobLog(*TailJobLogRequest, RayJobSubmissionService_TailJobLogServer) error
 	// List all job in a given a given cluster in a namespace. Supports pagination, and sorting on certain fields.
 	ListJobDetails(context.Context, *ListJobDetailsRequest) (*ListJobSubmissionInfo, error)
 	// Stops a job by its name and namespace.
@@ -127,6 +165,9 @@ func (UnimplementedRayJobSubmissionServiceServer) GetJobDetails(context.Context,
 func (UnimplementedRayJobSubmissionServiceServer) GetJobLog(context.Context, *GetJobLogRequest) (*GetJobLogReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobLog not implemented")
 }
+func (UnimplementedRayJobSubmissionServiceServer) TailJobLog(*TailJobLogRequest, RayJobSubmissionService_TailJobLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailJobLog not implemented")
+}
 func (UnimplementedRayJobSubmissionServiceServer) ListJobDetails(context.Context, *ListJobDetailsRequest) (*ListJobSubmissionInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJobDetails not implemented")
 }
@@ -204,6 +245,27 @@ func _RayJobSubmissionService_GetJobLog_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RayJobSubmissionService_TailJobLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailJobLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RayJobSubmissionServiceServer).TailJobLog(m, &rayJobSubmissionServiceTailJobLogServer{stream})
+}
+
+type RayJobSubmissionService_TailJobLogServer interface {
+	Send(*TailJobLogReply) error
+	grpc.ServerStream
+}
+
+type rayJobSubmissionServiceTailJobLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *rayJobSubmissionServiceTailJobLogServer) Send(m *TailJobLogReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RayJobSubmissionService_ListJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListJobDetailsRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +352,12 @@ var RayJobSubmissionService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _RayJobSubmissionService_DeleteRayJob_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailJobLog",
+			Handler:       _RayJobSubmissionService_TailJobLog_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "job_submission.proto",
 }
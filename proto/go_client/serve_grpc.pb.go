@@ -26,12 +26,18 @@ type RayServeServiceClient interface {
 	UpdateRayService(ctx context.Context, in *UpdateRayServiceRequest, opts ...grpc.CallOption) (*RayService, error)
 	// Find a specific ray serve by name and namespace.
 	GetRayService(ctx context.Context, in *GetRayServiceRequest, opts ...grpc.CallOption) (*RayService, error)
+	// Returns the live RayService custom resource of a ray service as YAML.
+	GetRayServiceManifest(ctx context.Context, in *GetRayServiceManifestRequest, opts ...grpc.CallOption) (*GetRayServiceManifestResponse, error)
 	// Finds all ray services in a given namespace. Supports pagination, and sorting on certain fields.
 	ListRayServices(ctx context.Context, in *ListRayServicesRequest, opts ...grpc.CallOption) (*ListRayServicesResponse, error)
 	// Finds all ray services in a given namespace. Supports pagination, and sorting on certain fields.
 	ListAllRayServices(ctx context.Context, in *ListAllRayServicesRequest, opts ...grpc.CallOption) (*ListAllRayServicesResponse, error)
 	// Deletes a ray service by its name and namespace
 	DeleteRayService(ctx context.Context, in *DeleteRayServiceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Watches the RayServices in a given namespace, or in all namespaces. The stream emits an event whenever a
+	// service is added, modified or deleted, and ends when the underlying Kubernetes watch times out.
+	// Resume it from the resource_version of the last received event.
+	WatchRayServices(ctx context.Context, in *WatchRayServicesRequest, opts ...grpc.CallOption) (RayServeService_WatchRayServicesClient, error)
 }
 
 type rayServeServiceClient struct {
@@ -69,6 +75,15 @@ func (c *rayServeServiceClient) GetRayService(ctx context.Context, in *GetRaySer
 	return out, nil
 }
 
+func (c *rayServeServiceClient) GetRayServiceManifest(ctx context.Context, in *GetRayServiceManifestRequest, opts ...grpc.CallOption) (*GetRayServiceManifestResponse, error) {
+	out := new(GetRayServiceManifestResponse)
+	err := c.cc.Invoke(ctx, "/proto.RayServeService/GetRayServiceManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rayServeServiceClient) ListRayServices(ctx context.Context, in *ListRayServicesRequest, opts ...grpc.CallOption) (*ListRayServicesResponse, error) {
 	out := new(ListRayServicesResponse)
 	err := c.cc.Invoke(ctx, "/proto.RayServeService/ListRayServices", in, out, opts...)
@@ -96,6 +111,38 @@ func (c *rayServeServiceClient) DeleteRayService(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *rayServeServiceClient) WatchRayServices(ctx context.Context, in *WatchRayServicesRequest, opts ...grpc.CallOption) (RayServeService_WatchRayServicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RayServeService_ServiceDesc.Streams[0], "/proto.RayServeService/WatchRayServices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rayServeServiceWatchRayServicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RayServeService_WatchRayServicesClient interface {
+	Recv() (*WatchRayServicesResponse, error)
+	grpc.ClientStream
+}
+
+type rayServeServiceWatchRayServicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *rayServeServiceWatchRayServicesClient) Recv() (*WatchRayServicesResponse, error) {
+	m := new(WatchRayServicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RayServeServiceServer is the server API for RayServeService service.
 // All implementations must embed UnimplementedRayServeServiceServer
 // for forward compatibility
@@ -107,12 +154,18 @@ type RayServeServiceServer interface {
 	UpdateRayService(context.Context, *UpdateRayServiceRequest) (*RayService, error)
 	// Find a specific ray serve by name and namespace.
 	GetRayService(context.Context, *GetRayServiceRequest) (*RayService, error)
+	// Returns the live RayService custom resource of a ray service as YAML.
+	GetRayServiceManifest(context.Context, *GetRayServiceManifestRequest) (*GetRayServiceManifestResponse, error)
 	// Finds all ray services in a given namespace. Supports pagination, and sorting on certain fields.
 	ListRayServices(context.Context, *ListRayServicesRequest) (*ListRayServicesResponse, error)
 	// Finds all ray services in a given namespace. Supports pagination, and sorting on certain fields.
 	ListAllRayServices(context.Context, *ListAllRayServicesRequest) (*ListAllRayServicesResponse, error)
 	// Deletes a ray service by its name and namespace
 	DeleteRayService(context.Context, *DeleteRayServiceRequest) (*emptypb.Empty, error)
+	// Watches the RayServices in a given namespace, or in all namespaces. The stream emits an event whenever a
+	// service is added, modified or deleted, and ends when the underlying Kubernetes watch times out.
+	// Resume it from the resource_version of the last received event.
+	WatchRayServices(*WatchRayServicesRequest, RayServeService_WatchRayServicesServer) error
 	mustEmbedUnimplementedRayServeServiceServer()
 }
 
@@ -129,6 +182,9 @@ func (UnimplementedRayServeServiceServer) UpdateRayService(context.Context, *Upd
 func (UnimplementedRayServeServiceServer) GetRayService(context.Context, *GetRayServiceRequest) (*RayService, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRayService not implemented")
 }
+func (UnimplementedRayServeServiceServer) GetRayServiceManifest(context.Context, *GetRayServiceManifestRequest) (*GetRayServiceManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRayServiceManifest not implemented")
+}
 func (UnimplementedRayServeServiceServer) ListRayServices(context.Context, *ListRayServicesRequest) (*ListRayServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRayServices not implemented")
 }
@@ -138,6 +194,9 @@ func (UnimplementedRayServeServiceServer) ListAllRayServices(context.Context, *L
 func (UnimplementedRayServeServiceServer) DeleteRayService(context.Context, *DeleteRayServiceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRayService not implemented")
 }
+func (UnimplementedRayServeServiceServer) WatchRayServices(*WatchRayServicesRequest, RayServeService_WatchRayServicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRayServices not implemented")
+}
 func (UnimplementedRayServeServiceServer) mustEmbedUnimplementedRayServeServiceServer() {}
 
 // UnsafeRayServeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -205,6 +264,24 @@ func _RayServeService_GetRayService_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RayServeService_GetRayServiceManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRayServiceManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RayServeServiceServer).GetRayServiceManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RayServeService/GetRayServiceManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RayServeServiceServer).GetRayServiceManifest(ctx, req.(*GetRayServiceManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RayServeService_ListRayServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRayServicesRequest)
 	if err := dec(in); err != nil {
@@ -259,6 +336,27 @@ func _RayServeService_DeleteRayService_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RayServeService_WatchRayServices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRayServicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RayServeServiceServer).WatchRayServices(m, &rayServeServiceWatchRayServicesServer{stream})
+}
+
+type RayServeService_WatchRayServicesServer interface {
+	Send(*WatchRayServicesResponse) error
+	grpc.ServerStream
+}
+
+type rayServeServiceWatchRayServicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *rayServeServiceWatchRayServicesServer) Send(m *WatchRayServicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RayServeService_ServiceDesc is the grpc.ServiceDesc for RayServeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -278,6 +376,10 @@ var RayServeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRayService",
 			Handler:    _RayServeService_GetRayService_Handler,
 		},
+		{
+			MethodName: "GetRayServiceManifest",
+			Handler:    _RayServeService_GetRayServiceManifest_Handler,
+		},
 		{
 			MethodName: "ListRayServices",
 			Handler:    _RayServeService_ListRayServices_Handler,
@@ -291,6 +393,12 @@ var RayServeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _RayServeService_DeleteRayService_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRayServices",
+			Handler:       _RayServeService_WatchRayServices_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "serve.proto",
 }
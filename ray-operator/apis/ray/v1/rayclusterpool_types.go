@@ -0,0 +1,60 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayClusterPoolSpec defines the desired state of RayClusterPool
+type RayClusterPoolSpec struct {
+	// Replicas is the number of idle RayClusters the pool keeps provisioned. When a RayJob
+	// claims one of them, the pool creates a new RayCluster to replace it.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+	// RayClusterSpec is the template of the RayClusters in the pool.
+	RayClusterSpec RayClusterSpec `json:"rayClusterSpec"`
+}
+
+// RayClusterPoolStatus defines the observed state of RayClusterPool
+type RayClusterPoolStatus struct {
+	// IdleReplicas is the number of RayClusters owned by the pool that have not been claimed yet.
+	IdleReplicas int32 `json:"idleReplicas,omitempty"`
+	// ReadyReplicas is the number of idle RayClusters whose state is `ready`.
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// LastUpdateTime indicates the last time the status was updated.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// observedGeneration is the most recent generation observed for this RayClusterPool. It corresponds to the
+	// RayClusterPool's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="desired replicas",type=integer,JSONPath=".spec.replicas",priority=0
+// +kubebuilder:printcolumn:name="idle replicas",type=integer,JSONPath=".status.idleReplicas",priority=0
+// +kubebuilder:printcolumn:name="ready replicas",type=integer,JSONPath=".status.readyReplicas",priority=0
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// +genclient
+// RayClusterPool is the Schema for the rayclusterpools API
+type RayClusterPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RayClusterPoolSpec   `json:"spec,omitempty"`
+	Status RayClusterPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RayClusterPoolList contains a list of RayClusterPool
+type RayClusterPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayClusterPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayClusterPool{}, &RayClusterPoolList{})
+}
@@ -36,6 +36,7 @@ type JobDeploymentStatus string
 const (
 	JobDeploymentStatusNew          JobDeploymentStatus = ""
 	JobDeploymentStatusInitializing JobDeploymentStatus = "Initializing"
+	JobDeploymentStatusQueued       JobDeploymentStatus = "Queued"
 	JobDeploymentStatusRunning      JobDeploymentStatus = "Running"
 	JobDeploymentStatusComplete     JobDeploymentStatus = "Complete"
 	JobDeploymentStatusFailed       JobDeploymentStatus = "Failed"
@@ -59,6 +60,66 @@ const (
 	HTTPMode   JobSubmissionMode = "HTTPMode"   // Submit job via HTTP request
 )
 
+// RuntimeEnvConda is the conda environment of a Ray job. Either `name` or `dependencies` can be set.
+type RuntimeEnvConda struct {
+	// Name is the name of an existing conda environment on the Ray nodes.
+	Name string `json:"name,omitempty"`
+	// Channels are the conda channels used to install the dependencies.
+	Channels []string `json:"channels,omitempty"`
+	// Dependencies are the conda and pip packages installed into a new conda environment.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// RuntimeEnvVarSource selects the source of the value of an environment variable.
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.secretKeyRef)",message="exactly one of configMapKeyRef and secretKeyRef must be set"
+type RuntimeEnvVarSource struct {
+	// Selects a key of a ConfigMap in the RayJob's namespace.
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// Selects a key of a Secret in the RayJob's namespace.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// RuntimeEnvVar is an environment variable set for the Ray job.
+// +kubebuilder:validation:XValidation:rule="!(has(self.value) && has(self.valueFrom))",message="value and valueFrom cannot be set together"
+type RuntimeEnvVar struct {
+	// Name of the environment variable.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Value of the environment variable.
+	Value string `json:"value,omitempty"`
+	// ValueFrom is the source of the value of the environment variable. It cannot be used if `value` is not empty.
+	// The value is resolved by KubeRay when the Ray job is submitted. In the K8sJobMode submission mode, values read
+	// from Secrets are passed to the submitter through environment variables rather than its command, and must not
+	// contain quotes, backslashes or control characters.
+	ValueFrom *RuntimeEnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// RuntimeEnvContainer runs the Ray job's workers in a container.
+type RuntimeEnvContainer struct {
+	// Image is the image of the container.
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// RunOptions are the options passed to the container runtime.
+	RunOptions []string `json:"runOptions,omitempty"`
+}
+
+// RuntimeEnv is the runtime environment of a Ray job. See https://docs.ray.io/en/latest/ray-core/api/runtime-env.html
+// for the semantics of each field.
+type RuntimeEnv struct {
+	// Pip is the list of pip packages to install.
+	Pip []string `json:"pip,omitempty"`
+	// Conda is the conda environment of the Ray job. It cannot be used together with `pip`.
+	Conda *RuntimeEnvConda `json:"conda,omitempty"`
+	// EnvVars are the environment variables set for the Ray job.
+	EnvVars []RuntimeEnvVar `json:"envVars,omitempty"`
+	// WorkingDir is the working directory of the Ray job, e.g. a remote URI of a zip file.
+	WorkingDir string `json:"workingDir,omitempty"`
+	// PyModules are the Python modules made available to the Ray job.
+	PyModules []string `json:"pyModules,omitempty"`
+	// Container runs the Ray job's workers in a container.
+	Container *RuntimeEnvContainer `json:"container,omitempty"`
+}
+
 // RayJobSpec defines the desired state of RayJob
 type RayJobSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -69,6 +130,8 @@ type RayJobSpec struct {
 	// RuntimeEnvYAML represents the runtime environment configuration
 	// provided as a multi-line YAML string.
 	RuntimeEnvYAML string `json:"runtimeEnvYAML,omitempty"`
+	// RuntimeEnv is the structured runtime environment configuration. It cannot be used together with RuntimeEnvYAML.
+	RuntimeEnv *RuntimeEnv `json:"runtimeEnv,omitempty"`
 	// If jobId is not set, a new jobId will be auto-generated.
 	JobId string `json:"jobId,omitempty"`
 	// ShutdownAfterJobFinishes will determine whether to delete the ray cluster once rayJob succeed or failed.
@@ -83,7 +146,20 @@ type RayJobSpec struct {
 	// RayClusterSpec is the cluster template to run the job
 	RayClusterSpec *RayClusterSpec `json:"rayClusterSpec,omitempty"`
 	// clusterSelector is used to select running rayclusters by labels
+	// If the selected RayCluster has the `ray.io/max-concurrent-jobs` annotation, the RayJob stays in the
+	// `Queued` status until the number of RayJobs running on the RayCluster is below the limit.
 	ClusterSelector map[string]string `json:"clusterSelector,omitempty"`
+	// RayClusterPoolName is the name of a RayClusterPool in the same namespace. If it is set, the RayJob
+	// claims an idle RayCluster from the pool instead of creating a new one, and the claimed RayCluster
+	// is deleted after the job finishes.
+	RayClusterPoolName string `json:"rayClusterPoolName,omitempty"`
+	// RayJobQueueName is the name of a RayJobQueue in the same namespace. If it is set, the RayJob is suspended
+	// when it is created, and the RayJobQueue admits it by setting `suspend` to false once the queue has capacity.
+	// A RayJob suspended by the user is not admitted again until the user sets `suspend` back to false.
+	RayJobQueueName string `json:"rayJobQueueName,omitempty"`
+	// QueuePriority is the priority of the RayJob in its RayJobQueue. RayJobs with a higher priority are admitted
+	// first, and RayJobs with the same priority are admitted in the order of their creation.
+	QueuePriority int32 `json:"queuePriority,omitempty"`
 	// SubmissionMode specifies how RayJob submits the Ray job to the RayCluster.
 	// In "K8sJobMode", the KubeRay operator creates a submitter Kubernetes Job to submit the Ray job.
 	// In "HTTPMode", the KubeRay operator sends a request to the RayCluster to create a Ray job.
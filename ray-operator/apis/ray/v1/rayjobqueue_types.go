@@ -0,0 +1,63 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayJobQueueSpec defines the desired state of RayJobQueue
+type RayJobQueueSpec struct {
+	// MaxRunningJobs is the maximum number of admitted RayJobs in the queue that have not finished yet.
+	// If it is not set, the number of running RayJobs is not limited.
+	// +kubebuilder:validation:Minimum=0
+	MaxRunningJobs *int32 `json:"maxRunningJobs,omitempty"`
+	// MaxResources is the maximum total resources requested by the RayClusters of the admitted RayJobs
+	// that have not finished yet. Resources that are not listed are not limited.
+	MaxResources corev1.ResourceList `json:"maxResources,omitempty"`
+}
+
+// RayJobQueueStatus defines the observed state of RayJobQueue
+type RayJobQueueStatus struct {
+	// RunningJobs is the number of admitted RayJobs in the queue that have not finished yet.
+	RunningJobs int32 `json:"runningJobs,omitempty"`
+	// PendingJobs is the number of RayJobs in the queue that are waiting to be admitted.
+	PendingJobs int32 `json:"pendingJobs,omitempty"`
+	// UsedResources is the total resources requested by the RayClusters of the running RayJobs.
+	UsedResources corev1.ResourceList `json:"usedResources,omitempty"`
+	// LastUpdateTime indicates the last time the status was updated.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// observedGeneration is the most recent generation observed for this RayJobQueue. It corresponds to the
+	// RayJobQueue's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="max running jobs",type=integer,JSONPath=".spec.maxRunningJobs",priority=0
+// +kubebuilder:printcolumn:name="running jobs",type=integer,JSONPath=".status.runningJobs",priority=0
+// +kubebuilder:printcolumn:name="pending jobs",type=integer,JSONPath=".status.pendingJobs",priority=0
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// +genclient
+// RayJobQueue is the Schema for the rayjobqueues API
+type RayJobQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RayJobQueueSpec   `json:"spec,omitempty"`
+	Status RayJobQueueStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RayJobQueueList contains a list of RayJobQueue
+type RayJobQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayJobQueue `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayJobQueue{}, &RayJobQueueList{})
+}
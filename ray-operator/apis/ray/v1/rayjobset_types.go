@@ -0,0 +1,102 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayJobSetState is the state of a RayJobSet
+type RayJobSetState string
+
+const (
+	RayJobSetRunning  RayJobSetState = "Running"
+	RayJobSetComplete RayJobSetState = "Complete"
+)
+
+// RayJobTemplateSpec describes the RayJobs that a RayJobSet creates
+type RayJobTemplateSpec struct {
+	// Labels and annotations of the RayJobs. Their values can reference parameters.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec of the RayJobs. The entrypoint, the metadata values, the runtimeEnvYAML, and the values of
+	// the environment variables in runtimeEnv can reference parameters.
+	Spec RayJobSpec `json:"spec"`
+}
+
+// RayJobSetParameters defines the parameter sets that a RayJobSet expands into RayJobs. Exactly one of
+// `list` and `matrix` must be set.
+type RayJobSetParameters struct {
+	// List is an explicit list of parameter sets. Each parameter set creates one RayJob.
+	List []map[string]string `json:"list,omitempty"`
+	// Matrix maps each parameter name to its values. Each combination in the cartesian product
+	// of the values creates one RayJob.
+	Matrix map[string][]string `json:"matrix,omitempty"`
+}
+
+// RayJobSetSpec defines the desired state of RayJobSet
+type RayJobSetSpec struct {
+	// Template is the template of the RayJobs. Parameters are referenced with the Go template
+	// syntax, e.g. `python train.py --lr={{ .lr }}`.
+	Template RayJobTemplateSpec `json:"template"`
+	// Parameters is the parameter matrix that is expanded into RayJobs.
+	Parameters RayJobSetParameters `json:"parameters"`
+	// Parallelism is the maximum number of RayJobs that run at the same time. If it is not set,
+	// all RayJobs are created at once.
+	// +kubebuilder:validation:Minimum=1
+	Parallelism *int32 `json:"parallelism,omitempty"`
+	// SharedCluster specifies whether all RayJobs run on one RayCluster created from
+	// `template.spec.rayClusterSpec`. The RayCluster is deleted after all RayJobs finish.
+	SharedCluster bool `json:"sharedCluster,omitempty"`
+}
+
+// RayJobSetStatus defines the observed state of RayJobSet
+type RayJobSetStatus struct {
+	State RayJobSetState `json:"state,omitempty"`
+	// Total is the number of RayJobs that the parameters expand into.
+	Total int32 `json:"total,omitempty"`
+	// Active is the number of RayJobs that have been created and have not finished yet.
+	Active int32 `json:"active,omitempty"`
+	// Succeeded is the number of RayJobs whose Ray job succeeded.
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// Failed is the number of RayJobs that finished without succeeding.
+	Failed int32 `json:"failed,omitempty"`
+	// SharedRayClusterName is the name of the RayCluster shared by the RayJobs if `sharedCluster` is true.
+	SharedRayClusterName string `json:"sharedRayClusterName,omitempty"`
+	// LastUpdateTime indicates the last time the status was updated.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// observedGeneration is the most recent generation observed for this RayJobSet. It corresponds to the
+	// RayJobSet's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="state",type=string,JSONPath=".status.state",priority=0
+// +kubebuilder:printcolumn:name="total",type=integer,JSONPath=".status.total",priority=0
+// +kubebuilder:printcolumn:name="active",type=integer,JSONPath=".status.active",priority=0
+// +kubebuilder:printcolumn:name="succeeded",type=integer,JSONPath=".status.succeeded",priority=0
+// +kubebuilder:printcolumn:name="failed",type=integer,JSONPath=".status.failed",priority=0
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// +genclient
+// RayJobSet is the Schema for the rayjobsets API
+type RayJobSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RayJobSetSpec   `json:"spec,omitempty"`
+	Status RayJobSetStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RayJobSetList contains a list of RayJobSet
+type RayJobSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayJobSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayJobSet{}, &RayJobSetList{})
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -18,6 +19,8 @@ const (
 	Restarting                       ServiceStatus = "Restarting"
 	FailedToUpdateServingPodLabel    ServiceStatus = "FailedToUpdateServingPodLabel"
 	FailedToUpdateService            ServiceStatus = "FailedToUpdateService"
+	FailedToResolveServeConfig       ServiceStatus = "FailedToResolveServeConfig"
+	ServiceSuspended                 ServiceStatus = "Suspended"
 )
 
 // These statuses should match Ray Serve's application statuses
@@ -49,18 +52,175 @@ var DeploymentStatusEnum = struct {
 	UNHEALTHY: "UNHEALTHY",
 }
 
+// TrafficShiftingStep is a step of the gradual traffic shifting from the active RayCluster to the pending RayCluster.
+type TrafficShiftingStep struct {
+	// Weight is the percentage of the traffic routed to the pending RayCluster during this step.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+	// SoakSeconds is how long this step lasts before moving on to the next step. The rollout fails if
+	// a Serve application on the pending RayCluster becomes UNHEALTHY during the soak.
+	// +kubebuilder:validation:Minimum=0
+	SoakSeconds int32 `json:"soakSeconds,omitempty"`
+}
+
+// GatewayReference identifies the Gateway API Gateway that the HTTPRoute of a RayService attaches to.
+type GatewayReference struct {
+	// Name of the Gateway.
+	Name string `json:"name"`
+	// Namespace of the Gateway. Defaults to the namespace of the RayService.
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName is the name of the listener of the Gateway.
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// TrafficShiftingSpec configures the gradual traffic shifting during zero-downtime upgrades. KubeRay creates a
+// Gateway API HTTPRoute for the RayService and shifts the traffic between the RayClusters by updating the weights
+// of its backends.
+type TrafficShiftingSpec struct {
+	// Gateway is the Gateway that the HTTPRoute attaches to.
+	Gateway GatewayReference `json:"gateway"`
+	// Hostnames are the hostnames of the HTTPRoute.
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Steps is the schedule of the traffic shifting, e.g. 10% -> 50% -> 100%. The pending RayCluster becomes the
+	// active RayCluster after the last step.
+	// +kubebuilder:validation:MinItems=1
+	Steps []TrafficShiftingStep `json:"steps"`
+}
+
+// RayServiceConditionType is the type of a RayService condition.
+type RayServiceConditionType string
+
+const (
+	// RolloutFailed is true if KubeRay gave up the last rollout of a new RayCluster and kept the active RayCluster.
+	RolloutFailed RayServiceConditionType = "RolloutFailed"
+	// AwaitingPromotion is true if the pending RayCluster is ready but waits for the approval annotation before it
+	// receives traffic.
+	AwaitingPromotion RayServiceConditionType = "AwaitingPromotion"
+	// ServeGRPCProxyReady is true if the Ray Serve gRPC proxy on the head Pod of the active RayCluster passes its health
+	// check. The pending RayCluster of an upgrade is not reflected. It is only set if the Serve config enables the gRPC
+	// proxies.
+	ServeGRPCProxyReady RayServiceConditionType = "ServeGRPCProxyReady"
+	// RayServiceSuspended is true if the RayClusters of the RayService are deleted because `spec.suspend` is true.
+	RayServiceSuspended RayServiceConditionType = "Suspended"
+)
+
+// Reasons of the RolloutFailed condition.
+const (
+	UpgradeTimeoutReason            = "UpgradeTimeout"
+	ServeApplicationUnhealthyReason = "ServeApplicationUnhealthy"
+)
+
+// Reasons of the ServeGRPCProxyReady condition.
+const (
+	GRPCProxyHealthCheckSucceededReason = "HealthCheckSucceeded"
+	GRPCProxyHealthCheckFailedReason    = "HealthCheckFailed"
+)
+
+// RayServiceUpgradeType is the way KubeRay applies changes of the RayClusterSpec to a RayService.
+type RayServiceUpgradeType string
+
+const (
+	// RayServiceUpgradeNewCluster prepares a new RayCluster and switches the traffic to it once it is ready. It needs
+	// the capacity of two RayClusters during the upgrade. It is the default.
+	RayServiceUpgradeNewCluster RayServiceUpgradeType = "NewCluster"
+	// RayServiceUpgradeInPlaceWhenPossible updates the active RayCluster instead of preparing a new one if only the
+	// worker groups change. The worker Pods of modified worker groups are recreated in batches of a quarter of each
+	// worker group, so the Serve replicas on the other worker Pods keep serving. Changes to other fields still prepare
+	// a new RayCluster.
+	RayServiceUpgradeInPlaceWhenPossible RayServiceUpgradeType = "InPlaceWhenPossible"
+	// RayServiceUpgradeNone doesn't apply changes of the RayClusterSpec to the active RayCluster. Changes of the Serve
+	// config are still applied.
+	RayServiceUpgradeNone RayServiceUpgradeType = "None"
+)
+
+// RayServiceUpgradeStrategy defines how KubeRay upgrades the RayCluster of a RayService.
+type RayServiceUpgradeStrategy struct {
+	// Type is the upgrade strategy. Defaults to NewCluster.
+	// +kubebuilder:validation:Enum=NewCluster;InPlaceWhenPossible;None
+	// +optional
+	Type *RayServiceUpgradeType `json:"type,omitempty"`
+	// ManualPromotion keeps the traffic on the active RayCluster after the new RayCluster is ready until the
+	// RayService is annotated with `ray.io/promote-pending-cluster: <name of the pending RayCluster>`.
+	// +optional
+	ManualPromotion bool `json:"manualPromotion,omitempty"`
+}
+
+// ServeConfigReference refers to a key of a ConfigMap in the RayService's namespace that contains the Serve config.
+type ServeConfigReference struct {
+	// Name of the ConfigMap.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Key of the Serve config in the ConfigMap.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// ServeApplicationService exposes a Serve application through its own Kubernetes Service and, optionally, Ingress.
+type ServeApplicationService struct {
+	// ApplicationName is the name of the Serve application in the Serve config.
+	// +kubebuilder:validation:MinLength=1
+	ApplicationName string `json:"applicationName"`
+	// Service is the template of the Kubernetes Service of the application. KubeRay sets its selector and its
+	// port named "serve", so only the metadata, the type and the other fields of the Service spec are used.
+	// +optional
+	Service *corev1.Service `json:"service,omitempty"`
+	// Ingress exposes the application through an Ingress whose path is the `route_prefix` of the application.
+	// +optional
+	Ingress *ServeApplicationIngress `json:"ingress,omitempty"`
+}
+
+// ServeApplicationIngress configures the Ingress of a Serve application.
+type ServeApplicationIngress struct {
+	// IngressClassName is the name of the IngressClass of the Ingress.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// Annotations are added to the Ingress, e.g. to configure the authentication of the ingress controller.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Host is the host of the Ingress rule. The rule matches all hosts if it is not set.
+	// +optional
+	Host string `json:"host,omitempty"`
+	// TLS is the TLS configuration of the Ingress.
+	// +optional
+	TLS []networkingv1.IngressTLS `json:"tls,omitempty"`
+}
+
 // RayServiceSpec defines the desired state of RayService
 type RayServiceSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	// Defines the applications and deployments to deploy, should be a YAML multi-line scalar string.
-	ServeConfigV2  string         `json:"serveConfigV2,omitempty"`
-	RayClusterSpec RayClusterSpec `json:"rayClusterConfig,omitempty"`
+	ServeConfigV2 string `json:"serveConfigV2,omitempty"`
+	// ServeConfigRef refers to a ConfigMap key that contains the Serve config. Changes to the ConfigMap are applied
+	// in the same way as changes to ServeConfigV2. It is mutually exclusive with ServeConfigV2.
+	ServeConfigRef *ServeConfigReference `json:"serveConfigRef,omitempty"`
+	RayClusterSpec RayClusterSpec        `json:"rayClusterConfig,omitempty"`
 	// Deprecated: This field is not used anymore. ref: https://github.com/ray-project/kuberay/issues/1685
 	ServiceUnhealthySecondThreshold *int32 `json:"serviceUnhealthySecondThreshold,omitempty"`
 	// Deprecated: This field is not used anymore. ref: https://github.com/ray-project/kuberay/issues/1685
 	DeploymentUnhealthySecondThreshold *int32 `json:"deploymentUnhealthySecondThreshold,omitempty"`
 	// ServeService is the Kubernetes service for head node and worker nodes who have healthy http proxy to serve traffics.
 	ServeService *corev1.Service `json:"serveService,omitempty"`
+	// ApplicationServices creates a Kubernetes Service, and optionally an Ingress, for each listed Serve application
+	// in addition to the serve service, so that the applications can be exposed separately.
+	// +listType=map
+	// +listMapKey=applicationName
+	// +optional
+	ApplicationServices []ServeApplicationService `json:"applicationServices,omitempty"`
+	// TrafficShifting shifts the traffic to the pending RayCluster gradually instead of all at once during
+	// zero-downtime upgrades. It requires the Gateway API CRDs.
+	TrafficShifting *TrafficShiftingSpec `json:"trafficShifting,omitempty"`
+	// UpgradeTimeoutSeconds is the maximum time for a pending RayCluster to become ready during an upgrade. After the
+	// timeout, KubeRay deletes the pending RayCluster, keeps serving with the active RayCluster, and doesn't retry the
+	// upgrade until the RayClusterSpec or the Serve config changes. The timeout is disabled if it is not set.
+	// +kubebuilder:validation:Minimum=1
+	UpgradeTimeoutSeconds *int32 `json:"upgradeTimeoutSeconds,omitempty"`
+	// UpgradeStrategy defines how KubeRay applies changes of the RayClusterSpec.
+	UpgradeStrategy *RayServiceUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	// Suspend deletes the RayClusters of the RayService if it is true. The Kubernetes Services of the RayService are
+	// kept but have no endpoints. When Suspend is set back to false, a new RayCluster is created and it serves the
+	// traffic once the Serve applications are ready.
+	Suspend bool `json:"suspend,omitempty"`
 }
 
 // RayServiceStatuses defines the observed state of RayService
@@ -79,6 +239,69 @@ type RayServiceStatuses struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// LastUpdateTime represents the timestamp when the RayService status was last updated.
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// ServeConfigHash is the hash of the Serve config resolved from ServeConfigV2 or ServeConfigRef.
+	ServeConfigHash string `json:"serveConfigHash,omitempty"`
+	// TrafficShifting is the progress of the gradual traffic shifting to the pending RayCluster.
+	TrafficShifting *TrafficShiftingStatus `json:"trafficShifting,omitempty"`
+	// RolloutFailedHash is the hash of the RayClusterSpec and the Serve config whose rollout failed. KubeRay doesn't
+	// prepare a new RayCluster for them again until one of them changes.
+	RolloutFailedHash string `json:"rolloutFailedHash,omitempty"`
+	// Conditions represent the latest available observations of the RayService's state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// RolloutHistory records the recent rollouts of the RayService, from the oldest to the newest. It is bounded,
+	// so the oldest records are dropped as new rollouts are recorded.
+	RolloutHistory []RayServiceRolloutRecord `json:"rolloutHistory,omitempty"`
+}
+
+// RolloutOutcome is the outcome of a rollout recorded in the rollout history of a RayService.
+type RolloutOutcome string
+
+const (
+	// RolloutOutcomeActive means that the RayCluster of the rollout is serving the traffic.
+	RolloutOutcomeActive RolloutOutcome = "Active"
+	// RolloutOutcomeSuperseded means that the RayCluster of the rollout served the traffic until a newer rollout replaced it.
+	RolloutOutcomeSuperseded RolloutOutcome = "Superseded"
+	// RolloutOutcomeRolledBack means that the RayCluster of the rollout served the traffic until it was replaced by a
+	// rollout of a previously recorded RayClusterSpec and Serve config.
+	RolloutOutcomeRolledBack RolloutOutcome = "RolledBack"
+	// RolloutOutcomeFailed means that the RayCluster of the rollout never served the traffic because the rollout failed.
+	RolloutOutcomeFailed RolloutOutcome = "Failed"
+)
+
+// RayServiceRolloutRecord is a rollout recorded in the rollout history of a RayService.
+type RayServiceRolloutRecord struct {
+	// RayClusterName is the name of the RayCluster of the rollout.
+	RayClusterName string `json:"rayClusterName"`
+	// RayClusterSpecHash is the hash of the RayClusterSpec of the rollout, ignoring the replicas of the worker groups.
+	RayClusterSpecHash string `json:"rayClusterSpecHash,omitempty"`
+	// ServeConfigHash is the hash of the Serve config of the rollout.
+	ServeConfigHash string `json:"serveConfigHash,omitempty"`
+	// Revision is the name of the ControllerRevision that stores the RayClusterSpec and the Serve config of the
+	// rollout. It is used to roll back to the rollout. It is empty for failed rollouts and for the rollouts whose
+	// RayService spec changed before the RayCluster was promoted.
+	Revision string `json:"revision,omitempty"`
+	// ActiveStartTime is the time at which the RayCluster of the rollout started serving the traffic.
+	ActiveStartTime *metav1.Time `json:"activeStartTime,omitempty"`
+	// ActiveEndTime is the time at which the RayCluster of the rollout stopped serving the traffic, or at which the
+	// rollout failed.
+	ActiveEndTime *metav1.Time `json:"activeEndTime,omitempty"`
+	// Outcome is the outcome of the rollout.
+	Outcome RolloutOutcome `json:"outcome"`
+}
+
+// TrafficShiftingStatus is the progress of the gradual traffic shifting to the pending RayCluster.
+type TrafficShiftingStatus struct {
+	// Step is the index of the current step in `spec.trafficShifting.steps`.
+	Step int32 `json:"step,omitempty"`
+	// PendingClusterWeight is the percentage of the traffic routed to the pending RayCluster.
+	PendingClusterWeight int32 `json:"pendingClusterWeight,omitempty"`
+	// StepStartTime is the time at which the current step started. It is not set if the traffic shifting hasn't started.
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+	// AbortedRayClusterHash is the hash of the RayClusterSpec whose traffic shifting was aborted because a Serve application
+	// became UNHEALTHY. The failed rollout is also recorded in RolloutFailedHash and the RolloutFailed condition.
+	AbortedRayClusterHash string `json:"abortedRayClusterHash,omitempty"`
 }
 
 type RayServiceStatus struct {
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"fmt"
+	"unicode"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var rayservicelog = logf.Log.WithName("rayservice-resource")
+
+func (r *RayService) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-ray-io-v1-rayservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=ray.io,resources=rayservices,verbs=create;update,versions=v1,name=vrayservice.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RayService{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *RayService) ValidateCreate() (admission.Warnings, error) {
+	rayservicelog.Info("validate create", "name", r.Name)
+	return r.warnings(), r.validateRayService()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *RayService) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	rayservicelog.Info("validate update", "name", r.Name)
+	return r.warnings(), r.validateRayService()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *RayService) ValidateDelete() (admission.Warnings, error) {
+	rayservicelog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+// warnings returns the admission warnings of the RayService. They report the fields of the Serve config that KubeRay
+// doesn't know, which are likely typos but may also be fields added by a newer Ray release.
+func (r *RayService) warnings() admission.Warnings {
+	if r.Spec.ServeConfigV2 == "" {
+		return nil
+	}
+	return ServeConfigV2Warnings(r.Spec.ServeConfigV2, field.NewPath("spec").Child("serveConfigV2"))
+}
+
+func (r *RayService) validateRayService() error {
+	var allErrs field.ErrorList
+
+	if r.Spec.ServeConfigV2 != "" && r.Spec.ServeConfigRef != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("serveConfigRef"), r.Spec.ServeConfigRef, "serveConfigV2 and serveConfigRef are mutually exclusive"))
+	}
+
+	// The Serve config referenced by serveConfigRef is validated by the RayService controller when it reads the ConfigMap.
+	if r.Spec.ServeConfigV2 != "" {
+		allErrs = append(allErrs, ValidateServeConfigV2(r.Spec.ServeConfigV2, field.NewPath("spec").Child("serveConfigV2"))...)
+		allErrs = append(allErrs, validateApplicationServices(r.Name, r.Spec.ApplicationServices, r.Spec.ServeConfigV2, field.NewPath("spec").Child("applicationServices"))...)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: "ray.io", Kind: "RayService"},
+		r.Name, allErrs)
+}
+
+// validateApplicationServices checks that every application in `spec.applicationServices` is in the Serve config, and
+// that the names of their Kubernetes Services and Ingresses don't collide with each other nor with the names of the
+// serve and head Services of the RayService. The name of a Service is the one of its template if it is set, and
+// otherwise the name that KubeRay generates from the sanitized application name.
+func validateApplicationServices(rayServiceName string, appServices []ServeApplicationService, serveConfigV2 string, fldPath *field.Path) field.ErrorList {
+	if len(appServices) == 0 {
+		return nil
+	}
+	serveConfig, err := ParseServeConfigV2(serveConfigV2)
+	if err != nil {
+		// The error is already reported by ValidateServeConfigV2.
+		return nil
+	}
+	appNames := make(map[string]bool, len(serveConfig.Applications))
+	for _, app := range serveConfig.Applications {
+		appNames[app.GetName()] = true
+	}
+
+	var allErrs field.ErrorList
+	// The owners of the Service and Ingress names, described as in the error messages.
+	serviceNames := map[string]string{
+		checkName(rayServiceName + "-serve-svc"): "the serve Service of the RayService",
+		checkName(rayServiceName + "-head-svc"):  "the head Service of the RayService",
+	}
+	ingressNames := make(map[string]string, len(appServices))
+	for i, appService := range appServices {
+		if !appNames[appService.ApplicationName] {
+			allErrs = append(allErrs, field.NotFound(fldPath.Index(i).Child("applicationName"), appService.ApplicationName))
+		}
+		sanitizedAppName := SanitizeServeApplicationName(appService.ApplicationName)
+		owner := "the Kubernetes Service of application " + appService.ApplicationName
+
+		// A generated name is reported on the application name that it is derived from.
+		serviceNamePath := fldPath.Index(i).Child("applicationName")
+		serviceNameValue := appService.ApplicationName
+		serviceName := checkName(fmt.Sprintf("%s-%s-serve-svc", rayServiceName, sanitizedAppName))
+		if appService.Service != nil && appService.Service.Name != "" {
+			serviceNamePath = fldPath.Index(i).Child("service", "metadata", "name")
+			serviceName = appService.Service.Name
+			serviceNameValue = serviceName
+		}
+		if otherOwner, ok := serviceNames[serviceName]; ok {
+			allErrs = append(allErrs, field.Invalid(serviceNamePath, serviceNameValue,
+				fmt.Sprintf("the Kubernetes Service of the application would have the same name %s as %s", serviceName, otherOwner)))
+		} else {
+			serviceNames[serviceName] = owner
+		}
+
+		if appService.Ingress == nil {
+			continue
+		}
+		ingressName := checkName(fmt.Sprintf("%s-%s-serve-ingress", rayServiceName, sanitizedAppName))
+		if otherAppName, ok := ingressNames[ingressName]; ok {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("applicationName"), appService.ApplicationName,
+				fmt.Sprintf("the Ingress of the application would have the same name %s as the one of application %s", ingressName, otherAppName)))
+		} else {
+			ingressNames[ingressName] = appService.ApplicationName
+		}
+	}
+	return allErrs
+}
+
+// checkName shortens and fixes the names that KubeRay generates for the Kubernetes objects of a RayService like
+// utils.CheckName does, so that the webhook validates the same names as the ones the RayService controller creates.
+func checkName(s string) string {
+	maxLength := 50
+	if len(s) > maxLength {
+		s = s[len(s)-maxLength:]
+	}
+	// cannot start with a numeric value or a punctuation
+	if unicode.IsDigit(rune(s[0])) || unicode.IsPunct(rune(s[0])) {
+		s = "r" + s[1:]
+	}
+	return s
+}
@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	sigsjson "sigs.k8s.io/json"
+	"sigs.k8s.io/yaml"
+)
+
+// The types below describe the Ray Serve multi-application config in `ServeConfigV2`. See
+// https://docs.ray.io/en/latest/serve/api/doc/ray.serve.schema.ServeDeploySchema.html for the schema.
+// They are not part of the CRDs.
+
+// DefaultServeApplicationName is the name of a Serve application whose name is not set in the Serve config.
+const DefaultServeApplicationName = "default"
+
+// ServeDeployConfig is the Ray Serve multi-application config.
+// +kubebuilder:object:generate=false
+type ServeDeployConfig struct {
+	ProxyLocation  *string                  `json:"proxy_location,omitempty"`
+	HTTPOptions    *ServeHTTPOptions        `json:"http_options,omitempty"`
+	GRPCOptions    *ServeGRPCOptions        `json:"grpc_options,omitempty"`
+	LoggingConfig  map[string]interface{}   `json:"logging_config,omitempty"`
+	Applications   []ServeApplicationConfig `json:"applications"`
+	TargetCapacity *float64                 `json:"target_capacity,omitempty"`
+}
+
+// ServeHTTPOptions configures the HTTP proxies of Ray Serve.
+// +kubebuilder:object:generate=false
+type ServeHTTPOptions struct {
+	Host              *string  `json:"host,omitempty"`
+	Port              *int32   `json:"port,omitempty"`
+	RootPath          *string  `json:"root_path,omitempty"`
+	RequestTimeoutS   *float64 `json:"request_timeout_s,omitempty"`
+	KeepAliveTimeoutS *float64 `json:"keep_alive_timeout_s,omitempty"`
+}
+
+// ServeGRPCOptions configures the gRPC proxies of Ray Serve.
+// +kubebuilder:object:generate=false
+type ServeGRPCOptions struct {
+	Port                  *int32   `json:"port,omitempty"`
+	GRPCServicerFunctions []string `json:"grpc_servicer_functions,omitempty"`
+}
+
+// ServeApplicationConfig is the config of a Serve application.
+// +kubebuilder:object:generate=false
+type ServeApplicationConfig struct {
+	Name          string                  `json:"name,omitempty"`
+	RoutePrefix   *string                 `json:"route_prefix,omitempty"`
+	ImportPath    string                  `json:"import_path"`
+	RuntimeEnv    map[string]interface{}  `json:"runtime_env,omitempty"`
+	Args          map[string]interface{}  `json:"args,omitempty"`
+	Deployments   []ServeDeploymentConfig `json:"deployments,omitempty"`
+	LoggingConfig map[string]interface{}  `json:"logging_config,omitempty"`
+	// Deprecated: Use http_options instead.
+	Host *string `json:"host,omitempty"`
+	// Deprecated: Use http_options instead.
+	Port *int32 `json:"port,omitempty"`
+}
+
+// ServeDeploymentConfig overrides the options of a deployment in a Serve application.
+// +kubebuilder:object:generate=false
+type ServeDeploymentConfig struct {
+	Name string `json:"name"`
+	// NumReplicas is either an integer or "auto".
+	NumReplicas               *intstr.IntOrString     `json:"num_replicas,omitempty"`
+	MaxConcurrentQueries      *int32                  `json:"max_concurrent_queries,omitempty"`
+	MaxOngoingRequests        *int32                  `json:"max_ongoing_requests,omitempty"`
+	MaxQueuedRequests         *int32                  `json:"max_queued_requests,omitempty"`
+	UserConfig                interface{}             `json:"user_config,omitempty"`
+	AutoscalingConfig         *ServeAutoscalingConfig `json:"autoscaling_config,omitempty"`
+	GracefulShutdownWaitLoopS *float64                `json:"graceful_shutdown_wait_loop_s,omitempty"`
+	GracefulShutdownTimeoutS  *float64                `json:"graceful_shutdown_timeout_s,omitempty"`
+	HealthCheckPeriodS        *float64                `json:"health_check_period_s,omitempty"`
+	HealthCheckTimeoutS       *float64                `json:"health_check_timeout_s,omitempty"`
+	RayActorOptions           *ServeRayActorOptions   `json:"ray_actor_options,omitempty"`
+	PlacementGroupBundles     []map[string]float64    `json:"placement_group_bundles,omitempty"`
+	PlacementGroupStrategy    *string                 `json:"placement_group_strategy,omitempty"`
+	MaxReplicasPerNode        *int32                  `json:"max_replicas_per_node,omitempty"`
+	LoggingConfig             map[string]interface{}  `json:"logging_config,omitempty"`
+	// Deprecated: Set the route prefix of the application instead.
+	RoutePrefix *string `json:"route_prefix,omitempty"`
+}
+
+// ServeAutoscalingConfig configures the autoscaling of a deployment.
+// +kubebuilder:object:generate=false
+type ServeAutoscalingConfig struct {
+	MinReplicas                        *int32   `json:"min_replicas,omitempty"`
+	InitialReplicas                    *int32   `json:"initial_replicas,omitempty"`
+	MaxReplicas                        *int32   `json:"max_replicas,omitempty"`
+	TargetNumOngoingRequestsPerReplica *float64 `json:"target_num_ongoing_requests_per_replica,omitempty"`
+	TargetOngoingRequests              *float64 `json:"target_ongoing_requests,omitempty"`
+	MetricsIntervalS                   *float64 `json:"metrics_interval_s,omitempty"`
+	LookBackPeriodS                    *float64 `json:"look_back_period_s,omitempty"`
+	SmoothingFactor                    *float64 `json:"smoothing_factor,omitempty"`
+	UpscaleSmoothingFactor             *float64 `json:"upscale_smoothing_factor,omitempty"`
+	DownscaleSmoothingFactor           *float64 `json:"downscale_smoothing_factor,omitempty"`
+	UpscalingFactor                    *float64 `json:"upscaling_factor,omitempty"`
+	DownscalingFactor                  *float64 `json:"downscaling_factor,omitempty"`
+	DownscaleDelayS                    *float64 `json:"downscale_delay_s,omitempty"`
+	UpscaleDelayS                      *float64 `json:"upscale_delay_s,omitempty"`
+}
+
+// ServeRayActorOptions are the Ray actor options of the replicas of a deployment.
+// +kubebuilder:object:generate=false
+type ServeRayActorOptions struct {
+	RuntimeEnv        map[string]interface{} `json:"runtime_env,omitempty"`
+	NumCPUs           *float64               `json:"num_cpus,omitempty"`
+	NumGPUs           *float64               `json:"num_gpus,omitempty"`
+	Memory            *float64               `json:"memory,omitempty"`
+	ObjectStoreMemory *float64               `json:"object_store_memory,omitempty"`
+	Resources         map[string]float64     `json:"resources,omitempty"`
+	AcceleratorType   *string                `json:"accelerator_type,omitempty"`
+}
+
+// GetName returns the name of the Serve application. Ray Serve uses "default" if the name is not set.
+func (app ServeApplicationConfig) GetName() string {
+	if app.Name == "" {
+		return DefaultServeApplicationName
+	}
+	return app.Name
+}
+
+// SanitizeServeApplicationName converts a Serve application name, which can be any string, to a valid part of a DNS
+// label. It is used in the names of the Kubernetes Service and Ingress of the application, so different application
+// names may be converted to the same name, e.g. "app_a" and "app-a".
+func SanitizeServeApplicationName(appName string) string {
+	return strings.Trim(strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		if r >= 'A' && r <= 'Z' {
+			return unicode.ToLower(r)
+		}
+		return '-'
+	}, appName), "-")
+}
+
+// ParseServeConfigV2 parses the Serve config. Fields that the types above don't describe are ignored, because Ray Serve
+// accepts more fields than KubeRay reads and adds new ones across releases. See ServeConfigV2Warnings.
+func ParseServeConfigV2(serveConfigV2 string) (*ServeDeployConfig, error) {
+	serveConfig, _, err := parseServeConfigV2(serveConfigV2)
+	return serveConfig, err
+}
+
+// ServeConfigV2Warnings returns a warning for each field of the Serve config that the types above don't describe, such
+// as a typo in a field name. The fields are passed to Ray Serve unchanged. An invalid Serve config returns no warnings
+// because ValidateServeConfigV2 reports it.
+func ServeConfigV2Warnings(serveConfigV2 string, fldPath *field.Path) []string {
+	_, unknownFields, err := parseServeConfigV2(serveConfigV2)
+	if err != nil {
+		return nil
+	}
+	var warnings []string
+	for _, unknownField := range unknownFields {
+		warnings = append(warnings, fmt.Sprintf("%s: %v, which is passed to Ray Serve without being validated by KubeRay", fldPath, unknownField))
+	}
+	return warnings
+}
+
+// parseServeConfigV2 parses the Serve config case-sensitively, as Ray Serve does, and returns the unknown fields
+// separately from the parsing error.
+func parseServeConfigV2(serveConfigV2 string) (*ServeDeployConfig, []error, error) {
+	serveConfigJSON, err := yaml.YAMLToJSON([]byte(serveConfigV2))
+	if err != nil {
+		return nil, nil, err
+	}
+	serveConfig := &ServeDeployConfig{}
+	unknownFields, err := sigsjson.UnmarshalStrict(serveConfigJSON, serveConfig, sigsjson.DisallowUnknownFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return serveConfig, unknownFields, nil
+}
+
+// ValidateServeConfigV2 parses and validates the Serve config.
+func ValidateServeConfigV2(serveConfigV2 string, fldPath *field.Path) field.ErrorList {
+	serveConfig, err := ParseServeConfigV2(serveConfigV2)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, serveConfigV2, err.Error())}
+	}
+
+	var allErrs field.ErrorList
+	appNames := make(map[string]bool)
+	routePrefixes := make(map[string]string)
+	for i, app := range serveConfig.Applications {
+		appPath := fldPath.Child("applications").Index(i)
+		appName := app.GetName()
+		if appNames[appName] {
+			allErrs = append(allErrs, field.Duplicate(appPath.Child("name"), appName))
+		}
+		appNames[appName] = true
+
+		if app.ImportPath == "" {
+			allErrs = append(allErrs, field.Required(appPath.Child("import_path"), "import_path is required"))
+		}
+
+		if app.RoutePrefix != nil {
+			if !strings.HasPrefix(*app.RoutePrefix, "/") {
+				allErrs = append(allErrs, field.Invalid(appPath.Child("route_prefix"), *app.RoutePrefix, "route_prefix must start with '/'"))
+			} else if otherApp, ok := routePrefixes[*app.RoutePrefix]; ok {
+				allErrs = append(allErrs, field.Invalid(appPath.Child("route_prefix"), *app.RoutePrefix, "route_prefix is already used by application "+otherApp))
+			} else {
+				routePrefixes[*app.RoutePrefix] = appName
+			}
+		}
+
+		deploymentNames := make(map[string]bool)
+		for j, deployment := range app.Deployments {
+			deploymentPath := appPath.Child("deployments").Index(j)
+			if deployment.Name == "" {
+				allErrs = append(allErrs, field.Required(deploymentPath.Child("name"), "deployment name is required"))
+			} else if deploymentNames[deployment.Name] {
+				allErrs = append(allErrs, field.Duplicate(deploymentPath.Child("name"), deployment.Name))
+			}
+			deploymentNames[deployment.Name] = true
+
+			if deployment.NumReplicas != nil && deployment.NumReplicas.Type == intstr.String && deployment.NumReplicas.StrVal != "auto" {
+				allErrs = append(allErrs, field.Invalid(deploymentPath.Child("num_replicas"), deployment.NumReplicas.StrVal, `num_replicas must be an integer or "auto"`))
+			}
+
+			if autoscalingConfig := deployment.AutoscalingConfig; autoscalingConfig != nil {
+				if autoscalingConfig.MinReplicas != nil && autoscalingConfig.MaxReplicas != nil && *autoscalingConfig.MinReplicas > *autoscalingConfig.MaxReplicas {
+					allErrs = append(allErrs, field.Invalid(deploymentPath.Child("autoscaling_config", "min_replicas"), *autoscalingConfig.MinReplicas, "min_replicas must not be greater than max_replicas"))
+				}
+			}
+		}
+	}
+	return allErrs
+}
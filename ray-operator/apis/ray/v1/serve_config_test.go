@@ -0,0 +1,260 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestParseServeConfigV2(t *testing.T) {
+	serveConfig, err := ParseServeConfigV2(`
+proxy_location: EveryNode
+http_options:
+  port: 8000
+grpc_options:
+  port: 9000
+  grpc_servicer_functions:
+    - user_defined_protos_pb2_grpc.add_UserDefinedServiceServicer_to_server
+applications:
+  - name: fruit_app
+    import_path: fruit.deployment_graph
+    route_prefix: /fruit
+    runtime_env:
+      working_dir: "https://github.com/ray-project/test_dag/archive/78b4a5da38796123d9f9ffff59bab2792a043e95.zip"
+    deployments:
+      - name: MangoStand
+        num_replicas: 2
+        max_replicas_per_node: 1
+        user_config:
+          price: 3
+        ray_actor_options:
+          num_cpus: 0.1
+      - name: FruitMarket
+        num_replicas: auto
+        autoscaling_config:
+          min_replicas: 1
+          max_replicas: 5
+`)
+	require.NoError(t, err)
+	require.Equal(t, int32(9000), *serveConfig.GRPCOptions.Port)
+	require.Len(t, serveConfig.Applications, 1)
+	app := serveConfig.Applications[0]
+	require.Equal(t, "fruit_app", app.GetName())
+	require.Equal(t, "/fruit", *app.RoutePrefix)
+	require.Equal(t, intstr.FromInt(2), *app.Deployments[0].NumReplicas)
+	require.Equal(t, 0.1, *app.Deployments[0].RayActorOptions.NumCPUs)
+	require.Equal(t, intstr.FromString("auto"), *app.Deployments[1].NumReplicas)
+	require.Equal(t, int32(5), *app.Deployments[1].AutoscalingConfig.MaxReplicas)
+
+	// Fields that KubeRay doesn't read, such as the fields added by newer Ray releases, are accepted.
+	serveConfig, err = ParseServeConfigV2(`
+applications:
+  - import_path: fruit.deployment_graph
+    external_scaler_enabled: true
+    deployments:
+      - name: MangoStand
+        num_replica: 2
+        request_router_config:
+          request_router_class: custom.Router
+`)
+	require.NoError(t, err)
+	require.Equal(t, "MangoStand", serveConfig.Applications[0].Deployments[0].Name)
+	require.Nil(t, serveConfig.Applications[0].Deployments[0].NumReplicas)
+}
+
+func TestServeConfigV2Warnings(t *testing.T) {
+	fldPath := field.NewPath("spec").Child("serveConfigV2")
+	require.Empty(t, ServeConfigV2Warnings(`
+logging_config:
+  encoding: JSON
+applications:
+  - import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        max_ongoing_requests: 10
+`, fldPath))
+
+	warnings := ServeConfigV2Warnings(`
+applications:
+  - import_path: fruit.deployment_graph
+    Route_Prefix: /fruit
+    deployments:
+      - name: MangoStand
+        num_replica: 2
+`, fldPath)
+	require.Len(t, warnings, 2)
+	require.Contains(t, warnings[0], `spec.serveConfigV2: unknown field "applications[0].Route_Prefix"`)
+	require.Contains(t, warnings[1], `unknown field "applications[0].deployments[0].num_replica"`)
+
+	// Invalid Serve configs are reported by ValidateServeConfigV2 instead.
+	require.Empty(t, ServeConfigV2Warnings("applications: [", fldPath))
+}
+
+func TestValidateServeConfigV2(t *testing.T) {
+	tests := map[string]struct {
+		serveConfigV2 string
+		expectedError string
+	}{
+		"Valid Serve config": {
+			serveConfigV2: `
+applications:
+  - import_path: fruit.deployment_graph
+    route_prefix: /fruit
+  - name: math_app
+    import_path: conditional_dag.serve_dag
+    route_prefix: /calc`,
+		},
+		"Invalid YAML": {
+			serveConfigV2: "applications: [",
+			expectedError: "spec.serveConfigV2",
+		},
+		"Duplicate application names": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+  - name: app
+    import_path: conditional_dag.serve_dag`,
+			expectedError: "spec.serveConfigV2.applications[1].name: Duplicate value",
+		},
+		"Missing import path": {
+			serveConfigV2: `
+applications:
+  - name: app`,
+			expectedError: "spec.serveConfigV2.applications[0].import_path: Required value",
+		},
+		"Route prefix doesn't start with a slash": {
+			serveConfigV2: `
+applications:
+  - import_path: fruit.deployment_graph
+    route_prefix: fruit`,
+			expectedError: "route_prefix must start with '/'",
+		},
+		"Duplicate route prefixes": {
+			serveConfigV2: `
+applications:
+  - name: app1
+    import_path: fruit.deployment_graph
+    route_prefix: /fruit
+  - name: app2
+    import_path: conditional_dag.serve_dag
+    route_prefix: /fruit`,
+			expectedError: "route_prefix is already used by application app1",
+		},
+		"Duplicate deployment names": {
+			serveConfigV2: `
+applications:
+  - import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+      - name: MangoStand`,
+			expectedError: "spec.serveConfigV2.applications[0].deployments[1].name: Duplicate value",
+		},
+		"Invalid num_replicas": {
+			serveConfigV2: `
+applications:
+  - import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        num_replicas: many`,
+			expectedError: `num_replicas must be an integer or "auto"`,
+		},
+		"min_replicas is greater than max_replicas": {
+			serveConfigV2: `
+applications:
+  - import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        autoscaling_config:
+          min_replicas: 3
+          max_replicas: 1`,
+			expectedError: "min_replicas must not be greater than max_replicas",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := ValidateServeConfigV2(tc.serveConfigV2, field.NewPath("spec").Child("serveConfigV2"))
+			if tc.expectedError == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.ErrorContains(t, errs.ToAggregate(), tc.expectedError)
+		})
+	}
+}
+
+func TestValidateRayService(t *testing.T) {
+	rayService := &RayService{
+		Spec: RayServiceSpec{
+			ServeConfigV2:  "applications: []",
+			ServeConfigRef: &ServeConfigReference{Name: "serve-config", Key: "serveConfigV2"},
+		},
+	}
+	require.ErrorContains(t, rayService.validateRayService(), "serveConfigV2 and serveConfigRef are mutually exclusive")
+
+	rayService.Spec.ServeConfigRef = nil
+	require.NoError(t, rayService.validateRayService())
+
+	rayService.Spec.ServeConfigV2 = `applications:
+  - name: fruit_app
+    import_path: fruit.deployment_graph
+    route_prefix: /fruit`
+	rayService.Spec.ApplicationServices = []ServeApplicationService{{ApplicationName: "fruit_app"}}
+	require.NoError(t, rayService.validateRayService())
+
+	rayService.Spec.ApplicationServices = append(rayService.Spec.ApplicationServices, ServeApplicationService{ApplicationName: "math_app"})
+	require.ErrorContains(t, rayService.validateRayService(), `spec.applicationServices[1].applicationName: Not found: "math_app"`)
+
+	// Application names that are converted to the same Service name are rejected.
+	rayService.Spec.ServeConfigV2 = `applications:
+  - name: app_a
+    import_path: fruit.deployment_graph
+    route_prefix: /a
+  - name: app-a
+    import_path: conditional_dag.serve_dag
+    route_prefix: /b`
+	rayService.Spec.ApplicationServices = []ServeApplicationService{{ApplicationName: "app_a"}, {ApplicationName: "app-a"}}
+	require.ErrorContains(t, rayService.validateRayService(), "spec.applicationServices[1].applicationName: Invalid value: \"app-a\"")
+	rayService.Spec.ApplicationServices = rayService.Spec.ApplicationServices[:1]
+	require.NoError(t, rayService.validateRayService())
+
+	// The names of the Service templates are checked against the other Service names.
+	rayService.Name = "rayservice-sample"
+	rayService.Spec.ApplicationServices = []ServeApplicationService{
+		{ApplicationName: "app_a"},
+		{ApplicationName: "app-a", Service: &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "app-a-svc"}}},
+	}
+	require.NoError(t, rayService.validateRayService())
+	rayService.Spec.ApplicationServices[1].Service.Name = "rayservice-sample-app-a-serve-svc"
+	require.ErrorContains(t, rayService.validateRayService(), `spec.applicationServices[1].service.metadata.name: Invalid value: "rayservice-sample-app-a-serve-svc"`)
+	rayService.Spec.ApplicationServices[1].Service.Name = "rayservice-sample-serve-svc"
+	require.ErrorContains(t, rayService.validateRayService(), "the serve Service of the RayService")
+	rayService.Spec.ApplicationServices[1].Service.Name = "rayservice-sample-head-svc"
+	require.ErrorContains(t, rayService.validateRayService(), "the head Service of the RayService")
+	rayService.Spec.ApplicationServices[0].Service = &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "app-svc"}}
+	rayService.Spec.ApplicationServices[1].Service.Name = "app-svc"
+	require.ErrorContains(t, rayService.validateRayService(), `spec.applicationServices[1].service.metadata.name: Invalid value: "app-svc"`)
+
+	// The generated Ingress names collide even if the Service names don't.
+	rayService.Spec.ApplicationServices[1].Service.Name = "app-a-svc"
+	rayService.Spec.ApplicationServices[0].Ingress = &ServeApplicationIngress{}
+	require.NoError(t, rayService.validateRayService())
+	rayService.Spec.ApplicationServices[1].Ingress = &ServeApplicationIngress{}
+	require.ErrorContains(t, rayService.validateRayService(), `spec.applicationServices[1].applicationName: Invalid value: "app-a": the Ingress of the application`)
+
+	// Unknown fields of the Serve config are admitted with warnings.
+	rayService.Spec.ApplicationServices = nil
+	rayService.Spec.ServeConfigV2 = `applications:
+  - name: fruit_app
+    import_path: fruit.deployment_graph
+    route_prefx: /fruit`
+	warnings, err := rayService.ValidateCreate()
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "route_prefx")
+}
@@ -98,6 +98,9 @@ var _ = BeforeSuite(func() {
 	err = (&RayCluster{}).SetupWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = (&RayService{}).SetupWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
 	//+kubebuilder:scaffold:webhook
 
 	go func() {
@@ -208,3 +211,37 @@ var _ = AfterSuite(func() {
 	err := testEnv.Stop()
 	Expect(err).NotTo(HaveOccurred())
 })
+
+var _ = Describe("RayService validating webhook", func() {
+	Context("when the Serve config has an unknown field", func() {
+		It("should be admitted", func() {
+			rayService := RayService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      fmt.Sprintf("test-rayservice-%d", rand.IntnRange(1000, 9000)),
+				},
+				Spec: RayServiceSpec{
+					ServeConfigV2: `
+applications:
+  - import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        num_replica: 2`,
+					RayClusterSpec: RayClusterSpec{
+						HeadGroupSpec: HeadGroupSpec{
+							RayStartParams: map[string]string{"DEADBEEF": "DEADBEEF"},
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			err := k8sClient.Create(context.TODO(), &rayService)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
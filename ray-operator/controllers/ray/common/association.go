@@ -68,3 +68,43 @@ func RayJobRayClusterNamespacedName(rayJob *rayv1.RayJob) types.NamespacedName {
 		Namespace: rayJob.Namespace,
 	}
 }
+
+func RayJobRayClusterPoolNamespacedName(rayJob *rayv1.RayJob) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      rayJob.Spec.RayClusterPoolName,
+		Namespace: rayJob.Namespace,
+	}
+}
+
+// RayClusterPoolRayClusterListOptions lists both the idle RayClusters of the pool and the RayClusters that
+// have already been claimed from it. Use the controller reference of each RayCluster to tell them apart.
+func RayClusterPoolRayClusterListOptions(namespace string, poolName string) []client.ListOption {
+	return []client.ListOption{
+		client.InNamespace(namespace),
+		client.MatchingLabels(map[string]string{utils.RayClusterPoolLabelKey: poolName}),
+	}
+}
+
+func RayJobRayJobQueueNamespacedName(rayJob *rayv1.RayJob) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      rayJob.Spec.RayJobQueueName,
+		Namespace: rayJob.Namespace,
+	}
+}
+
+func RayJobSetRayJobListOptions(rayJobSet *rayv1.RayJobSet) []client.ListOption {
+	return []client.ListOption{
+		client.InNamespace(rayJobSet.Namespace),
+		client.MatchingLabels(map[string]string{
+			utils.RayOriginatedFromCRNameLabelKey: rayJobSet.Name,
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayJobSetCRD),
+		}),
+	}
+}
+
+func RayJobSetSharedRayClusterNamespacedName(rayJobSet *rayv1.RayJobSet) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      rayJobSet.Status.SharedRayClusterName,
+		Namespace: rayJobSet.Namespace,
+	}
+}
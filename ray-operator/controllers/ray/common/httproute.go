@@ -0,0 +1,78 @@
+package common
+
+import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HTTPRouteGroupVersionKind is the GroupVersionKind of the Gateway API HTTPRoute. KubeRay manages HTTPRoutes as
+// unstructured objects so that the Gateway API CRDs are only required when a RayService uses them.
+var HTTPRouteGroupVersionKind = schema.GroupVersionKind{
+	Group:   "gateway.networking.k8s.io",
+	Version: "v1",
+	Kind:    "HTTPRoute",
+}
+
+// HTTPRouteBackend is a weighted Service backend of an HTTPRoute.
+type HTTPRouteBackend struct {
+	ServiceName string
+	Port        int32
+	Weight      int32
+}
+
+// BuildHTTPRouteForRayService builds the HTTPRoute of the RayService that splits the traffic between the backends
+// according to their weights.
+func BuildHTTPRouteForRayService(rayService rayv1.RayService, backends []HTTPRouteBackend) (*unstructured.Unstructured, error) {
+	trafficShifting := rayService.Spec.TrafficShifting
+
+	parentRef := map[string]interface{}{
+		"name": trafficShifting.Gateway.Name,
+	}
+	if trafficShifting.Gateway.Namespace != "" {
+		parentRef["namespace"] = trafficShifting.Gateway.Namespace
+	}
+	if trafficShifting.Gateway.SectionName != "" {
+		parentRef["sectionName"] = trafficShifting.Gateway.SectionName
+	}
+
+	backendRefs := make([]interface{}, 0, len(backends))
+	for _, backend := range backends {
+		backendRefs = append(backendRefs, map[string]interface{}{
+			"name":   backend.ServiceName,
+			"port":   int64(backend.Port),
+			"weight": int64(backend.Weight),
+		})
+	}
+
+	spec := map[string]interface{}{
+		"parentRefs": []interface{}{parentRef},
+		"rules": []interface{}{
+			map[string]interface{}{"backendRefs": backendRefs},
+		},
+	}
+	if len(trafficShifting.Hostnames) > 0 {
+		hostnames := make([]interface{}, 0, len(trafficShifting.Hostnames))
+		for _, hostname := range trafficShifting.Hostnames {
+			hostnames = append(hostnames, hostname)
+		}
+		spec["hostnames"] = hostnames
+	}
+
+	specHash, err := utils.GenerateJsonHash(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRoute := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	httpRoute.SetGroupVersionKind(HTTPRouteGroupVersionKind)
+	httpRoute.SetName(utils.GenerateHTTPRouteName(rayService.Name))
+	httpRoute.SetNamespace(rayService.Namespace)
+	httpRoute.SetLabels(map[string]string{
+		utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+	})
+	httpRoute.SetAnnotations(map[string]string{utils.HTTPRouteSpecHashKey: specHash})
+	return httpRoute, nil
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"testing"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBuildHTTPRouteForRayService(t *testing.T) {
+	rayService := rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rayservice-sample",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayServiceSpec{
+			TrafficShifting: &rayv1.TrafficShiftingSpec{
+				Gateway:   rayv1.GatewayReference{Name: "gateway", Namespace: "gateway-system"},
+				Hostnames: []string{"example.com"},
+				Steps:     []rayv1.TrafficShiftingStep{{Weight: 100}},
+			},
+		},
+	}
+	backends := []HTTPRouteBackend{
+		{ServiceName: "active-serve-svc", Port: 8000, Weight: 90},
+		{ServiceName: "pending-serve-svc", Port: 8000, Weight: 10},
+	}
+
+	httpRoute, err := BuildHTTPRouteForRayService(rayService, backends)
+	assert.Nil(t, err)
+	assert.Equal(t, HTTPRouteGroupVersionKind, httpRoute.GroupVersionKind())
+	assert.Equal(t, utils.GenerateHTTPRouteName(rayService.Name), httpRoute.GetName())
+	assert.Equal(t, rayService.Namespace, httpRoute.GetNamespace())
+	assert.NotEmpty(t, httpRoute.GetAnnotations()[utils.HTTPRouteSpecHashKey])
+
+	parentRefs, _, _ := unstructured.NestedSlice(httpRoute.Object, "spec", "parentRefs")
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "gateway", "namespace": "gateway-system"}}, parentRefs)
+	hostnames, _, _ := unstructured.NestedStringSlice(httpRoute.Object, "spec", "hostnames")
+	assert.Equal(t, []string{"example.com"}, hostnames)
+	rules, _, _ := unstructured.NestedSlice(httpRoute.Object, "spec", "rules")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "active-serve-svc", "port": int64(8000), "weight": int64(90)},
+		map[string]interface{}{"name": "pending-serve-svc", "port": int64(8000), "weight": int64(10)},
+	}, rules[0].(map[string]interface{})["backendRefs"])
+
+	// The hash changes with the weights.
+	backends[0].Weight, backends[1].Weight = 50, 50
+	newHTTPRoute, err := BuildHTTPRouteForRayService(rayService, backends)
+	assert.Nil(t, err)
+	assert.NotEqual(t, httpRoute.GetAnnotations()[utils.HTTPRouteSpecHashKey], newHTTPRoute.GetAnnotations()[utils.HTTPRouteSpecHashKey])
+}
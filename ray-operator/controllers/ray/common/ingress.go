@@ -7,6 +7,7 @@ import (
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -98,3 +99,64 @@ func BuildIngressForHeadService(ctx context.Context, cluster rayv1.RayCluster) (
 
 	return ingress, nil
 }
+
+// BuildIngressForServeApplication builds the Ingress of a Serve application listed in `spec.applicationServices`.
+// The Ingress routes the requests whose path starts with the `route_prefix` of the application to its Service.
+func BuildIngressForServeApplication(rayService rayv1.RayService, appService rayv1.ServeApplicationService, appSvc *corev1.Service, routePrefix string) (*networkingv1.Ingress, error) {
+	var servePort int32
+	for _, port := range appSvc.Spec.Ports {
+		if port.Name == utils.ServingPortName {
+			servePort = port.Port
+			break
+		}
+	}
+	if servePort == 0 {
+		return nil, fmt.Errorf("port %s is not found in the Service %s of Serve application %s", utils.ServingPortName, appSvc.Name, appService.ApplicationName)
+	}
+
+	labels := map[string]string{
+		utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+		utils.ServeApplicationLabelKey:        utils.GenerateServeApplicationLabel(appService.ApplicationName),
+	}
+
+	pathType := networkingv1.PathTypePrefix
+	ingressSpec := appService.Ingress.DeepCopy()
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        utils.GenerateServeApplicationIngressName(rayService.Name, appService.ApplicationName),
+			Namespace:   rayService.Namespace,
+			Labels:      labels,
+			Annotations: ingressSpec.Annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ingressSpec.IngressClassName,
+			TLS:              ingressSpec.TLS,
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: ingressSpec.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     routePrefix,
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: appSvc.Name,
+											Port: networkingv1.ServiceBackendPort{
+												Number: servePort,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return ingress, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -131,3 +132,49 @@ func TestBuildIngressForHeadService(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildIngressForServeApplication(t *testing.T) {
+	rayService := rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rayservice-sample",
+			Namespace: "default",
+		},
+	}
+	ingressClassName := "nginx"
+	appService := rayv1.ServeApplicationService{
+		ApplicationName: "fruit_app",
+		Ingress: &rayv1.ServeApplicationIngress{
+			IngressClassName: &ingressClassName,
+			Annotations:      map[string]string{"nginx.ingress.kubernetes.io/auth-type": "basic"},
+			Host:             "fruit.example.com",
+		},
+	}
+	appSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayservice-sample-fruit-app-serve-svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: utils.ServingPortName, Port: 8000}},
+		},
+	}
+
+	ingress, err := BuildIngressForServeApplication(rayService, appService, appSvc, "/fruit")
+	assert.Nil(t, err)
+	assert.Equal(t, "rayservice-sample-fruit-app-serve-ingress", ingress.Name)
+	assert.Equal(t, "default", ingress.Namespace)
+	assert.Equal(t, "fruit-app", ingress.Labels[utils.ServeApplicationLabelKey])
+	assert.Equal(t, "basic", ingress.Annotations["nginx.ingress.kubernetes.io/auth-type"])
+	assert.Equal(t, ingressClassName, *ingress.Spec.IngressClassName)
+	assert.Equal(t, 1, len(ingress.Spec.Rules))
+	assert.Equal(t, "fruit.example.com", ingress.Spec.Rules[0].Host)
+
+	paths := ingress.Spec.Rules[0].IngressRuleValue.HTTP.Paths
+	assert.Equal(t, 1, len(paths))
+	assert.Equal(t, "/fruit", paths[0].Path)
+	assert.Equal(t, networkingv1.PathTypePrefix, *paths[0].PathType)
+	assert.Equal(t, appSvc.Name, paths[0].Backend.Service.Name)
+	assert.Equal(t, int32(8000), paths[0].Backend.Service.Port.Number)
+
+	// The Service of the application must have the serve port.
+	appSvc.Spec.Ports = nil
+	_, err = BuildIngressForServeApplication(rayService, appService, appSvc, "/fruit")
+	assert.NotNil(t, err)
+}
@@ -16,6 +16,18 @@ import (
 
 // GetRuntimeEnvJson returns the JSON string of the runtime environment for the Ray job.
 func getRuntimeEnvJson(rayJobInstance *rayv1.RayJob) (string, error) {
+	if rayJobInstance.Spec.RuntimeEnv != nil {
+		runtimeEnv, err := utils.ConvertRuntimeEnv(rayJobInstance.Spec.RuntimeEnv)
+		if err != nil {
+			return "", err
+		}
+		jsonData, err := json.Marshal(runtimeEnv)
+		if err != nil {
+			return "", err
+		}
+		return string(jsonData), nil
+	}
+
 	runtimeEnvYAML := rayJobInstance.Spec.RuntimeEnvYAML
 
 	if len(runtimeEnvYAML) > 0 {
@@ -37,6 +37,34 @@ func TestGetRuntimeEnvJsonFromBase64(t *testing.T) {
 	assert.Equal(t, expected, jsonOutput)
 }
 
+func TestGetRuntimeEnvJsonFromRuntimeEnv(t *testing.T) {
+	rayJob := &rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RuntimeEnv: &rayv1.RuntimeEnv{
+				Pip:        []string{"python-multipart==0.0.6"},
+				EnvVars:    []rayv1.RuntimeEnvVar{{Name: "counter_name", Value: "test_counter"}},
+				WorkingDir: "https://github.com/ray-project/serve_config_examples/archive/b393e77bbd6aba0881e3d94c05f968f05a387b96.zip",
+				Container:  &rayv1.RuntimeEnvContainer{Image: "rayproject/ray:2.9.0", RunOptions: []string{"--cap-drop SYS_ADMIN"}},
+			},
+		},
+	}
+	expected := `{"container":{"image":"rayproject/ray:2.9.0","run_options":["--cap-drop SYS_ADMIN"]},"env_vars":{"counter_name":"test_counter"},` +
+		`"pip":["python-multipart==0.0.6"],"working_dir":"https://github.com/ray-project/serve_config_examples/archive/b393e77bbd6aba0881e3d94c05f968f05a387b96.zip"}`
+	jsonOutput, err := getRuntimeEnvJson(rayJob)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, jsonOutput)
+
+	// The environment variables that reference Secrets or ConfigMaps must be resolved first.
+	rayJob.Spec.RuntimeEnv.EnvVars = []rayv1.RuntimeEnvVar{{
+		Name: "TOKEN",
+		ValueFrom: &rayv1.RuntimeEnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "token"},
+		},
+	}}
+	_, err = getRuntimeEnvJson(rayJob)
+	assert.Error(t, err)
+}
+
 func TestGetRuntimeEnvJsonFromYAML(t *testing.T) {
 	rayJobWithYAML := &rayv1.RayJob{
 		Spec: rayv1.RayJobSpec{
@@ -161,6 +161,64 @@ func BuildServeServiceForRayService(ctx context.Context, rayService rayv1.RaySer
 	return BuildServeService(ctx, rayService, rayCluster, true)
 }
 
+// BuildServeServiceForRayServiceCluster builds a serve service that only selects the serving Pods of one RayCluster
+// of the RayService. These services are the weighted backends of the HTTPRoute during the gradual traffic shifting.
+func BuildServeServiceForRayServiceCluster(ctx context.Context, rayService rayv1.RayService, rayCluster rayv1.RayCluster) (*corev1.Service, error) {
+	serveService, err := BuildServeService(ctx, rayService, rayCluster, true)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{
+		utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+		utils.RayClusterLabelKey:              rayCluster.Name,
+	}
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utils.GenerateServeServiceName(rayCluster.Name),
+			Namespace: rayService.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: serveService.Spec.Selector,
+			Ports:    serveService.Spec.Ports,
+		},
+	}, nil
+}
+
+// BuildServeApplicationServiceForRayService builds the Kubernetes Service of a Serve application listed in
+// `spec.applicationServices`. It selects the same Pods and exposes the same port as the serve service, while its
+// metadata, type and the other fields of its spec come from the Service template of the application.
+func BuildServeApplicationServiceForRayService(ctx context.Context, rayService rayv1.RayService, rayCluster rayv1.RayCluster, appService rayv1.ServeApplicationService) (*corev1.Service, error) {
+	serveService, err := BuildServeServiceForRayService(ctx, rayService, rayCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{
+		utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+		utils.ServeApplicationLabelKey:        utils.GenerateServeApplicationLabel(appService.ApplicationName),
+	}
+
+	appSvc := &corev1.Service{}
+	if appService.Service != nil {
+		appSvc = appService.Service.DeepCopy()
+	}
+	// For the selector and the ports, ignore any custom values so that the application Service routes to the same
+	// Ray Serve proxies as the serve service.
+	appSvc.Spec.Selector = serveService.Spec.Selector
+	appSvc.Spec.Ports = serveService.Spec.Ports
+
+	setLabelsforUserProvidedService(appSvc, labels)
+	setNameforUserProvidedService(ctx, appSvc, utils.GenerateServeApplicationServiceName(rayService.Name, appService.ApplicationName))
+	setNamespaceforUserProvidedService(ctx, appSvc, rayService.Namespace)
+	setServiceTypeForUserProvidedService(ctx, appSvc, rayService.Spec.RayClusterSpec.HeadGroupSpec.ServiceType)
+
+	return appSvc, nil
+}
+
 // BuildServeServiceForRayCluster builds the serve service for Ray cluster.
 func BuildServeServiceForRayCluster(ctx context.Context, rayCluster rayv1.RayCluster) (*corev1.Service, error) {
 	return BuildServeService(ctx, rayv1.RayService{}, rayCluster, false)
@@ -209,6 +267,17 @@ func BuildServeService(ctx context.Context, rayService rayv1.RayService, rayClus
 		}
 	}
 
+	// The Ray Serve gRPC proxies are exposed through the port named "serve-grpc" if the Ray head container has it.
+	// Otherwise, RayService uses the port in `grpc_options` of the Serve config if it enables the gRPC proxies.
+	grpcPorts := []corev1.ServicePort{}
+	if port, ok := ports_int[utils.ServingGRPCPortName]; ok {
+		grpcPorts = append(grpcPorts, corev1.ServicePort{Name: utils.ServingGRPCPortName, Port: port})
+	} else if isRayService {
+		if port, ok := utils.GetServeGRPCPort(rayService.Spec.ServeConfigV2); ok {
+			grpcPorts = append(grpcPorts, corev1.ServicePort{Name: utils.ServingGRPCPortName, Port: port})
+		}
+	}
+
 	if isRayService {
 		// We are invoked from RayService
 		if len(ports) == 0 && rayService.Spec.ServeService == nil {
@@ -232,7 +301,7 @@ func BuildServeService(ctx context.Context, rayService rayv1.RayService, rayClus
 			// Keeping this consistentent with adding only serve port in serve service
 			if len(ports) != 0 {
 				log.Info("port with name 'serve' already added. Ignoring user provided ports for serve service")
-				serveService.Spec.Ports = ports
+				serveService.Spec.Ports = append(ports, grpcPorts...)
 			} else {
 				ports := []corev1.ServicePort{}
 				for _, port := range serveService.Spec.Ports {
@@ -242,7 +311,16 @@ func BuildServeService(ctx context.Context, rayService rayv1.RayService, rayClus
 						break
 					}
 				}
-				serveService.Spec.Ports = ports
+				// Keep the user provided "serve-grpc" port unless the Ray head container or the Serve config has one.
+				if len(grpcPorts) == 0 {
+					for _, port := range serveService.Spec.Ports {
+						if port.Name == utils.ServingGRPCPortName {
+							grpcPorts = append(grpcPorts, corev1.ServicePort{Name: port.Name, Port: port.Port})
+							break
+						}
+					}
+				}
+				serveService.Spec.Ports = append(ports, grpcPorts...)
 			}
 
 			setLabelsforUserProvidedService(serveService, labels)
@@ -268,7 +346,7 @@ func BuildServeService(ctx context.Context, rayService rayv1.RayService, rayClus
 		},
 		Spec: corev1.ServiceSpec{
 			Selector: selectorLabels,
-			Ports:    ports,
+			Ports:    append(ports, grpcPorts...),
 			Type:     default_type,
 		},
 	}
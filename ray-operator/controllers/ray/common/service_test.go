@@ -459,6 +459,97 @@ func TestBuildServeServiceForRayService(t *testing.T) {
 	validateNameAndNamespaceForUserSpecifiedService(svc, serviceInstance.ObjectMeta.Namespace, expectedName, t)
 }
 
+func TestBuildServeServiceForRayServiceWithGRPC(t *testing.T) {
+	rayService := serviceInstance.DeepCopy()
+	cluster := instanceForServeSvc.DeepCopy()
+
+	// The serve service doesn't expose the gRPC port if neither the Ray head container nor the Serve config has one.
+	svc, err := BuildServeServiceForRayService(context.Background(), *rayService, *cluster)
+	assert.Nil(t, err)
+	assert.Equal(t, []corev1.ServicePort{{Name: utils.ServingPortName, Port: 8000}}, svc.Spec.Ports)
+
+	// The port in `grpc_options` is used if the Serve config enables the gRPC proxies.
+	rayService.Spec.ServeConfigV2 = `grpc_options:
+  port: 9001
+  grpc_servicer_functions:
+    - user_defined_protos_pb2_grpc.add_UserDefinedServiceServicer_to_server
+applications: []`
+	svc, err = BuildServeServiceForRayService(context.Background(), *rayService, *cluster)
+	assert.Nil(t, err)
+	assert.Equal(t, []corev1.ServicePort{
+		{Name: utils.ServingPortName, Port: 8000},
+		{Name: utils.ServingGRPCPortName, Port: 9001},
+	}, svc.Spec.Ports)
+
+	// The port named "serve-grpc" in the Ray head container takes precedence over the Serve config.
+	cluster.Spec.HeadGroupSpec.Template.Spec.Containers[0].Ports = append(cluster.Spec.HeadGroupSpec.Template.Spec.Containers[0].Ports,
+		corev1.ContainerPort{ContainerPort: 9000, Name: utils.ServingGRPCPortName})
+	svc, err = BuildServeServiceForRayService(context.Background(), *rayService, *cluster)
+	assert.Nil(t, err)
+	assert.Equal(t, []corev1.ServicePort{
+		{Name: utils.ServingPortName, Port: 8000},
+		{Name: utils.ServingGRPCPortName, Port: 9000},
+	}, svc.Spec.Ports)
+
+	// The serve service of a RayCluster only uses the Ray head container.
+	svc, err = BuildServeServiceForRayCluster(context.Background(), *cluster)
+	assert.Nil(t, err)
+	assert.Equal(t, []corev1.ServicePort{
+		{Name: utils.ServingPortName, Port: 8000},
+		{Name: utils.ServingGRPCPortName, Port: 9000},
+	}, svc.Spec.Ports)
+}
+
+func TestBuildServeApplicationServiceForRayService(t *testing.T) {
+	// Without a Service template, the application Service has the generated name and the selector, the ports and the type
+	// of the serve service.
+	appService := rayv1.ServeApplicationService{ApplicationName: "fruit_app"}
+	svc, err := BuildServeApplicationServiceForRayService(context.Background(), *serviceInstance, *instanceForServeSvc, appService)
+	assert.Nil(t, err)
+	validateNameAndNamespaceForUserSpecifiedService(svc, serviceInstance.Namespace, "rayservice-sample-fruit-app-serve-svc", t)
+	assert.Equal(t, map[string]string{
+		utils.RayClusterLabelKey:               instanceForServeSvc.Name,
+		utils.RayClusterServingServiceLabelKey: utils.EnableRayClusterServingServiceTrue,
+	}, svc.Spec.Selector)
+	assert.Equal(t, []corev1.ServicePort{{Name: utils.ServingPortName, Port: 8000}}, svc.Spec.Ports)
+	assert.Equal(t, corev1.ServiceTypeClusterIP, svc.Spec.Type)
+	assert.Equal(t, "fruit-app", svc.Labels[utils.ServeApplicationLabelKey])
+	assert.Equal(t, serviceInstance.Name, svc.Labels[utils.RayOriginatedFromCRNameLabelKey])
+
+	// The metadata and the type of the Service template are used, while its selector and ports are ignored.
+	appService.Service = &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fruit-svc",
+			Namespace:   "other-namespace",
+			Labels:      map[string]string{"team": "fruit", utils.ServeApplicationLabelKey: "other"},
+			Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeLoadBalancer,
+			Selector: map[string]string{"app": "fruit"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+	svc, err = BuildServeApplicationServiceForRayService(context.Background(), *serviceInstance, *instanceForServeSvc, appService)
+	assert.Nil(t, err)
+	validateNameAndNamespaceForUserSpecifiedService(svc, serviceInstance.Namespace, "fruit-svc", t)
+	assert.Equal(t, instanceForServeSvc.Name, svc.Spec.Selector[utils.RayClusterLabelKey])
+	assert.Equal(t, []corev1.ServicePort{{Name: utils.ServingPortName, Port: 8000}}, svc.Spec.Ports)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, svc.Spec.Type)
+	assert.Equal(t, "fruit", svc.Labels["team"])
+	assert.Equal(t, "fruit-app", svc.Labels[utils.ServeApplicationLabelKey])
+	assert.Equal(t, "true", svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-internal"])
+	// The template in the RayService is not modified.
+	assert.Equal(t, "other", appService.Service.Labels[utils.ServeApplicationLabelKey])
+
+	// An application name that isn't a valid label value is sanitized like in the name of the Service.
+	appService = rayv1.ServeApplicationService{ApplicationName: "Fruit/App v2"}
+	svc, err = BuildServeApplicationServiceForRayService(context.Background(), *serviceInstance, *instanceForServeSvc, appService)
+	assert.Nil(t, err)
+	validateNameAndNamespaceForUserSpecifiedService(svc, serviceInstance.Namespace, "rayservice-sample-fruit-app-v2-serve-svc", t)
+	assert.Equal(t, "fruit-app-v2", svc.Labels[utils.ServeApplicationLabelKey])
+}
+
 func TestBuildServeServiceForRayCluster(t *testing.T) {
 	svc, err := BuildServeServiceForRayCluster(context.Background(), *instanceForServeSvc)
 	assert.Nil(t, err)
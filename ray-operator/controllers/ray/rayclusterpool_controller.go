@@ -0,0 +1,238 @@
+package ray
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const RayClusterPoolDefaultRequeueDuration = 10 * time.Second
+
+// RayClusterPoolReconciler reconciles a RayClusterPool object
+type RayClusterPoolReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// NewRayClusterPoolReconciler returns a new reconcile.Reconciler
+func NewRayClusterPoolReconciler(ctx context.Context, mgr manager.Manager) *RayClusterPoolReconciler {
+	return &RayClusterPoolReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("rayclusterpool-controller"),
+	}
+}
+
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// [WARNING]: There MUST be a newline after kubebuilder markers.
+// Reconcile keeps `Spec.Replicas` idle RayClusters provisioned for a RayClusterPool. A RayCluster is idle as long
+// as it is controlled by the pool. When a RayJob claims it, the controller reference is handed over to the RayJob,
+// and the pool creates a new RayCluster to replace it.
+func (r *RayClusterPoolReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	rayClusterPool := &rayv1.RayClusterPool{}
+	if err := r.Get(ctx, request.NamespacedName, rayClusterPool); err != nil {
+		if errors.IsNotFound(err) {
+			// The idle RayClusters will be garbage collected by Kubernetes because they are owned by the pool.
+			logger.Info("RayClusterPool resource not found. Ignoring since object must be deleted", "name", request.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get RayClusterPool")
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+
+	if !rayClusterPool.ObjectMeta.DeletionTimestamp.IsZero() {
+		logger.Info("RayClusterPool is being deleted", "DeletionTimestamp", rayClusterPool.ObjectMeta.DeletionTimestamp)
+		return ctrl.Result{}, nil
+	}
+
+	templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.RayClusterSpec)
+	if err != nil {
+		logger.Error(err, "Failed to generate the hash of the RayClusterSpec")
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+
+	idleClusters, err := r.getIdleRayClusters(ctx, rayClusterPool)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+
+	// Replace idle RayClusters that were created from an outdated template.
+	upToDateClusters := make([]rayv1.RayCluster, 0, len(idleClusters))
+	for i := range idleClusters {
+		cluster := &idleClusters[i]
+		if cluster.Annotations[utils.RayClusterPoolTemplateHashKey] == templateHash {
+			upToDateClusters = append(upToDateClusters, *cluster)
+			continue
+		}
+		logger.Info("The RayClusterSpec of the pool has changed. Delete the outdated idle RayCluster.", "RayCluster", cluster.Name)
+		if err := r.deleteIdleRayCluster(ctx, rayClusterPool, cluster); err != nil {
+			return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+		}
+	}
+
+	desiredReplicas := int32(1)
+	if rayClusterPool.Spec.Replicas != nil {
+		desiredReplicas = *rayClusterPool.Spec.Replicas
+	}
+	diff := int(desiredReplicas) - len(upToDateClusters)
+	if diff > 0 {
+		logger.Info("Create idle RayClusters for the pool", "desiredReplicas", desiredReplicas, "idleReplicas", len(upToDateClusters), "diff", diff)
+		for i := 0; i < diff; i++ {
+			cluster, err := r.constructRayClusterForRayClusterPool(rayClusterPool, templateHash)
+			if err != nil {
+				logger.Error(err, "unable to construct a new RayCluster")
+				return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+			}
+			if err := r.Create(ctx, cluster); err != nil {
+				logger.Error(err, "unable to create RayCluster for RayClusterPool", "RayCluster", cluster.Name)
+				return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+			}
+			upToDateClusters = append(upToDateClusters, *cluster)
+			r.Recorder.Eventf(rayClusterPool, corev1.EventTypeNormal, "Created", "Created RayCluster %s", cluster.Name)
+		}
+	} else if diff < 0 {
+		// Scale down the pool. Prefer deleting the RayClusters that are not ready yet.
+		sort.SliceStable(upToDateClusters, func(i, j int) bool {
+			return upToDateClusters[i].Status.State != rayv1.Ready && upToDateClusters[j].Status.State == rayv1.Ready
+		})
+		logger.Info("Delete idle RayClusters from the pool", "desiredReplicas", desiredReplicas, "idleReplicas", len(upToDateClusters), "diff", diff)
+		for i := 0; i < -diff; i++ {
+			if err := r.deleteIdleRayCluster(ctx, rayClusterPool, &upToDateClusters[i]); err != nil {
+				return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+			}
+		}
+		upToDateClusters = upToDateClusters[-diff:]
+	}
+
+	if err := r.updateRayClusterPoolStatus(ctx, rayClusterPool, upToDateClusters); err != nil {
+		logger.Info("Failed to update RayClusterPool status", "error", err)
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+	return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, nil
+}
+
+// getIdleRayClusters returns the RayClusters that belong to the pool and have not been claimed by a RayJob yet.
+func (r *RayClusterPoolReconciler) getIdleRayClusters(ctx context.Context, rayClusterPool *rayv1.RayClusterPool) ([]rayv1.RayCluster, error) {
+	rayClusterList := rayv1.RayClusterList{}
+	if err := r.List(ctx, &rayClusterList, common.RayClusterPoolRayClusterListOptions(rayClusterPool.Namespace, rayClusterPool.Name)...); err != nil {
+		return nil, err
+	}
+	idleClusters := make([]rayv1.RayCluster, 0, len(rayClusterList.Items))
+	for _, cluster := range rayClusterList.Items {
+		if cluster.DeletionTimestamp.IsZero() && metav1.IsControlledBy(&cluster, rayClusterPool) {
+			idleClusters = append(idleClusters, cluster)
+		}
+	}
+	return idleClusters, nil
+}
+
+func (r *RayClusterPoolReconciler) deleteIdleRayCluster(ctx context.Context, rayClusterPool *rayv1.RayClusterPool, cluster *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	// The precondition avoids deleting a RayCluster that a RayJob has claimed since it was listed.
+	if err := r.Delete(ctx, cluster, client.Preconditions{ResourceVersion: &cluster.ResourceVersion}); err != nil {
+		if errors.IsNotFound(err) || errors.IsConflict(err) {
+			logger.Info("The idle RayCluster has been deleted or claimed", "RayCluster", cluster.Name, "error", err)
+			return nil
+		}
+		logger.Error(err, "Failed to delete idle RayCluster", "RayCluster", cluster.Name)
+		return err
+	}
+	r.Recorder.Eventf(rayClusterPool, corev1.EventTypeNormal, "Deleted", "Deleted RayCluster %s", cluster.Name)
+	return nil
+}
+
+func (r *RayClusterPoolReconciler) constructRayClusterForRayClusterPool(rayClusterPool *rayv1.RayClusterPool, templateHash string) (*rayv1.RayCluster, error) {
+	labels := make(map[string]string, len(rayClusterPool.Labels))
+	for key, value := range rayClusterPool.Labels {
+		labels[key] = value
+	}
+	labels[utils.RayOriginatedFromCRNameLabelKey] = rayClusterPool.Name
+	labels[utils.RayOriginatedFromCRDLabelKey] = utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD)
+	labels[utils.RayClusterPoolLabelKey] = rayClusterPool.Name
+	annotations := make(map[string]string, len(rayClusterPool.Annotations))
+	for key, value := range rayClusterPool.Annotations {
+		annotations[key] = value
+	}
+	annotations[utils.RayClusterPoolTemplateHashKey] = templateHash
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      labels,
+			Annotations: annotations,
+			Name:        utils.GenerateRayClusterName(rayClusterPool.Name),
+			Namespace:   rayClusterPool.Namespace,
+		},
+		Spec: *rayClusterPool.Spec.RayClusterSpec.DeepCopy(),
+	}
+
+	// Set the ownership in order to do the garbage collection by k8s. The ownership is handed over to the
+	// RayJob when the RayCluster is claimed.
+	if err := ctrl.SetControllerReference(rayClusterPool, rayCluster, r.Scheme); err != nil {
+		return nil, err
+	}
+
+	return rayCluster, nil
+}
+
+func (r *RayClusterPoolReconciler) updateRayClusterPoolStatus(ctx context.Context, rayClusterPool *rayv1.RayClusterPool, idleClusters []rayv1.RayCluster) error {
+	newStatus := rayClusterPool.Status.DeepCopy()
+	newStatus.IdleReplicas = int32(len(idleClusters))
+	newStatus.ReadyReplicas = 0
+	for _, cluster := range idleClusters {
+		if cluster.Status.State == rayv1.Ready {
+			newStatus.ReadyReplicas++
+		}
+	}
+	newStatus.ObservedGeneration = rayClusterPool.Generation
+
+	if newStatus.IdleReplicas == rayClusterPool.Status.IdleReplicas &&
+		newStatus.ReadyReplicas == rayClusterPool.Status.ReadyReplicas &&
+		newStatus.ObservedGeneration == rayClusterPool.Status.ObservedGeneration {
+		return nil
+	}
+	newStatus.LastUpdateTime = &metav1.Time{Time: time.Now()}
+	rayClusterPool.Status = *newStatus
+	return r.Status().Update(ctx, rayClusterPool)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RayClusterPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayv1.RayClusterPool{}).
+		// When a RayJob claims a RayCluster, the update event is also enqueued for the previous controller,
+		// i.e. the RayClusterPool, which replenishes the pool.
+		Owns(&rayv1.RayCluster{}).
+		WithOptions(controller.Options{
+			LogConstructor: func(request *reconcile.Request) logr.Logger {
+				logger := ctrl.Log.WithName("controllers").WithName("RayClusterPool")
+				if request != nil {
+					logger = logger.WithValues("RayClusterPool", request.NamespacedName)
+				}
+				return logger
+			},
+		}).
+		Complete(r)
+}
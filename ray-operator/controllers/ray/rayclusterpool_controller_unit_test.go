@@ -0,0 +1,176 @@
+package ray
+
+import (
+	"context"
+	"testing"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRayClusterPoolReconcile(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pool",
+			Namespace: namespace,
+			UID:       "pool-uid",
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Replicas: pointer.Int32(2),
+			RayClusterSpec: rayv1.RayClusterSpec{
+				RayVersion: "2.9.0",
+			},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayClusterPool).
+		WithStatusSubresource(rayClusterPool).
+		Build()
+	ctx := context.Background()
+	r := &RayClusterPoolReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: rayClusterPool.Name, Namespace: namespace}}
+
+	listPoolClusters := func() []rayv1.RayCluster {
+		rayClusterList := rayv1.RayClusterList{}
+		err := fakeClient.List(ctx, &rayClusterList, common.RayClusterPoolRayClusterListOptions(namespace, rayClusterPool.Name)...)
+		assert.NoError(t, err)
+		return rayClusterList.Items
+	}
+	getPool := func() *rayv1.RayClusterPool {
+		pool := &rayv1.RayClusterPool{}
+		err := fakeClient.Get(ctx, request.NamespacedName, pool)
+		assert.NoError(t, err)
+		return pool
+	}
+
+	// The pool creates `Spec.Replicas` idle RayClusters.
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	clusters := listPoolClusters()
+	assert.Len(t, clusters, 2)
+	pool := getPool()
+	for _, cluster := range clusters {
+		assert.True(t, metav1.IsControlledBy(&cluster, pool))
+		assert.Equal(t, "2.9.0", cluster.Spec.RayVersion)
+		assert.Equal(t, utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD), cluster.Labels[utils.RayOriginatedFromCRDLabelKey])
+		assert.NotEmpty(t, cluster.Annotations[utils.RayClusterPoolTemplateHashKey])
+	}
+	assert.Equal(t, int32(2), pool.Status.IdleReplicas)
+
+	// Reconciling again doesn't create more RayClusters.
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Len(t, listPoolClusters(), 2)
+
+	// A RayJob claims one of the RayClusters, and the pool replenishes itself.
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob", Namespace: namespace, UID: "rayjob-uid"},
+		Spec:       rayv1.RayJobSpec{RayClusterPoolName: rayClusterPool.Name},
+	}
+	rayJobReconciler := &RayJobReconciler{Client: fakeClient, Scheme: newScheme, Recorder: &record.FakeRecorder{}}
+	claimedCluster, err := rayJobReconciler.getOrClaimRayClusterFromPool(ctx, rayJob)
+	assert.NoError(t, err)
+
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	clusters = listPoolClusters()
+	assert.Len(t, clusters, 3)
+	pool = getPool()
+	idleReplicas := 0
+	for _, cluster := range clusters {
+		if metav1.IsControlledBy(&cluster, pool) {
+			assert.NotEqual(t, claimedCluster.Name, cluster.Name)
+			idleReplicas++
+		}
+	}
+	assert.Equal(t, 2, idleReplicas)
+	assert.Equal(t, int32(2), pool.Status.IdleReplicas)
+
+	// Scaling down the pool only deletes idle RayClusters.
+	pool.Spec.Replicas = pointer.Int32(0)
+	err = fakeClient.Update(ctx, pool)
+	assert.NoError(t, err)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	clusters = listPoolClusters()
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, claimedCluster.Name, clusters[0].Name)
+	assert.Equal(t, int32(0), getPool().Status.IdleReplicas)
+}
+
+func TestRayClusterPoolReconcileTemplateChange(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pool",
+			Namespace: namespace,
+			UID:       "pool-uid",
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Replicas: pointer.Int32(1),
+			RayClusterSpec: rayv1.RayClusterSpec{
+				RayVersion: "2.9.0",
+			},
+		},
+	}
+	outdatedCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "outdated-cluster",
+			Namespace: namespace,
+			Labels: map[string]string{
+				utils.RayClusterPoolLabelKey: rayClusterPool.Name,
+			},
+			Annotations: map[string]string{
+				utils.RayClusterPoolTemplateHashKey: "outdated-hash",
+			},
+		},
+	}
+	_ = ctrl.SetControllerReference(rayClusterPool, outdatedCluster, newScheme)
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayClusterPool, outdatedCluster).
+		WithStatusSubresource(rayClusterPool).
+		Build()
+	ctx := context.Background()
+	r := &RayClusterPoolReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+	}
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: rayClusterPool.Name, Namespace: namespace}})
+	assert.NoError(t, err)
+
+	rayClusterList := rayv1.RayClusterList{}
+	err = fakeClient.List(ctx, &rayClusterList, common.RayClusterPoolRayClusterListOptions(namespace, rayClusterPool.Name)...)
+	assert.NoError(t, err)
+	assert.Len(t, rayClusterList.Items, 1)
+	assert.NotEqual(t, outdatedCluster.Name, rayClusterList.Items[0].Name)
+	assert.Equal(t, "2.9.0", rayClusterList.Items[0].Spec.RayVersion)
+}
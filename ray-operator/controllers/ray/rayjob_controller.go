@@ -3,6 +3,9 @@ package ray
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -10,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -55,6 +59,7 @@ func NewRayJobReconciler(ctx context.Context, mgr manager.Manager, dashboardClie
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;list;watch;create;update;patch;delete
@@ -62,6 +67,8 @@ func NewRayJobReconciler(ctx context.Context, mgr manager.Manager, dashboardClie
 // +kubebuilder:rbac:groups=core,resources=services/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles,verbs=get;list;watch;create;delete;update
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=rolebindings,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
@@ -101,6 +108,13 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			}
 		}
 
+		if len(rayJobInstance.Spec.ClusterSelector) != 0 {
+			if err := r.releaseRayClusterJobSlot(ctx, rayJobInstance); err != nil {
+				logger.Error(err, "Failed to release the job slot on the RayCluster")
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+		}
+
 		logger.Info("Remove the finalizer no matter StopJob() succeeds or not.", "finalizer", utils.RayJobStopJobFinalizer)
 		controllerutil.RemoveFinalizer(rayJobInstance, utils.RayJobStopJobFinalizer)
 		err := r.Update(ctx, rayJobInstance)
@@ -130,6 +144,22 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 			}
 		}
+		// A RayJob that references a RayJobQueue is suspended and queued when it is created or resumed, and the
+		// RayJobQueue controller admits it by setting `suspend` to false. The suspended RayJob transitions to
+		// `Suspended` without creating any resources.
+		if rayJobInstance.Spec.RayJobQueueName != "" && !rayJobInstance.Spec.Suspend && !isRayJobAdmitted(rayJobInstance) {
+			logger.Info("The RayJob has not been admitted by the RayJobQueue yet. Suspend it.", "RayJobQueue", rayJobInstance.Spec.RayJobQueueName)
+			rayJobInstance.Spec.Suspend = true
+			if rayJobInstance.Annotations == nil {
+				rayJobInstance.Annotations = make(map[string]string)
+			}
+			rayJobInstance.Annotations[utils.RayJobQueueAdmittedAnnotationKey] = "false"
+			if err := r.Update(ctx, rayJobInstance); err != nil {
+				logger.Error(err, "Failed to suspend RayJob")
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+			r.Recorder.Eventf(rayJobInstance, corev1.EventTypeNormal, "Queued", "Suspended until RayJobQueue %s admits the RayJob", rayJobInstance.Spec.RayJobQueueName)
+		}
 		// Set `Status.JobDeploymentStatus` to `JobDeploymentStatusInitializing`, and initialize `Status.JobId`
 		// and `Status.RayClusterName` prior to avoid duplicate job submissions and cluster creations.
 		logger.Info("JobDeploymentStatusNew", "RayJob", rayJobInstance.Name)
@@ -150,6 +180,20 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 		}
 
+		// If the RayJob runs on a shared RayCluster selected by `ClusterSelector`, it needs to hold one of the
+		// RayCluster's job slots before submitting the Ray job. Otherwise, transition the status to `Queued`.
+		if len(rayJobInstance.Spec.ClusterSelector) != 0 {
+			hasSlot, err := r.acquireRayClusterJobSlotIfNeeded(ctx, rayJobInstance, rayClusterInstance)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+			if !hasSlot {
+				logger.Info("The RayCluster has reached its maximum number of concurrent RayJobs. Transition the status to `Queued`.", "RayCluster", rayClusterInstance.Name)
+				rayJobInstance.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusQueued
+				break
+			}
+		}
+
 		// Check the current status of RayCluster before submitting.
 		if clientURL := rayJobInstance.Status.DashboardURL; clientURL == "" {
 			if rayClusterInstance.Status.State != rayv1.Ready {
@@ -173,6 +217,25 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		logger.Info("Both RayCluster and the submitter K8s Job are created. Transition the status from `Initializing` to `Running`.",
 			"RayJob", rayJobInstance.Name, "RayCluster", rayJobInstance.Status.RayClusterName)
 		rayJobInstance.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusRunning
+	case rayv1.JobDeploymentStatusQueued:
+		if shouldUpdate := r.checkActiveDeadlineAndUpdateStatusIfNeeded(ctx, rayJobInstance); shouldUpdate {
+			break
+		}
+
+		var rayClusterInstance *rayv1.RayCluster
+		if rayClusterInstance, err = r.getOrCreateRayClusterInstance(ctx, rayJobInstance); err != nil {
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+		}
+		hasSlot, err := r.acquireRayClusterJobSlotIfNeeded(ctx, rayJobInstance, rayClusterInstance)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+		}
+		if !hasSlot {
+			logger.Info("Wait for a free job slot on the RayCluster", "RayCluster", rayClusterInstance.Name)
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
+		}
+		logger.Info("The RayJob holds a job slot on the RayCluster. Transition the status from `Queued` to `Initializing`.", "RayCluster", rayClusterInstance.Name)
+		rayJobInstance.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusInitializing
 	case rayv1.JobDeploymentStatusRunning:
 		if shouldUpdate := r.updateStatusToSuspendingIfNeeded(ctx, rayJobInstance); shouldUpdate {
 			break
@@ -214,7 +277,12 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			// If the Ray job was not found, GetJobInfo returns a BadRequest error.
 			if rayJobInstance.Spec.SubmissionMode == rayv1.HTTPMode && errors.IsBadRequest(err) {
 				logger.Info("The Ray job was not found. Submit a Ray job via an HTTP request.", "JobId", rayJobInstance.Status.JobId)
-				if _, err := rayDashboardClient.SubmitJob(ctx, rayJobInstance); err != nil {
+				resolvedRayJob, _, err := r.resolveRuntimeEnvVars(ctx, rayJobInstance, false)
+				if err != nil {
+					logger.Error(err, "Failed to resolve the environment variables of RuntimeEnv")
+					return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+				}
+				if _, err := rayDashboardClient.SubmitJob(ctx, resolvedRayJob); err != nil {
 					logger.Error(err, "Failed to submit the Ray job", "JobId", rayJobInstance.Status.JobId)
 					return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 				}
@@ -295,8 +363,14 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
 	case rayv1.JobDeploymentStatusComplete, rayv1.JobDeploymentStatusFailed:
 		// If this RayJob uses an existing RayCluster (i.e., ClusterSelector is set), we should not delete the RayCluster.
-		logger.Info(string(rayJobInstance.Status.JobDeploymentStatus), "RayJob", rayJobInstance.Name, "ShutdownAfterJobFinishes", rayJobInstance.Spec.ShutdownAfterJobFinishes, "ClusterSelector", rayJobInstance.Spec.ClusterSelector)
-		if rayJobInstance.Spec.ShutdownAfterJobFinishes && len(rayJobInstance.Spec.ClusterSelector) == 0 {
+		// If this RayJob claimed a RayCluster from a RayClusterPool, the RayCluster is always deleted so that it is not reused.
+		if len(rayJobInstance.Spec.ClusterSelector) != 0 {
+			if err = r.releaseRayClusterJobSlot(ctx, rayJobInstance); err != nil {
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+		}
+		logger.Info(string(rayJobInstance.Status.JobDeploymentStatus), "RayJob", rayJobInstance.Name, "ShutdownAfterJobFinishes", rayJobInstance.Spec.ShutdownAfterJobFinishes, "ClusterSelector", rayJobInstance.Spec.ClusterSelector, "RayClusterPoolName", rayJobInstance.Spec.RayClusterPoolName)
+		if (rayJobInstance.Spec.ShutdownAfterJobFinishes && len(rayJobInstance.Spec.ClusterSelector) == 0) || rayJobInstance.Spec.RayClusterPoolName != "" {
 			ttlSeconds := rayJobInstance.Spec.TTLSecondsAfterFinished
 			nowTime := time.Now()
 			shutdownTime := rayJobInstance.Status.EndTime.Add(time.Duration(ttlSeconds) * time.Second)
@@ -375,11 +449,17 @@ func (r *RayJobReconciler) getSubmitterTemplate(ctx context.Context, rayJobInsta
 
 	// If the command in the submitter pod template isn't set, use the default command.
 	if len(submitterTemplate.Spec.Containers[utils.RayContainerIndex].Command) == 0 {
-		k8sJobCommand, err := common.GetK8sJobCommand(rayJobInstance)
+		// The values read from Secrets are not written into the command, which anyone able to read the Job can see.
+		resolvedRayJob, secretEnvVars, err := r.resolveRuntimeEnvVars(ctx, rayJobInstance, true)
+		if err != nil {
+			return corev1.PodTemplateSpec{}, err
+		}
+		k8sJobCommand, err := common.GetK8sJobCommand(resolvedRayJob)
 		if err != nil {
 			return corev1.PodTemplateSpec{}, err
 		}
 		submitterTemplate.Spec.Containers[utils.RayContainerIndex].Command = k8sJobCommand
+		submitterTemplate.Spec.Containers[utils.RayContainerIndex].Env = append(submitterTemplate.Spec.Containers[utils.RayContainerIndex].Env, secretEnvVars...)
 		logger.Info("No command is specified in the user-provided template. Default command is used", "command", k8sJobCommand)
 	} else {
 		logger.Info("User-provided command is used", "command", submitterTemplate.Spec.Containers[utils.RayContainerIndex].Command)
@@ -406,6 +486,78 @@ func (r *RayJobReconciler) getSubmitterTemplate(ctx context.Context, rayJobInsta
 	return submitterTemplate, nil
 }
 
+// resolveRuntimeEnvVars returns a copy of the RayJob in which the environment variables of RuntimeEnv that reference
+// Secrets or ConfigMaps are replaced with their values. A missing optional reference leaves the variable unset.
+// If secretsAsEnvVars is true, the values read from Secrets are replaced with references to the returned environment
+// variables of the submitter container instead, which Kubernetes expands when the container starts.
+func (r *RayJobReconciler) resolveRuntimeEnvVars(ctx context.Context, rayJobInstance *rayv1.RayJob, secretsAsEnvVars bool) (*rayv1.RayJob, []corev1.EnvVar, error) {
+	if rayJobInstance.Spec.RuntimeEnv == nil {
+		return rayJobInstance, nil, nil
+	}
+	resolvedRayJob := rayJobInstance.DeepCopy()
+	envVars := make([]rayv1.RuntimeEnvVar, 0, len(resolvedRayJob.Spec.RuntimeEnv.EnvVars))
+	var secretEnvVars []corev1.EnvVar
+	for _, envVar := range resolvedRayJob.Spec.RuntimeEnv.EnvVars {
+		if envVar.ValueFrom == nil {
+			envVars = append(envVars, envVar)
+			continue
+		}
+		var value string
+		var found bool
+		var optional *bool
+		if ref := envVar.ValueFrom.SecretKeyRef; ref != nil {
+			optional = ref.Optional
+			secret := &corev1.Secret{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: rayJobInstance.Namespace, Name: ref.Name}, secret); err == nil {
+				var data []byte
+				data, found = secret.Data[ref.Key]
+				value = string(data)
+			} else if !errors.IsNotFound(err) {
+				return nil, nil, err
+			}
+			if found && secretsAsEnvVars {
+				// The expanded value ends up in the JSON of the runtime environment as is.
+				if !isJSONStringSafe(value) {
+					return nil, nil, fmt.Errorf("the value of the environment variable %s in RuntimeEnv cannot be passed to the submitter because it contains quotes, backslashes or control characters; use the HTTPMode submission mode instead", envVar.Name)
+				}
+				secretEnvVarName := fmt.Sprintf("%s%d", utils.KUBERAY_RUNTIME_ENV_SECRET_PREFIX, len(secretEnvVars))
+				secretEnvVars = append(secretEnvVars, corev1.EnvVar{
+					Name:      secretEnvVarName,
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: ref.DeepCopy()},
+				})
+				value = fmt.Sprintf("$(%s)", secretEnvVarName)
+			}
+		} else if ref := envVar.ValueFrom.ConfigMapKeyRef; ref != nil {
+			optional = ref.Optional
+			configMap := &corev1.ConfigMap{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: rayJobInstance.Namespace, Name: ref.Name}, configMap); err == nil {
+				value, found = configMap.Data[ref.Key]
+			} else if !errors.IsNotFound(err) {
+				return nil, nil, err
+			}
+		}
+		if !found {
+			if optional != nil && *optional {
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to resolve the value of the environment variable %s in RuntimeEnv", envVar.Name)
+		}
+		envVars = append(envVars, rayv1.RuntimeEnvVar{Name: envVar.Name, Value: value})
+	}
+	resolvedRayJob.Spec.RuntimeEnv.EnvVars = envVars
+	return resolvedRayJob, secretEnvVars, nil
+}
+
+// isJSONStringSafe returns whether a value can be inserted into a JSON string without escaping.
+func isJSONStringSafe(value string) bool {
+	for _, c := range value {
+		if c == '"' || c == '\\' || c < 0x20 {
+			return false
+		}
+	}
+	return true
+}
+
 // createNewK8sJob creates a new Kubernetes Job. It returns an error.
 func (r *RayJobReconciler) createNewK8sJob(ctx context.Context, rayJobInstance *rayv1.RayJob, submitterTemplate corev1.PodTemplateSpec) error {
 	logger := ctrl.LoggerFrom(ctx)
@@ -486,6 +638,19 @@ func (r *RayJobReconciler) deleteSubmitterJob(ctx context.Context, rayJobInstanc
 func (r *RayJobReconciler) deleteClusterResources(ctx context.Context, rayJobInstance *rayv1.RayJob) (bool, error) {
 	logger := ctrl.LoggerFrom(ctx)
 	clusterIdentifier := common.RayJobRayClusterNamespacedName(rayJobInstance)
+	if rayJobInstance.Spec.RayClusterPoolName != "" && clusterIdentifier.Name == "" {
+		// The RayJob may have claimed a RayCluster from the pool without persisting its name in the status,
+		// e.g. if the RayJob failed before the RayCluster became ready.
+		claimedCluster, err := r.getClaimedRayCluster(ctx, rayJobInstance)
+		if err != nil {
+			return false, err
+		}
+		if claimedCluster == nil {
+			logger.Info("The RayJob has not claimed any RayCluster from the pool", "RayClusterPool", rayJobInstance.Spec.RayClusterPoolName)
+			return true, nil
+		}
+		clusterIdentifier.Name = claimedCluster.Name
+	}
 
 	var isClusterDeleted bool
 	cluster := rayv1.RayCluster{}
@@ -506,7 +671,7 @@ func (r *RayJobReconciler) deleteClusterResources(ctx context.Context, rayJobIns
 				return false, err
 			}
 			logger.Info("The associated cluster is deleted", "RayCluster", clusterIdentifier)
-			r.Recorder.Eventf(rayJobInstance, corev1.EventTypeNormal, "Deleted", "Deleted cluster %s", rayJobInstance.Status.RayClusterName)
+			r.Recorder.Eventf(rayJobInstance, corev1.EventTypeNormal, "Deleted", "Deleted cluster %s", clusterIdentifier.Name)
 		}
 	}
 
@@ -553,7 +718,9 @@ func (r *RayJobReconciler) initRayJobStatusIfNeed(ctx context.Context, rayJob *r
 		}
 	}
 
-	if rayJob.Status.RayClusterName == "" {
+	// If the RayJob uses a RayClusterPool, `Status.RayClusterName` is set when the RayJob claims a RayCluster
+	// from the pool in `getOrCreateRayClusterInstance`.
+	if rayJob.Status.RayClusterName == "" && rayJob.Spec.RayClusterPoolName == "" {
 		// if the clusterSelector is not empty, default use this cluster name
 		// we assume the length of clusterSelector is one
 		if len(rayJob.Spec.ClusterSelector) != 0 {
@@ -601,6 +768,10 @@ func (r *RayJobReconciler) updateRayJobStatus(ctx context.Context, oldRayJob *ra
 
 func (r *RayJobReconciler) getOrCreateRayClusterInstance(ctx context.Context, rayJobInstance *rayv1.RayJob) (*rayv1.RayCluster, error) {
 	logger := ctrl.LoggerFrom(ctx)
+	if rayJobInstance.Spec.RayClusterPoolName != "" {
+		return r.getOrClaimRayClusterFromPool(ctx, rayJobInstance)
+	}
+
 	rayClusterNamespacedName := common.RayJobRayClusterNamespacedName(rayJobInstance)
 	logger.Info("try to find existing RayCluster instance", "name", rayClusterNamespacedName.Name)
 
@@ -640,6 +811,99 @@ func (r *RayJobReconciler) getOrCreateRayClusterInstance(ctx context.Context, ra
 	return rayClusterInstance, nil
 }
 
+// getOrClaimRayClusterFromPool returns the RayCluster that the RayJob has claimed from its RayClusterPool. If the RayJob
+// has not claimed one yet, it claims an idle RayCluster by handing over the controller reference from the pool to the RayJob.
+// The update is rejected if another RayJob has claimed the same RayCluster concurrently, so a RayCluster is never shared.
+func (r *RayJobReconciler) getOrClaimRayClusterFromPool(ctx context.Context, rayJobInstance *rayv1.RayJob) (*rayv1.RayCluster, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	claimedCluster, err := r.getClaimedRayCluster(ctx, rayJobInstance)
+	if err != nil {
+		return nil, err
+	}
+	if claimedCluster != nil {
+		rayJobInstance.Status.RayClusterName = claimedCluster.Name
+		return claimedCluster, nil
+	}
+	if rayJobInstance.Status.RayClusterName != "" {
+		return nil, fmt.Errorf("the RayCluster %s claimed from RayClusterPool %s is not found", rayJobInstance.Status.RayClusterName, rayJobInstance.Spec.RayClusterPoolName)
+	}
+
+	rayClusterPool := &rayv1.RayClusterPool{}
+	if err := r.Get(ctx, common.RayJobRayClusterPoolNamespacedName(rayJobInstance), rayClusterPool); err != nil {
+		logger.Error(err, "Failed to get RayClusterPool", "RayClusterPool", rayJobInstance.Spec.RayClusterPoolName)
+		return nil, err
+	}
+	rayClusterList := rayv1.RayClusterList{}
+	if err := r.List(ctx, &rayClusterList, common.RayClusterPoolRayClusterListOptions(rayClusterPool.Namespace, rayClusterPool.Name)...); err != nil {
+		return nil, err
+	}
+	// Prefer the RayClusters that are already ready.
+	candidates := make([]*rayv1.RayCluster, 0, len(rayClusterList.Items))
+	for i := range rayClusterList.Items {
+		cluster := &rayClusterList.Items[i]
+		if cluster.DeletionTimestamp.IsZero() && metav1.IsControlledBy(cluster, rayClusterPool) {
+			candidates = append(candidates, cluster)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Status.State == rayv1.Ready && candidates[j].Status.State != rayv1.Ready
+	})
+
+	for _, cluster := range candidates {
+		if err := r.claimRayCluster(rayJobInstance, rayClusterPool, cluster); err != nil {
+			return nil, err
+		}
+		if err := r.Update(ctx, cluster); err != nil {
+			if errors.IsConflict(err) {
+				logger.Info("The RayCluster has been modified, possibly claimed by another RayJob. Try the next one.", "RayCluster", cluster.Name)
+				continue
+			}
+			logger.Error(err, "Failed to claim RayCluster", "RayCluster", cluster.Name)
+			return nil, err
+		}
+		logger.Info("Claimed RayCluster from RayClusterPool", "RayCluster", cluster.Name, "RayClusterPool", rayClusterPool.Name)
+		r.Recorder.Eventf(rayJobInstance, corev1.EventTypeNormal, "Claimed", "Claimed RayCluster %s from RayClusterPool %s", cluster.Name, rayClusterPool.Name)
+		rayJobInstance.Status.RayClusterName = cluster.Name
+		return cluster, nil
+	}
+	return nil, fmt.Errorf("no idle RayCluster is available in RayClusterPool %s", rayClusterPool.Name)
+}
+
+// getClaimedRayCluster returns the RayCluster that the RayJob has claimed from its RayClusterPool, or nil if there is none.
+func (r *RayJobReconciler) getClaimedRayCluster(ctx context.Context, rayJobInstance *rayv1.RayJob) (*rayv1.RayCluster, error) {
+	rayClusterList := rayv1.RayClusterList{}
+	if err := r.List(ctx, &rayClusterList, common.RayClusterPoolRayClusterListOptions(rayJobInstance.Namespace, rayJobInstance.Spec.RayClusterPoolName)...); err != nil {
+		return nil, err
+	}
+	for i := range rayClusterList.Items {
+		if metav1.IsControlledBy(&rayClusterList.Items[i], rayJobInstance) {
+			return &rayClusterList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// claimRayCluster hands over the ownership of an idle RayCluster from the RayClusterPool to the RayJob. The RayCluster
+// keeps the `ray.io/cluster-pool` label, and the originated-from labels are updated to point to the RayJob.
+func (r *RayJobReconciler) claimRayCluster(rayJobInstance *rayv1.RayJob, rayClusterPool *rayv1.RayClusterPool, cluster *rayv1.RayCluster) error {
+	ownerReferences := make([]metav1.OwnerReference, 0, len(cluster.OwnerReferences))
+	for _, ref := range cluster.OwnerReferences {
+		if ref.UID != rayClusterPool.UID {
+			ownerReferences = append(ownerReferences, ref)
+		}
+	}
+	cluster.OwnerReferences = ownerReferences
+	if err := ctrl.SetControllerReference(rayJobInstance, cluster, r.Scheme); err != nil {
+		return err
+	}
+	if cluster.Labels == nil {
+		cluster.Labels = make(map[string]string)
+	}
+	cluster.Labels[utils.RayOriginatedFromCRNameLabelKey] = rayJobInstance.Name
+	cluster.Labels[utils.RayOriginatedFromCRDLabelKey] = utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD)
+	return nil
+}
+
 func (r *RayJobReconciler) constructRayClusterForRayJob(rayJobInstance *rayv1.RayJob, rayClusterName string) (*rayv1.RayCluster, error) {
 	labels := make(map[string]string, len(rayJobInstance.Labels))
 	for key, value := range rayJobInstance.Labels {
@@ -665,6 +929,111 @@ func (r *RayJobReconciler) constructRayClusterForRayJob(rayJobInstance *rayv1.Ra
 	return rayCluster, nil
 }
 
+// acquireRayClusterJobSlotIfNeeded returns whether the RayJob holds a job slot on the RayCluster selected by `ClusterSelector`.
+// If the RayCluster doesn't have the `ray.io/max-concurrent-jobs` annotation, the number of RayJobs is not limited. Otherwise,
+// the RayJob acquires a slot by adding its name to the `ray.io/job-slots` annotation. Slots held by RayJobs that have been
+// deleted or have finished are reclaimed. The RayCluster is updated with optimistic concurrency control, so two reconciliations
+// based on the same resourceVersion cannot both acquire the last slot.
+func (r *RayJobReconciler) acquireRayClusterJobSlotIfNeeded(ctx context.Context, rayJob *rayv1.RayJob, rayCluster *rayv1.RayCluster) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	value, ok := rayCluster.Annotations[utils.RayClusterMaxConcurrentJobsAnnotationKey]
+	if !ok {
+		return true, nil
+	}
+	maxConcurrentJobs, err := strconv.Atoi(value)
+	if err != nil || maxConcurrentJobs < 0 {
+		return false, fmt.Errorf("the value of the annotation %s on RayCluster %s must be a non-negative integer, got %q", utils.RayClusterMaxConcurrentJobsAnnotationKey, rayCluster.Name, value)
+	}
+
+	slotOwners := getRayClusterJobSlotOwners(rayCluster)
+	for _, owner := range slotOwners {
+		if owner == rayJob.Name {
+			return true, nil
+		}
+	}
+
+	activeOwners := make([]string, 0, len(slotOwners))
+	for _, owner := range slotOwners {
+		ownerRayJob := &rayv1.RayJob{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: rayCluster.Namespace, Name: owner}, ownerRayJob); err != nil {
+			if errors.IsNotFound(err) {
+				logger.Info("Reclaim the job slot held by a deleted RayJob", "RayCluster", rayCluster.Name, "RayJob", owner)
+				continue
+			}
+			return false, err
+		}
+		if ownerRayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusComplete || ownerRayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed {
+			logger.Info("Reclaim the job slot held by a finished RayJob", "RayCluster", rayCluster.Name, "RayJob", owner)
+			continue
+		}
+		activeOwners = append(activeOwners, owner)
+	}
+
+	hasSlot := len(activeOwners) < maxConcurrentJobs
+	if hasSlot {
+		activeOwners = append(activeOwners, rayJob.Name)
+	} else if len(activeOwners) == len(slotOwners) {
+		return false, nil
+	}
+	setRayClusterJobSlotOwners(rayCluster, activeOwners)
+	if err := r.Update(ctx, rayCluster); err != nil {
+		logger.Info("Failed to update the job slots of the RayCluster", "RayCluster", rayCluster.Name, "error", err)
+		return false, err
+	}
+	if hasSlot {
+		r.Recorder.Eventf(rayJob, corev1.EventTypeNormal, "AcquiredJobSlot", "Acquired a job slot on RayCluster %s", rayCluster.Name)
+	}
+	return hasSlot, nil
+}
+
+// releaseRayClusterJobSlot removes the RayJob from the job slots of the RayCluster selected by `ClusterSelector`.
+func (r *RayJobReconciler) releaseRayClusterJobSlot(ctx context.Context, rayJob *rayv1.RayJob) error {
+	logger := ctrl.LoggerFrom(ctx)
+	if rayJob.Status.RayClusterName == "" {
+		return nil
+	}
+	rayCluster := &rayv1.RayCluster{}
+	if err := r.Get(ctx, common.RayJobRayClusterNamespacedName(rayJob), rayCluster); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	slotOwners := getRayClusterJobSlotOwners(rayCluster)
+	remainingOwners := make([]string, 0, len(slotOwners))
+	for _, owner := range slotOwners {
+		if owner != rayJob.Name {
+			remainingOwners = append(remainingOwners, owner)
+		}
+	}
+	if len(remainingOwners) == len(slotOwners) {
+		return nil
+	}
+	setRayClusterJobSlotOwners(rayCluster, remainingOwners)
+	if err := r.Update(ctx, rayCluster); err != nil {
+		return err
+	}
+	logger.Info("Released the job slot on the RayCluster", "RayCluster", rayCluster.Name)
+	r.Recorder.Eventf(rayJob, corev1.EventTypeNormal, "ReleasedJobSlot", "Released the job slot on RayCluster %s", rayCluster.Name)
+	return nil
+}
+
+func getRayClusterJobSlotOwners(rayCluster *rayv1.RayCluster) []string {
+	value := rayCluster.Annotations[utils.RayClusterJobSlotsAnnotationKey]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func setRayClusterJobSlotOwners(rayCluster *rayv1.RayCluster, owners []string) {
+	if rayCluster.Annotations == nil {
+		rayCluster.Annotations = make(map[string]string)
+	}
+	rayCluster.Annotations[utils.RayClusterJobSlotsAnnotationKey] = strings.Join(owners, ",")
+}
+
 func (r *RayJobReconciler) updateStatusToSuspendingIfNeeded(ctx context.Context, rayJob *rayv1.RayJob) bool {
 	logger := ctrl.LoggerFrom(ctx)
 	if !rayJob.Spec.Suspend {
@@ -727,16 +1096,64 @@ func validateRayJobSpec(rayJob *rayv1.RayJob) error {
 	if rayJob.Spec.Suspend && len(rayJob.Spec.ClusterSelector) != 0 {
 		return fmt.Errorf("the ClusterSelector mode doesn't support the suspend operation")
 	}
-	if rayJob.Spec.RayClusterSpec == nil && len(rayJob.Spec.ClusterSelector) == 0 {
-		return fmt.Errorf("one of RayClusterSpec or ClusterSelector must be set")
+	if rayJob.Spec.Suspend && rayJob.Spec.RayClusterPoolName != "" {
+		return fmt.Errorf("the RayClusterPool mode doesn't support the suspend operation")
+	}
+	if rayJob.Spec.RayClusterPoolName != "" && (rayJob.Spec.RayClusterSpec != nil || len(rayJob.Spec.ClusterSelector) != 0) {
+		return fmt.Errorf("RayClusterPoolName cannot be set together with RayClusterSpec or ClusterSelector")
+	}
+	if rayJob.Spec.RayJobQueueName != "" && (len(rayJob.Spec.ClusterSelector) != 0 || rayJob.Spec.RayClusterPoolName != "") {
+		return fmt.Errorf("RayJobQueueName cannot be set together with ClusterSelector or RayClusterPoolName because the RayJobQueue suspends the RayJob")
+	}
+	if rayJob.Spec.RayJobQueueName != "" && !rayJob.Spec.ShutdownAfterJobFinishes {
+		return fmt.Errorf("a RayJob with RayJobQueueName set must set shutdownAfterJobFinishes to true because the RayJobQueue suspends the RayJob")
+	}
+	if rayJob.Spec.RayClusterSpec == nil && len(rayJob.Spec.ClusterSelector) == 0 && rayJob.Spec.RayClusterPoolName == "" {
+		return fmt.Errorf("one of RayClusterSpec, ClusterSelector, or RayClusterPoolName must be set")
 	}
 	// Validate whether RuntimeEnvYAML is a valid YAML string. Note that this only checks its validity
 	// as a YAML string, not its adherence to the runtime environment schema.
 	if _, err := utils.UnmarshalRuntimeEnvYAML(rayJob.Spec.RuntimeEnvYAML); err != nil {
 		return err
 	}
+	if rayJob.Spec.RuntimeEnv != nil {
+		if len(rayJob.Spec.RuntimeEnvYAML) != 0 {
+			return fmt.Errorf("RuntimeEnv and RuntimeEnvYAML cannot be set at the same time")
+		}
+		if err := validateRuntimeEnv(rayJob.Spec.RuntimeEnv); err != nil {
+			return err
+		}
+	}
 	if rayJob.Spec.ActiveDeadlineSeconds != nil && *rayJob.Spec.ActiveDeadlineSeconds <= 0 {
 		return fmt.Errorf("activeDeadlineSeconds must be a positive integer")
 	}
 	return nil
 }
+
+func validateRuntimeEnv(runtimeEnv *rayv1.RuntimeEnv) error {
+	if conda := runtimeEnv.Conda; conda != nil {
+		if len(runtimeEnv.Pip) != 0 {
+			return fmt.Errorf("RuntimeEnv cannot set both pip and conda")
+		}
+		if conda.Name != "" && (len(conda.Channels) != 0 || len(conda.Dependencies) != 0) {
+			return fmt.Errorf("the conda environment of RuntimeEnv cannot set name together with channels or dependencies")
+		}
+	}
+	names := make(map[string]struct{}, len(runtimeEnv.EnvVars))
+	for _, envVar := range runtimeEnv.EnvVars {
+		if _, ok := names[envVar.Name]; ok {
+			return fmt.Errorf("the environment variable %s is duplicated in RuntimeEnv", envVar.Name)
+		}
+		names[envVar.Name] = struct{}{}
+		if envVar.ValueFrom == nil {
+			continue
+		}
+		if envVar.Value != "" {
+			return fmt.Errorf("the environment variable %s in RuntimeEnv cannot set both value and valueFrom", envVar.Name)
+		}
+		if (envVar.ValueFrom.SecretKeyRef == nil) == (envVar.ValueFrom.ConfigMapKeyRef == nil) {
+			return fmt.Errorf("the valueFrom of the environment variable %s in RuntimeEnv must set exactly one of secretKeyRef and configMapKeyRef", envVar.Name)
+		}
+	}
+	return nil
+}
@@ -2,9 +2,11 @@ package ray
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	utils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	"github.com/stretchr/testify/assert"
 	batchv1 "k8s.io/api/batch/v1"
@@ -13,6 +15,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -360,4 +364,361 @@ func TestValidateRayJobSpec(t *testing.T) {
 		},
 	})
 	assert.Error(t, err, "The RayJob is invalid because the runtimeEnvYAML is invalid.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RuntimeEnvYAML: "pip: [numpy]",
+			RuntimeEnv:     &rayv1.RuntimeEnv{Pip: []string{"numpy"}},
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because RuntimeEnv and RuntimeEnvYAML are both set.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RuntimeEnv: &rayv1.RuntimeEnv{
+				EnvVars: []rayv1.RuntimeEnvVar{{
+					Name:  "TOKEN",
+					Value: "token",
+					ValueFrom: &rayv1.RuntimeEnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "token"},
+					},
+				}},
+			},
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because the environment variable sets both value and valueFrom.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RuntimeEnv: &rayv1.RuntimeEnv{
+				Pip:   []string{"numpy"},
+				Conda: &rayv1.RuntimeEnvConda{Name: "pytorch"},
+			},
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because RuntimeEnv sets both pip and conda.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayClusterPoolName: "pool",
+		},
+	})
+	assert.NoError(t, err, "The RayJob is valid.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayClusterPoolName: "pool",
+			RayClusterSpec:     &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because RayClusterPoolName and RayClusterSpec are both set.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			Suspend:                  true,
+			ShutdownAfterJobFinishes: true,
+			RayClusterPoolName:       "pool",
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because the RayClusterPool mode doesn't support the suspend operation.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayJobQueueName:          "queue",
+			ShutdownAfterJobFinishes: true,
+			RayClusterSpec:           &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.NoError(t, err, "The RayJob is valid.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayJobQueueName: "queue",
+			RayClusterSpec:  &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because a RayJob in a RayJobQueue must set shutdownAfterJobFinishes to true.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayJobQueueName:          "queue",
+			ShutdownAfterJobFinishes: true,
+			ClusterSelector: map[string]string{
+				"key": "value",
+			},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because the ClusterSelector mode doesn't support RayJobQueue.")
+}
+
+func TestGetOrClaimRayClusterFromPool(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pool",
+			Namespace: namespace,
+			UID:       "pool-uid",
+		},
+	}
+	newPoolCluster := func(name string, state rayv1.ClusterState) *rayv1.RayCluster {
+		cluster := &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					utils.RayClusterPoolLabelKey: rayClusterPool.Name,
+				},
+			},
+			Status: rayv1.RayClusterStatus{State: state},
+		}
+		_ = ctrl.SetControllerReference(rayClusterPool, cluster, newScheme)
+		return cluster
+	}
+	newRayJob := func(name string, uid types.UID) *rayv1.RayJob {
+		return &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				UID:       uid,
+			},
+			Spec: rayv1.RayJobSpec{
+				RayClusterPoolName: rayClusterPool.Name,
+			},
+		}
+	}
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayClusterPool, newPoolCluster("cluster-not-ready", ""), newPoolCluster("cluster-ready", rayv1.Ready)).
+		Build()
+	ctx := context.Background()
+	rayJobReconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+	}
+
+	// The first RayJob claims the ready RayCluster.
+	rayJob1 := newRayJob("rayjob-1", "rayjob-1-uid")
+	cluster, err := rayJobReconciler.getOrClaimRayClusterFromPool(ctx, rayJob1)
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster-ready", cluster.Name)
+	assert.Equal(t, "cluster-ready", rayJob1.Status.RayClusterName)
+
+	claimedCluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "cluster-ready", Namespace: namespace}, claimedCluster)
+	assert.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(claimedCluster, rayJob1))
+	assert.False(t, metav1.IsControlledBy(claimedCluster, rayClusterPool))
+	assert.Equal(t, rayJob1.Name, claimedCluster.Labels[utils.RayOriginatedFromCRNameLabelKey])
+	assert.Equal(t, utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD), claimedCluster.Labels[utils.RayOriginatedFromCRDLabelKey])
+	assert.Equal(t, rayClusterPool.Name, claimedCluster.Labels[utils.RayClusterPoolLabelKey])
+
+	// Reconciling the same RayJob again returns the RayCluster that it has already claimed,
+	// even if the RayCluster name was not persisted in the status.
+	rayJob1.Status.RayClusterName = ""
+	cluster, err = rayJobReconciler.getOrClaimRayClusterFromPool(ctx, rayJob1)
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster-ready", cluster.Name)
+
+	// The second RayJob claims the remaining RayCluster even though it is not ready yet.
+	rayJob2 := newRayJob("rayjob-2", "rayjob-2-uid")
+	cluster, err = rayJobReconciler.getOrClaimRayClusterFromPool(ctx, rayJob2)
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster-not-ready", cluster.Name)
+
+	// There is no idle RayCluster left in the pool.
+	rayJob3 := newRayJob("rayjob-3", "rayjob-3-uid")
+	_, err = rayJobReconciler.getOrClaimRayClusterFromPool(ctx, rayJob3)
+	assert.Error(t, err)
+	assert.Equal(t, "", rayJob3.Status.RayClusterName)
+}
+
+func TestAcquireAndReleaseRayClusterJobSlot(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shared-raycluster",
+			Namespace: namespace,
+			Annotations: map[string]string{
+				utils.RayClusterMaxConcurrentJobsAnnotationKey: "1",
+				// `finished-rayjob` has finished, and `deleted-rayjob` doesn't exist anymore. Both slots can be reclaimed.
+				utils.RayClusterJobSlotsAnnotationKey: "finished-rayjob,deleted-rayjob",
+			},
+		},
+	}
+	newRayJob := func(name string, jobDeploymentStatus rayv1.JobDeploymentStatus) *rayv1.RayJob {
+		return &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: rayv1.RayJobSpec{
+				ClusterSelector: map[string]string{RayJobDefaultClusterSelectorKey: rayCluster.Name},
+			},
+			Status: rayv1.RayJobStatus{
+				RayClusterName:      rayCluster.Name,
+				JobDeploymentStatus: jobDeploymentStatus,
+			},
+		}
+	}
+	finishedRayJob := newRayJob("finished-rayjob", rayv1.JobDeploymentStatusComplete)
+	rayJob1 := newRayJob("rayjob-1", rayv1.JobDeploymentStatusInitializing)
+	rayJob2 := newRayJob("rayjob-2", rayv1.JobDeploymentStatusInitializing)
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayCluster, finishedRayJob, rayJob1, rayJob2).
+		Build()
+	ctx := context.Background()
+	rayJobReconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+	}
+	getRayCluster := func() *rayv1.RayCluster {
+		cluster := &rayv1.RayCluster{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: rayCluster.Name, Namespace: namespace}, cluster)
+		assert.NoError(t, err)
+		return cluster
+	}
+
+	// rayjob-1 acquires the only slot after the stale slots are reclaimed.
+	hasSlot, err := rayJobReconciler.acquireRayClusterJobSlotIfNeeded(ctx, rayJob1, getRayCluster())
+	assert.NoError(t, err)
+	assert.True(t, hasSlot)
+	assert.Equal(t, "rayjob-1", getRayCluster().Annotations[utils.RayClusterJobSlotsAnnotationKey])
+
+	// Acquiring the slot again is idempotent.
+	hasSlot, err = rayJobReconciler.acquireRayClusterJobSlotIfNeeded(ctx, rayJob1, getRayCluster())
+	assert.NoError(t, err)
+	assert.True(t, hasSlot)
+
+	// rayjob-2 needs to wait until rayjob-1 releases the slot.
+	hasSlot, err = rayJobReconciler.acquireRayClusterJobSlotIfNeeded(ctx, rayJob2, getRayCluster())
+	assert.NoError(t, err)
+	assert.False(t, hasSlot)
+
+	// A reconciliation based on an outdated RayCluster cannot acquire the slot.
+	staleRayCluster := getRayCluster()
+	staleRayCluster.Annotations[utils.RayClusterJobSlotsAnnotationKey] = ""
+	staleRayCluster.ResourceVersion = "1"
+	hasSlot, err = rayJobReconciler.acquireRayClusterJobSlotIfNeeded(ctx, rayJob2, staleRayCluster)
+	assert.Error(t, err)
+	assert.False(t, hasSlot)
+
+	err = rayJobReconciler.releaseRayClusterJobSlot(ctx, rayJob1)
+	assert.NoError(t, err)
+	assert.Equal(t, "", getRayCluster().Annotations[utils.RayClusterJobSlotsAnnotationKey])
+
+	hasSlot, err = rayJobReconciler.acquireRayClusterJobSlotIfNeeded(ctx, rayJob2, getRayCluster())
+	assert.NoError(t, err)
+	assert.True(t, hasSlot)
+	assert.Equal(t, "rayjob-2", getRayCluster().Annotations[utils.RayClusterJobSlotsAnnotationKey])
+
+	// The number of RayJobs is not limited if the annotation is not set.
+	unlimitedRayCluster := getRayCluster()
+	delete(unlimitedRayCluster.Annotations, utils.RayClusterMaxConcurrentJobsAnnotationKey)
+	hasSlot, err = rayJobReconciler.acquireRayClusterJobSlotIfNeeded(ctx, rayJob1, unlimitedRayCluster)
+	assert.NoError(t, err)
+	assert.True(t, hasSlot)
+
+	// An invalid annotation value is rejected.
+	invalidRayCluster := getRayCluster()
+	invalidRayCluster.Annotations[utils.RayClusterMaxConcurrentJobsAnnotationKey] = "invalid"
+	_, err = rayJobReconciler.acquireRayClusterJobSlotIfNeeded(ctx, rayJob1, invalidRayCluster)
+	assert.Error(t, err)
+}
+
+func TestResolveRuntimeEnvVars(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: namespace},
+		Data:       map[string][]byte{"token": []byte("secret-token")},
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: namespace},
+		Data:       map[string]string{"level": "debug"},
+	}
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: namespace},
+		Spec: rayv1.RayJobSpec{
+			RuntimeEnv: &rayv1.RuntimeEnv{
+				EnvVars: []rayv1.RuntimeEnvVar{
+					{Name: "LITERAL", Value: "value"},
+					{Name: "TOKEN", ValueFrom: &rayv1.RuntimeEnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name}, Key: "token"},
+					}},
+					{Name: "LOG_LEVEL", ValueFrom: &rayv1.RuntimeEnvVarSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name}, Key: "level"},
+					}},
+					{Name: "OPTIONAL", ValueFrom: &rayv1.RuntimeEnvVarSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "key", Optional: pointer.Bool(true)},
+					}},
+				},
+			},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(secret, configMap).Build()
+	r := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+	resolvedRayJob, secretEnvVars, err := r.resolveRuntimeEnvVars(context.Background(), rayJob, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []rayv1.RuntimeEnvVar{
+		{Name: "LITERAL", Value: "value"},
+		{Name: "TOKEN", Value: "secret-token"},
+		{Name: "LOG_LEVEL", Value: "debug"},
+	}, resolvedRayJob.Spec.RuntimeEnv.EnvVars)
+	assert.Empty(t, secretEnvVars)
+	// The RayJob itself is not modified.
+	assert.NotNil(t, rayJob.Spec.RuntimeEnv.EnvVars[1].ValueFrom)
+
+	// The values read from Secrets are passed to the submitter through environment variables.
+	resolvedRayJob, secretEnvVars, err = r.resolveRuntimeEnvVars(context.Background(), rayJob, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []rayv1.RuntimeEnvVar{
+		{Name: "LITERAL", Value: "value"},
+		{Name: "TOKEN", Value: "$(KUBERAY_RUNTIME_ENV_SECRET_0)"},
+		{Name: "LOG_LEVEL", Value: "debug"},
+	}, resolvedRayJob.Spec.RuntimeEnv.EnvVars)
+	assert.Equal(t, []corev1.EnvVar{{
+		Name:      "KUBERAY_RUNTIME_ENV_SECRET_0",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: rayJob.Spec.RuntimeEnv.EnvVars[1].ValueFrom.SecretKeyRef},
+	}}, secretEnvVars)
+	k8sJobCommand, err := common.GetK8sJobCommand(resolvedRayJob)
+	assert.NoError(t, err)
+	assert.NotContains(t, strings.Join(k8sJobCommand, " "), "secret-token")
+
+	// A Secret value that would break the JSON of the runtime environment once expanded is rejected.
+	secret.Data["token"] = []byte(`secret"token`)
+	err = fakeClient.Update(context.Background(), secret)
+	assert.NoError(t, err)
+	_, _, err = r.resolveRuntimeEnvVars(context.Background(), rayJob, true)
+	assert.Error(t, err)
+	_, _, err = r.resolveRuntimeEnvVars(context.Background(), rayJob, false)
+	assert.NoError(t, err)
+
+	// A missing required reference is an error.
+	rayJob.Spec.RuntimeEnv.EnvVars[3].ValueFrom.ConfigMapKeyRef.Optional = nil
+	_, _, err = r.resolveRuntimeEnvVars(context.Background(), rayJob, false)
+	assert.Error(t, err)
 }
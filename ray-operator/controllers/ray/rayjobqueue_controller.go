@@ -0,0 +1,246 @@
+package ray
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const RayJobQueueDefaultRequeueDuration = 10 * time.Second
+
+// RayJobQueueReconciler reconciles a RayJobQueue object
+type RayJobQueueReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// NewRayJobQueueReconciler returns a new reconcile.Reconciler
+func NewRayJobQueueReconciler(ctx context.Context, mgr manager.Manager) *RayJobQueueReconciler {
+	return &RayJobQueueReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("rayjobqueue-controller"),
+	}
+}
+
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobqueues,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobqueues/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobqueues/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// [WARNING]: There MUST be a newline after kubebuilder markers.
+// Reconcile admits the RayJobs that the RayJob controller queued in a RayJobQueue. RayJobs are admitted in the order
+// of their `QueuePriority` and creation timestamp by setting `Spec.Suspend` to false, as long as the running RayJobs in
+// the queue stay within `MaxRunningJobs` and `MaxResources`. The RayJob controller handles the rest of the lifecycle
+// with its suspend/resume state machine, and RayJobs suspended by their users are not admitted again until resumed.
+func (r *RayJobQueueReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	rayJobQueue := &rayv1.RayJobQueue{}
+	if err := r.Get(ctx, request.NamespacedName, rayJobQueue); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("RayJobQueue resource not found. Ignoring since object must be deleted", "name", request.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get RayJobQueue")
+		return ctrl.Result{RequeueAfter: RayJobQueueDefaultRequeueDuration}, err
+	}
+
+	if !rayJobQueue.ObjectMeta.DeletionTimestamp.IsZero() {
+		logger.Info("RayJobQueue is being deleted", "DeletionTimestamp", rayJobQueue.ObjectMeta.DeletionTimestamp)
+		return ctrl.Result{}, nil
+	}
+
+	rayJobList := rayv1.RayJobList{}
+	if err := r.List(ctx, &rayJobList, client.InNamespace(rayJobQueue.Namespace)); err != nil {
+		logger.Error(err, "Failed to list RayJobs")
+		return ctrl.Result{RequeueAfter: RayJobQueueDefaultRequeueDuration}, err
+	}
+
+	var runningJobs int32
+	usedResources := corev1.ResourceList{}
+	pendingJobs := make([]*rayv1.RayJob, 0)
+	for i := range rayJobList.Items {
+		rayJob := &rayJobList.Items[i]
+		if rayJob.Spec.RayJobQueueName != rayJobQueue.Name || !rayJob.DeletionTimestamp.IsZero() || isRayJobFinished(rayJob) {
+			continue
+		}
+		switch {
+		case rayJob.Spec.Suspend && isRayJobAdmitted(rayJob):
+			// The user suspended the RayJob after it was admitted. Drop the admission so that the RayJob is held
+			// until the user resumes it, which queues it again.
+			delete(rayJob.Annotations, utils.RayJobQueueAdmittedAnnotationKey)
+			if err := r.Update(ctx, rayJob); err != nil {
+				logger.Error(err, "Failed to drop the admission of RayJob", "RayJob", rayJob.Name)
+				return ctrl.Result{RequeueAfter: RayJobQueueDefaultRequeueDuration}, err
+			}
+			logger.Info("RayJob was suspended after its admission", "RayJob", rayJob.Name)
+		case rayJob.Spec.Suspend && isRayJobQueued(rayJob):
+			pendingJobs = append(pendingJobs, rayJob)
+		case !rayJob.Spec.Suspend && isRayJobAdmitted(rayJob):
+			runningJobs++
+			usedResources = addResourceList(usedResources, getRayJobRequestedResources(rayJob))
+		}
+		// A RayJob that is suspended but not queued is held by its user. A RayJob that is neither suspended nor
+		// admitted has just been created or resumed, and the RayJob controller will queue it soon.
+	}
+
+	// Admit the pending RayJobs in the order of priority and creation. Stop at the first RayJob that doesn't fit
+	// so that a large RayJob is not starved by smaller RayJobs behind it.
+	sort.SliceStable(pendingJobs, func(i, j int) bool {
+		if pendingJobs[i].Spec.QueuePriority != pendingJobs[j].Spec.QueuePriority {
+			return pendingJobs[i].Spec.QueuePriority > pendingJobs[j].Spec.QueuePriority
+		}
+		if !pendingJobs[i].CreationTimestamp.Equal(&pendingJobs[j].CreationTimestamp) {
+			return pendingJobs[i].CreationTimestamp.Before(&pendingJobs[j].CreationTimestamp)
+		}
+		return pendingJobs[i].Name < pendingJobs[j].Name
+	})
+	admittedJobs := 0
+	for _, rayJob := range pendingJobs {
+		requestedResources := getRayJobRequestedResources(rayJob)
+		if rayJobQueue.Spec.MaxRunningJobs != nil && runningJobs >= *rayJobQueue.Spec.MaxRunningJobs {
+			logger.Info("The RayJobQueue has reached MaxRunningJobs", "MaxRunningJobs", *rayJobQueue.Spec.MaxRunningJobs)
+			break
+		}
+		if !fitsResourceList(addResourceList(usedResources, requestedResources), rayJobQueue.Spec.MaxResources) {
+			logger.Info("The RayJobQueue doesn't have enough resources for the next RayJob", "RayJob", rayJob.Name, "UsedResources", usedResources, "RequestedResources", requestedResources)
+			break
+		}
+
+		rayJob.Spec.Suspend = false
+		if rayJob.Annotations == nil {
+			rayJob.Annotations = make(map[string]string)
+		}
+		rayJob.Annotations[utils.RayJobQueueAdmittedAnnotationKey] = "true"
+		if err := r.Update(ctx, rayJob); err != nil {
+			logger.Error(err, "Failed to admit RayJob", "RayJob", rayJob.Name)
+			return ctrl.Result{RequeueAfter: RayJobQueueDefaultRequeueDuration}, err
+		}
+		logger.Info("Admitted RayJob", "RayJob", rayJob.Name, "QueuePriority", rayJob.Spec.QueuePriority)
+		r.Recorder.Eventf(rayJobQueue, corev1.EventTypeNormal, "Admitted", "Admitted RayJob %s", rayJob.Name)
+		r.Recorder.Eventf(rayJob, corev1.EventTypeNormal, "Admitted", "Admitted by RayJobQueue %s", rayJobQueue.Name)
+		runningJobs++
+		usedResources = addResourceList(usedResources, requestedResources)
+		admittedJobs++
+	}
+
+	newStatus := rayJobQueue.Status.DeepCopy()
+	newStatus.RunningJobs = runningJobs
+	newStatus.PendingJobs = int32(len(pendingJobs) - admittedJobs)
+	newStatus.UsedResources = usedResources
+	newStatus.ObservedGeneration = rayJobQueue.Generation
+	if err := r.updateRayJobQueueStatus(ctx, rayJobQueue, newStatus); err != nil {
+		logger.Info("Failed to update RayJobQueue status", "error", err)
+		return ctrl.Result{RequeueAfter: RayJobQueueDefaultRequeueDuration}, err
+	}
+	return ctrl.Result{RequeueAfter: RayJobQueueDefaultRequeueDuration}, nil
+}
+
+func (r *RayJobQueueReconciler) updateRayJobQueueStatus(ctx context.Context, rayJobQueue *rayv1.RayJobQueue, newStatus *rayv1.RayJobQueueStatus) error {
+	if newStatus.RunningJobs == rayJobQueue.Status.RunningJobs &&
+		newStatus.PendingJobs == rayJobQueue.Status.PendingJobs &&
+		equality.Semantic.DeepEqual(newStatus.UsedResources, rayJobQueue.Status.UsedResources) &&
+		newStatus.ObservedGeneration == rayJobQueue.Status.ObservedGeneration {
+		return nil
+	}
+	newStatus.LastUpdateTime = &metav1.Time{Time: time.Now()}
+	rayJobQueue.Status = *newStatus
+	return r.Status().Update(ctx, rayJobQueue)
+}
+
+func isRayJobFinished(rayJob *rayv1.RayJob) bool {
+	return rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusComplete || rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed
+}
+
+func isRayJobAdmitted(rayJob *rayv1.RayJob) bool {
+	return rayJob.Annotations[utils.RayJobQueueAdmittedAnnotationKey] == "true"
+}
+
+func isRayJobQueued(rayJob *rayv1.RayJob) bool {
+	return rayJob.Annotations[utils.RayJobQueueAdmittedAnnotationKey] == "false"
+}
+
+// getRayJobRequestedResources returns the resources requested by the RayCluster that the RayJob creates.
+func getRayJobRequestedResources(rayJob *rayv1.RayJob) corev1.ResourceList {
+	if rayJob.Spec.RayClusterSpec == nil {
+		return corev1.ResourceList{}
+	}
+	rayClusterSpec := rayJob.Spec.RayClusterSpec.DeepCopy()
+	for i := range rayClusterSpec.WorkerGroupSpecs {
+		if rayClusterSpec.WorkerGroupSpecs[i].Replicas == nil {
+			rayClusterSpec.WorkerGroupSpecs[i].Replicas = rayClusterSpec.WorkerGroupSpecs[i].MinReplicas
+		}
+		if rayClusterSpec.WorkerGroupSpecs[i].Replicas == nil {
+			var zero int32
+			rayClusterSpec.WorkerGroupSpecs[i].Replicas = &zero
+		}
+	}
+	return utils.CalculateDesiredResources(&rayv1.RayCluster{Spec: *rayClusterSpec})
+}
+
+func addResourceList(a corev1.ResourceList, b corev1.ResourceList) corev1.ResourceList {
+	sum := a.DeepCopy()
+	for name, quantity := range b {
+		if value, ok := sum[name]; ok {
+			value.Add(quantity)
+			sum[name] = value
+		} else {
+			sum[name] = quantity.DeepCopy()
+		}
+	}
+	return sum
+}
+
+// fitsResourceList returns whether every resource listed in `limits` is not exceeded by `resources`.
+func fitsResourceList(resources corev1.ResourceList, limits corev1.ResourceList) bool {
+	for name, limit := range limits {
+		if quantity, ok := resources[name]; ok && quantity.Cmp(limit) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RayJobQueueReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayv1.RayJobQueue{}).
+		Watches(&rayv1.RayJob{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			rayJob, ok := obj.(*rayv1.RayJob)
+			if !ok || rayJob.Spec.RayJobQueueName == "" {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: common.RayJobRayJobQueueNamespacedName(rayJob)}}
+		})).
+		WithOptions(controller.Options{
+			LogConstructor: func(request *reconcile.Request) logr.Logger {
+				logger := ctrl.Log.WithName("controllers").WithName("RayJobQueue")
+				if request != nil {
+					logger = logger.WithValues("RayJobQueue", request.NamespacedName)
+				}
+				return logger
+			},
+		}).
+		Complete(r)
+}
@@ -0,0 +1,231 @@
+package ray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRayJobQueueReconcile(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	now := time.Now()
+	rayJobQueue := &rayv1.RayJobQueue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-queue",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayJobQueueSpec{
+			MaxRunningJobs: pointer.Int32(2),
+			MaxResources: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("3"),
+			},
+		},
+	}
+	newRayJob := func(name string, priority int32, createdAt time.Time, cpu string) *rayv1.RayJob {
+		return &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.Time{Time: createdAt},
+				// The RayJob controller has queued the RayJob.
+				Annotations: map[string]string{utils.RayJobQueueAdmittedAnnotationKey: "false"},
+			},
+			Spec: rayv1.RayJobSpec{
+				RayJobQueueName:          rayJobQueue.Name,
+				QueuePriority:            priority,
+				ShutdownAfterJobFinishes: true,
+				Suspend:                  true,
+				RayClusterSpec: &rayv1.RayClusterSpec{
+					HeadGroupSpec: rayv1.HeadGroupSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Resources: corev1.ResourceRequirements{
+											Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Status: rayv1.RayJobStatus{
+				JobDeploymentStatus: rayv1.JobDeploymentStatusSuspended,
+			},
+		}
+	}
+
+	// `finished` has finished, so it doesn't count towards the limits of the queue.
+	finished := newRayJob("finished", 0, now.Add(-time.Hour), "1")
+	finished.Spec.Suspend = false
+	finished.Annotations = map[string]string{utils.RayJobQueueAdmittedAnnotationKey: "true"}
+	finished.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+	// `running` has been admitted and is still running.
+	running := newRayJob("running", 0, now.Add(-time.Minute), "1")
+	running.Spec.Suspend = false
+	running.Annotations = map[string]string{utils.RayJobQueueAdmittedAnnotationKey: "true"}
+	running.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusRunning
+	// `high-priority` is admitted first because it has the highest priority, even though it was created last.
+	highPriority := newRayJob("high-priority", 10, now.Add(2*time.Second), "1")
+	// `early` and `late` have the same priority, so `early` would be admitted next, but the queue reaches MaxRunningJobs.
+	early := newRayJob("early", 0, now, "1")
+	late := newRayJob("late", 0, now.Add(time.Second), "1")
+	// `other-queue` belongs to another queue.
+	otherQueue := newRayJob("other-queue", 100, now, "1")
+	otherQueue.Spec.RayJobQueueName = "other"
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayJobQueue, finished, running, highPriority, early, late, otherQueue).
+		WithStatusSubresource(rayJobQueue).
+		Build()
+	ctx := context.Background()
+	r := &RayJobQueueReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: rayJobQueue.Name, Namespace: namespace}}
+	getRayJob := func(name string) *rayv1.RayJob {
+		rayJob := &rayv1.RayJob{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, rayJob)
+		assert.NoError(t, err)
+		return rayJob
+	}
+	getQueue := func() *rayv1.RayJobQueue {
+		queue := &rayv1.RayJobQueue{}
+		err := fakeClient.Get(ctx, request.NamespacedName, queue)
+		assert.NoError(t, err)
+		return queue
+	}
+
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.False(t, getRayJob("high-priority").Spec.Suspend)
+	assert.True(t, isRayJobAdmitted(getRayJob("high-priority")))
+	assert.True(t, getRayJob("early").Spec.Suspend)
+	assert.True(t, getRayJob("late").Spec.Suspend)
+	assert.True(t, getRayJob("other-queue").Spec.Suspend)
+	queue := getQueue()
+	assert.Equal(t, int32(2), queue.Status.RunningJobs)
+	assert.Equal(t, int32(2), queue.Status.PendingJobs)
+	assert.True(t, queue.Status.UsedResources.Cpu().Equal(resource.MustParse("2")))
+
+	// After `running` finishes, `early` is admitted in FIFO order.
+	running = getRayJob("running")
+	running.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+	err = fakeClient.Update(ctx, running)
+	assert.NoError(t, err)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.False(t, getRayJob("early").Spec.Suspend)
+	assert.True(t, getRayJob("late").Spec.Suspend)
+	assert.Equal(t, int32(1), getQueue().Status.PendingJobs)
+
+	// Raising MaxRunningJobs doesn't admit `late` because the queue doesn't have enough CPUs.
+	queue = getQueue()
+	queue.Spec.MaxRunningJobs = pointer.Int32(10)
+	queue.Spec.MaxResources = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+	err = fakeClient.Update(ctx, queue)
+	assert.NoError(t, err)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.True(t, getRayJob("late").Spec.Suspend)
+}
+
+func TestRayJobQueueReconcileManuallySuspendedRayJob(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	rayJobQueue := &rayv1.RayJobQueue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-queue",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayJobQueueSpec{
+			MaxRunningJobs: pointer.Int32(1),
+		},
+	}
+	// `suspended` was admitted, and then the user suspended it.
+	suspended := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "suspended",
+			Namespace:   namespace,
+			Annotations: map[string]string{utils.RayJobQueueAdmittedAnnotationKey: "true"},
+		},
+		Spec: rayv1.RayJobSpec{
+			RayJobQueueName:          rayJobQueue.Name,
+			ShutdownAfterJobFinishes: true,
+			Suspend:                  true,
+		},
+		Status: rayv1.RayJobStatus{
+			JobDeploymentStatus: rayv1.JobDeploymentStatusSuspended,
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayJobQueue, suspended).
+		WithStatusSubresource(rayJobQueue).
+		Build()
+	ctx := context.Background()
+	r := &RayJobQueueReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: rayJobQueue.Name, Namespace: namespace}}
+	getRayJob := func() *rayv1.RayJob {
+		rayJob := &rayv1.RayJob{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: suspended.Name, Namespace: namespace}, rayJob)
+		assert.NoError(t, err)
+		return rayJob
+	}
+
+	// The admission is dropped, and the RayJob stays suspended even though the queue has capacity.
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(ctx, request)
+		assert.NoError(t, err)
+		rayJob := getRayJob()
+		assert.True(t, rayJob.Spec.Suspend)
+		assert.NotContains(t, rayJob.Annotations, utils.RayJobQueueAdmittedAnnotationKey)
+	}
+	queue := &rayv1.RayJobQueue{}
+	err := fakeClient.Get(ctx, request.NamespacedName, queue)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), queue.Status.RunningJobs)
+	assert.Equal(t, int32(0), queue.Status.PendingJobs)
+}
+
+func TestFitsResourceList(t *testing.T) {
+	limits := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("8Gi"),
+	}
+	assert.True(t, fitsResourceList(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}, limits))
+	assert.False(t, fitsResourceList(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4001m")}, limits))
+	// Resources that are not listed in the limits are not limited.
+	assert.True(t, fitsResourceList(corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")}, limits))
+	assert.True(t, fitsResourceList(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100")}, nil))
+}
@@ -0,0 +1,407 @@
+package ray
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const RayJobSetDefaultRequeueDuration = 10 * time.Second
+
+// RayJobSetReconciler reconciles a RayJobSet object
+type RayJobSetReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// NewRayJobSetReconciler returns a new reconcile.Reconciler
+func NewRayJobSetReconciler(ctx context.Context, mgr manager.Manager) *RayJobSetReconciler {
+	return &RayJobSetReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("rayjobset-controller"),
+	}
+}
+
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobsets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// [WARNING]: There MUST be a newline after kubebuilder markers.
+// Reconcile expands the parameters of a RayJobSet into RayJobs, creates them while respecting `Spec.Parallelism`,
+// and aggregates their results into the RayJobSet status.
+func (r *RayJobSetReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	rayJobSet := &rayv1.RayJobSet{}
+	if err := r.Get(ctx, request.NamespacedName, rayJobSet); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("RayJobSet resource not found. Ignoring since object must be deleted", "name", request.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get RayJobSet")
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+
+	if !rayJobSet.ObjectMeta.DeletionTimestamp.IsZero() {
+		logger.Info("RayJobSet is being deleted", "DeletionTimestamp", rayJobSet.ObjectMeta.DeletionTimestamp)
+		return ctrl.Result{}, nil
+	}
+
+	if err := validateRayJobSetSpec(rayJobSet); err != nil {
+		logger.Error(err, "The RayJobSet spec is invalid")
+		r.Recorder.Eventf(rayJobSet, corev1.EventTypeWarning, "InvalidSpec", "The RayJobSet spec is invalid: %v", err)
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+	parameterSets, err := expandRayJobSetParameters(rayJobSet.Spec.Parameters)
+	if err != nil {
+		logger.Error(err, "Failed to expand the parameters of the RayJobSet")
+		r.Recorder.Eventf(rayJobSet, corev1.EventTypeWarning, "InvalidSpec", "Failed to expand the parameters: %v", err)
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+
+	// Persist the name of the shared RayCluster before creating it to avoid creating duplicate RayClusters.
+	if rayJobSet.Spec.SharedCluster && rayJobSet.Status.SharedRayClusterName == "" {
+		rayJobSet.Status.SharedRayClusterName = utils.GenerateRayClusterName(rayJobSet.Name)
+		rayJobSet.Status.State = rayv1.RayJobSetRunning
+		if err := r.Status().Update(ctx, rayJobSet); err != nil {
+			logger.Info("Failed to update RayJobSet status", "error", err)
+			return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+		}
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, nil
+	}
+
+	rayJobList := rayv1.RayJobList{}
+	if err := r.List(ctx, &rayJobList, common.RayJobSetRayJobListOptions(rayJobSet)...); err != nil {
+		logger.Error(err, "Failed to list RayJobs of the RayJobSet")
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+	createdIndices := make(map[int]struct{}, len(rayJobList.Items))
+	newStatus := rayJobSet.Status.DeepCopy()
+	newStatus.Total = int32(len(parameterSets))
+	newStatus.Active, newStatus.Succeeded, newStatus.Failed = 0, 0, 0
+	for _, rayJob := range rayJobList.Items {
+		if !metav1.IsControlledBy(&rayJob, rayJobSet) {
+			continue
+		}
+		if index, err := strconv.Atoi(rayJob.Labels[utils.RayJobSetIndexLabelKey]); err == nil {
+			createdIndices[index] = struct{}{}
+		}
+		switch {
+		case !isRayJobFinished(&rayJob):
+			newStatus.Active++
+		case rayJob.Status.JobStatus == rayv1.JobStatusSucceeded:
+			newStatus.Succeeded++
+		default:
+			newStatus.Failed++
+		}
+	}
+
+	allFinished := newStatus.Succeeded+newStatus.Failed >= newStatus.Total
+	if !allFinished {
+		newStatus.State = rayv1.RayJobSetRunning
+		if rayJobSet.Spec.SharedCluster {
+			if err := r.createSharedRayClusterIfNeeded(ctx, rayJobSet); err != nil {
+				return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+			}
+		}
+		for index, parameters := range parameterSets {
+			if rayJobSet.Spec.Parallelism != nil && newStatus.Active >= *rayJobSet.Spec.Parallelism {
+				break
+			}
+			if _, ok := createdIndices[index]; ok {
+				continue
+			}
+			rayJob, err := r.constructRayJobForRayJobSet(rayJobSet, index, parameters)
+			if err != nil {
+				logger.Error(err, "unable to construct a new RayJob", "index", index)
+				r.Recorder.Eventf(rayJobSet, corev1.EventTypeWarning, "InvalidSpec", "Failed to render the RayJob template with parameters %v: %v", parameters, err)
+				return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+			}
+			if err := r.Create(ctx, rayJob); err != nil {
+				logger.Error(err, "unable to create RayJob for RayJobSet", "RayJob", rayJob.Name)
+				return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+			}
+			logger.Info("Created RayJob", "RayJob", rayJob.Name, "parameters", parameters)
+			r.Recorder.Eventf(rayJobSet, corev1.EventTypeNormal, "Created", "Created RayJob %s", rayJob.Name)
+			newStatus.Active++
+		}
+	} else {
+		if newStatus.State != rayv1.RayJobSetComplete {
+			r.Recorder.Eventf(rayJobSet, corev1.EventTypeNormal, "Completed", "All %d RayJobs have finished: %d succeeded, %d failed", newStatus.Total, newStatus.Succeeded, newStatus.Failed)
+		}
+		newStatus.State = rayv1.RayJobSetComplete
+		if rayJobSet.Spec.SharedCluster {
+			if err := r.deleteSharedRayClusterIfExists(ctx, rayJobSet); err != nil {
+				return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+			}
+		}
+	}
+
+	newStatus.ObservedGeneration = rayJobSet.Generation
+	if err := r.updateRayJobSetStatus(ctx, rayJobSet, newStatus); err != nil {
+		logger.Info("Failed to update RayJobSet status", "error", err)
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+	if allFinished {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, nil
+}
+
+func (r *RayJobSetReconciler) createSharedRayClusterIfNeeded(ctx context.Context, rayJobSet *rayv1.RayJobSet) error {
+	logger := ctrl.LoggerFrom(ctx)
+	rayCluster := &rayv1.RayCluster{}
+	namespacedName := common.RayJobSetSharedRayClusterNamespacedName(rayJobSet)
+	if err := r.Get(ctx, namespacedName, rayCluster); err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	labels := make(map[string]string, len(rayJobSet.Labels))
+	for key, value := range rayJobSet.Labels {
+		labels[key] = value
+	}
+	labels[utils.RayOriginatedFromCRNameLabelKey] = rayJobSet.Name
+	labels[utils.RayOriginatedFromCRDLabelKey] = utils.RayOriginatedFromCRDLabelValue(utils.RayJobSetCRD)
+	rayCluster = &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:    labels,
+			Name:      namespacedName.Name,
+			Namespace: namespacedName.Namespace,
+		},
+		Spec: *rayJobSet.Spec.Template.Spec.RayClusterSpec.DeepCopy(),
+	}
+	if err := ctrl.SetControllerReference(rayJobSet, rayCluster, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, rayCluster); err != nil {
+		logger.Error(err, "unable to create the shared RayCluster for RayJobSet", "RayCluster", rayCluster.Name)
+		return err
+	}
+	r.Recorder.Eventf(rayJobSet, corev1.EventTypeNormal, "Created", "Created RayCluster %s", rayCluster.Name)
+	return nil
+}
+
+func (r *RayJobSetReconciler) deleteSharedRayClusterIfExists(ctx context.Context, rayJobSet *rayv1.RayJobSet) error {
+	logger := ctrl.LoggerFrom(ctx)
+	rayCluster := &rayv1.RayCluster{}
+	if err := r.Get(ctx, common.RayJobSetSharedRayClusterNamespacedName(rayJobSet), rayCluster); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !rayCluster.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if err := r.Delete(ctx, rayCluster); err != nil {
+		logger.Error(err, "Failed to delete the shared RayCluster", "RayCluster", rayCluster.Name)
+		return err
+	}
+	logger.Info("All RayJobs have finished. The shared RayCluster is deleted", "RayCluster", rayCluster.Name)
+	r.Recorder.Eventf(rayJobSet, corev1.EventTypeNormal, "Deleted", "Deleted RayCluster %s", rayCluster.Name)
+	return nil
+}
+
+// constructRayJobForRayJobSet renders the RayJob template of the RayJobSet with a parameter set.
+func (r *RayJobSetReconciler) constructRayJobForRayJobSet(rayJobSet *rayv1.RayJobSet, index int, parameters map[string]string) (*rayv1.RayJob, error) {
+	var err error
+	spec := rayJobSet.Spec.Template.Spec.DeepCopy()
+	if spec.Entrypoint, err = renderRayJobSetTemplate(spec.Entrypoint, parameters); err != nil {
+		return nil, err
+	}
+	if spec.RuntimeEnvYAML, err = renderRayJobSetTemplate(spec.RuntimeEnvYAML, parameters); err != nil {
+		return nil, err
+	}
+	for key, value := range spec.Metadata {
+		if spec.Metadata[key], err = renderRayJobSetTemplate(value, parameters); err != nil {
+			return nil, err
+		}
+	}
+	if spec.RuntimeEnv != nil {
+		for i := range spec.RuntimeEnv.EnvVars {
+			if spec.RuntimeEnv.EnvVars[i].Value, err = renderRayJobSetTemplate(spec.RuntimeEnv.EnvVars[i].Value, parameters); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if rayJobSet.Spec.SharedCluster {
+		spec.RayClusterSpec = nil
+		spec.ClusterSelector = map[string]string{RayJobDefaultClusterSelectorKey: rayJobSet.Status.SharedRayClusterName}
+		spec.ShutdownAfterJobFinishes = false
+	}
+
+	labels := make(map[string]string, len(rayJobSet.Spec.Template.Labels))
+	for key, value := range rayJobSet.Spec.Template.Labels {
+		if labels[key], err = renderRayJobSetTemplate(value, parameters); err != nil {
+			return nil, err
+		}
+	}
+	labels[utils.RayOriginatedFromCRNameLabelKey] = rayJobSet.Name
+	labels[utils.RayOriginatedFromCRDLabelKey] = utils.RayOriginatedFromCRDLabelValue(utils.RayJobSetCRD)
+	labels[utils.RayJobSetIndexLabelKey] = strconv.Itoa(index)
+	annotations := make(map[string]string, len(rayJobSet.Spec.Template.Annotations))
+	for key, value := range rayJobSet.Spec.Template.Annotations {
+		if annotations[key], err = renderRayJobSetTemplate(value, parameters); err != nil {
+			return nil, err
+		}
+	}
+	parametersJson, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+	annotations[utils.RayJobSetParametersAnnotationKey] = string(parametersJson)
+
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%d", rayJobSet.Name, index),
+			Namespace:   rayJobSet.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: *spec,
+	}
+	if err := ctrl.SetControllerReference(rayJobSet, rayJob, r.Scheme); err != nil {
+		return nil, err
+	}
+	return rayJob, nil
+}
+
+func (r *RayJobSetReconciler) updateRayJobSetStatus(ctx context.Context, rayJobSet *rayv1.RayJobSet, newStatus *rayv1.RayJobSetStatus) error {
+	oldStatus := rayJobSet.Status
+	if newStatus.State == oldStatus.State &&
+		newStatus.Total == oldStatus.Total &&
+		newStatus.Active == oldStatus.Active &&
+		newStatus.Succeeded == oldStatus.Succeeded &&
+		newStatus.Failed == oldStatus.Failed &&
+		newStatus.ObservedGeneration == oldStatus.ObservedGeneration {
+		return nil
+	}
+	newStatus.LastUpdateTime = &metav1.Time{Time: time.Now()}
+	rayJobSet.Status = *newStatus
+	return r.Status().Update(ctx, rayJobSet)
+}
+
+// expandRayJobSetParameters returns the parameter sets in a deterministic order. For a matrix, the parameter
+// names are sorted, and the values of the last parameter change the fastest.
+func expandRayJobSetParameters(parameters rayv1.RayJobSetParameters) ([]map[string]string, error) {
+	if len(parameters.List) != 0 && len(parameters.Matrix) != 0 {
+		return nil, fmt.Errorf("only one of parameters.list and parameters.matrix can be set")
+	}
+	if len(parameters.List) != 0 {
+		parameterSets := make([]map[string]string, 0, len(parameters.List))
+		for _, parameterSet := range parameters.List {
+			copied := make(map[string]string, len(parameterSet))
+			for name, value := range parameterSet {
+				copied[name] = value
+			}
+			parameterSets = append(parameterSets, copied)
+		}
+		return parameterSets, nil
+	}
+	if len(parameters.Matrix) == 0 {
+		return nil, fmt.Errorf("one of parameters.list and parameters.matrix must be set")
+	}
+
+	names := make([]string, 0, len(parameters.Matrix))
+	for name := range parameters.Matrix {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parameterSets := []map[string]string{{}}
+	for _, name := range names {
+		values := parameters.Matrix[name]
+		if len(values) == 0 {
+			return nil, fmt.Errorf("parameter %s in parameters.matrix has no values", name)
+		}
+		expanded := make([]map[string]string, 0, len(parameterSets)*len(values))
+		for _, parameterSet := range parameterSets {
+			for _, value := range values {
+				copied := make(map[string]string, len(parameterSet)+1)
+				for k, v := range parameterSet {
+					copied[k] = v
+				}
+				copied[name] = value
+				expanded = append(expanded, copied)
+			}
+		}
+		parameterSets = expanded
+	}
+	return parameterSets, nil
+}
+
+// renderRayJobSetTemplate renders a string that references parameters with the Go template syntax.
+// Referencing a parameter that is not in the parameter set is an error.
+func renderRayJobSetTemplate(text string, parameters map[string]string) (string, error) {
+	if text == "" {
+		return text, nil
+	}
+	tmpl, err := template.New("").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, parameters); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func validateRayJobSetSpec(rayJobSet *rayv1.RayJobSet) error {
+	templateSpec := rayJobSet.Spec.Template.Spec
+	if rayJobSet.Spec.SharedCluster {
+		if templateSpec.RayClusterSpec == nil {
+			return fmt.Errorf("template.spec.rayClusterSpec must be set if sharedCluster is true")
+		}
+		if len(templateSpec.ClusterSelector) != 0 || templateSpec.RayClusterPoolName != "" || templateSpec.RayJobQueueName != "" {
+			return fmt.Errorf("template.spec.clusterSelector, template.spec.rayClusterPoolName, and template.spec.rayJobQueueName cannot be set if sharedCluster is true")
+		}
+		if templateSpec.Suspend {
+			return fmt.Errorf("template.spec.suspend cannot be set if sharedCluster is true")
+		}
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RayJobSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayv1.RayJobSet{}).
+		Owns(&rayv1.RayJob{}).
+		Owns(&rayv1.RayCluster{}).
+		WithOptions(controller.Options{
+			LogConstructor: func(request *reconcile.Request) logr.Logger {
+				logger := ctrl.Log.WithName("controllers").WithName("RayJobSet")
+				if request != nil {
+					logger = logger.WithValues("RayJobSet", request.NamespacedName)
+				}
+				return logger
+			},
+		}).
+		Complete(r)
+}
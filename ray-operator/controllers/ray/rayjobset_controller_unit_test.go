@@ -0,0 +1,245 @@
+package ray
+
+import (
+	"context"
+	"testing"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestExpandRayJobSetParameters(t *testing.T) {
+	tests := map[string]struct {
+		parameters rayv1.RayJobSetParameters
+		expected   []map[string]string
+		expectErr  bool
+	}{
+		"list": {
+			parameters: rayv1.RayJobSetParameters{
+				List: []map[string]string{{"lr": "0.1"}, {"lr": "0.01"}},
+			},
+			expected: []map[string]string{{"lr": "0.1"}, {"lr": "0.01"}},
+		},
+		"cartesian product of the matrix": {
+			parameters: rayv1.RayJobSetParameters{
+				Matrix: map[string][]string{
+					"lr":         {"0.1", "0.01"},
+					"batch_size": {"32", "64"},
+				},
+			},
+			expected: []map[string]string{
+				{"batch_size": "32", "lr": "0.1"},
+				{"batch_size": "32", "lr": "0.01"},
+				{"batch_size": "64", "lr": "0.1"},
+				{"batch_size": "64", "lr": "0.01"},
+			},
+		},
+		"both list and matrix": {
+			parameters: rayv1.RayJobSetParameters{
+				List:   []map[string]string{{"lr": "0.1"}},
+				Matrix: map[string][]string{"lr": {"0.1"}},
+			},
+			expectErr: true,
+		},
+		"neither list nor matrix": {
+			parameters: rayv1.RayJobSetParameters{},
+			expectErr:  true,
+		},
+		"matrix parameter without values": {
+			parameters: rayv1.RayJobSetParameters{
+				Matrix: map[string][]string{"lr": {}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			parameterSets, err := expandRayJobSetParameters(tc.parameters)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, parameterSets)
+		})
+	}
+}
+
+func TestRenderRayJobSetTemplate(t *testing.T) {
+	parameters := map[string]string{"lr": "0.1"}
+	rendered, err := renderRayJobSetTemplate("python train.py --lr={{ .lr }}", parameters)
+	assert.NoError(t, err)
+	assert.Equal(t, "python train.py --lr=0.1", rendered)
+
+	rendered, err = renderRayJobSetTemplate("python train.py", parameters)
+	assert.NoError(t, err)
+	assert.Equal(t, "python train.py", rendered)
+
+	// Referencing an unknown parameter is an error.
+	_, err = renderRayJobSetTemplate("python train.py --epochs={{ .epochs }}", parameters)
+	assert.Error(t, err)
+}
+
+func TestRayJobSetReconcile(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	rayJobSet := &rayv1.RayJobSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sweep",
+			Namespace: namespace,
+			UID:       "sweep-uid",
+		},
+		Spec: rayv1.RayJobSetSpec{
+			Template: rayv1.RayJobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"lr": "{{ .lr }}"},
+				},
+				Spec: rayv1.RayJobSpec{
+					Entrypoint:     "python train.py --lr={{ .lr }}",
+					Metadata:       map[string]string{"lr": "{{ .lr }}"},
+					RuntimeEnvYAML: "env_vars:\n  LR: \"{{ .lr }}\"\n",
+					RayClusterSpec: &rayv1.RayClusterSpec{RayVersion: "2.9.0"},
+				},
+			},
+			Parameters: rayv1.RayJobSetParameters{
+				Matrix: map[string][]string{"lr": {"0.1", "0.01", "0.001"}},
+			},
+			Parallelism:   pointer.Int32(2),
+			SharedCluster: true,
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayJobSet).
+		WithStatusSubresource(rayJobSet).
+		Build()
+	ctx := context.Background()
+	r := &RayJobSetReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: rayJobSet.Name, Namespace: namespace}}
+	getRayJobSet := func() *rayv1.RayJobSet {
+		set := &rayv1.RayJobSet{}
+		err := fakeClient.Get(ctx, request.NamespacedName, set)
+		assert.NoError(t, err)
+		return set
+	}
+	listRayJobs := func() []rayv1.RayJob {
+		rayJobList := rayv1.RayJobList{}
+		err := fakeClient.List(ctx, &rayJobList, common.RayJobSetRayJobListOptions(rayJobSet)...)
+		assert.NoError(t, err)
+		return rayJobList.Items
+	}
+	finishRayJob := func(name string, jobStatus rayv1.JobStatus, jobDeploymentStatus rayv1.JobDeploymentStatus) {
+		rayJob := &rayv1.RayJob{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, rayJob)
+		assert.NoError(t, err)
+		rayJob.Status.JobStatus = jobStatus
+		rayJob.Status.JobDeploymentStatus = jobDeploymentStatus
+		err = fakeClient.Update(ctx, rayJob)
+		assert.NoError(t, err)
+	}
+
+	// The first reconciliation persists the name of the shared RayCluster.
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	sharedRayClusterName := getRayJobSet().Status.SharedRayClusterName
+	assert.NotEmpty(t, sharedRayClusterName)
+
+	// The shared RayCluster and the first 2 RayJobs are created.
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	sharedRayCluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: sharedRayClusterName, Namespace: namespace}, sharedRayCluster)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.9.0", sharedRayCluster.Spec.RayVersion)
+
+	rayJobs := listRayJobs()
+	assert.Len(t, rayJobs, 2)
+	rayJob := &rayv1.RayJob{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "sweep-0", Namespace: namespace}, rayJob)
+	assert.NoError(t, err)
+	assert.Equal(t, "python train.py --lr=0.1", rayJob.Spec.Entrypoint)
+	assert.Equal(t, "0.1", rayJob.Spec.Metadata["lr"])
+	assert.Equal(t, "env_vars:\n  LR: \"0.1\"\n", rayJob.Spec.RuntimeEnvYAML)
+	assert.Equal(t, "0.1", rayJob.Labels["lr"])
+	assert.Equal(t, "0", rayJob.Labels[utils.RayJobSetIndexLabelKey])
+	assert.Equal(t, `{"lr":"0.1"}`, rayJob.Annotations[utils.RayJobSetParametersAnnotationKey])
+	assert.Nil(t, rayJob.Spec.RayClusterSpec)
+	assert.Equal(t, map[string]string{RayJobDefaultClusterSelectorKey: sharedRayClusterName}, rayJob.Spec.ClusterSelector)
+	assert.Equal(t, int32(2), getRayJobSet().Status.Active)
+
+	// The third RayJob is created after one of the RayJobs finishes.
+	finishRayJob("sweep-0", rayv1.JobStatusSucceeded, rayv1.JobDeploymentStatusComplete)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Len(t, listRayJobs(), 3)
+	status := getRayJobSet().Status
+	assert.Equal(t, rayv1.RayJobSetRunning, status.State)
+	assert.Equal(t, int32(3), status.Total)
+	assert.Equal(t, int32(2), status.Active)
+	assert.Equal(t, int32(1), status.Succeeded)
+
+	// The RayJobSet completes after all RayJobs finish, and the shared RayCluster is deleted.
+	finishRayJob("sweep-1", rayv1.JobStatusFailed, rayv1.JobDeploymentStatusFailed)
+	finishRayJob("sweep-2", rayv1.JobStatusSucceeded, rayv1.JobDeploymentStatusComplete)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	status = getRayJobSet().Status
+	assert.Equal(t, rayv1.RayJobSetComplete, status.State)
+	assert.Equal(t, int32(0), status.Active)
+	assert.Equal(t, int32(2), status.Succeeded)
+	assert.Equal(t, int32(1), status.Failed)
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: sharedRayClusterName, Namespace: namespace}, sharedRayCluster)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestValidateRayJobSetSpec(t *testing.T) {
+	err := validateRayJobSetSpec(&rayv1.RayJobSet{
+		Spec: rayv1.RayJobSetSpec{SharedCluster: true},
+	})
+	assert.Error(t, err, "The RayJobSet is invalid because the shared RayCluster has no RayClusterSpec.")
+
+	err = validateRayJobSetSpec(&rayv1.RayJobSet{
+		Spec: rayv1.RayJobSetSpec{
+			SharedCluster: true,
+			Template: rayv1.RayJobTemplateSpec{
+				Spec: rayv1.RayJobSpec{
+					RayClusterSpec:  &rayv1.RayClusterSpec{},
+					RayJobQueueName: "queue",
+				},
+			},
+		},
+	})
+	assert.Error(t, err, "The RayJobSet is invalid because the RayJobs on a shared RayCluster cannot be suspended by a RayJobQueue.")
+
+	err = validateRayJobSetSpec(&rayv1.RayJobSet{
+		Spec: rayv1.RayJobSetSpec{
+			SharedCluster: true,
+			Template: rayv1.RayJobTemplateSpec{
+				Spec: rayv1.RayJobSpec{
+					RayClusterSpec: &rayv1.RayClusterSpec{},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err, "The RayJobSet is valid.")
+}
@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
+	appsv1 "k8s.io/api/apps/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
@@ -22,10 +25,13 @@ import (
 	fmtErrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -40,9 +46,15 @@ import (
 )
 
 const (
+	// rolloutHistoryLimit is the maximum number of rollouts recorded in the rollout history of a RayService.
+	rolloutHistoryLimit = 10
+
 	ServiceDefaultRequeueDuration   = 2 * time.Second
 	RayClusterDeletionDelayDuration = 60 * time.Second
 	ENABLE_ZERO_DOWNTIME            = "ENABLE_ZERO_DOWNTIME"
+
+	// Definition of an index field for the name of the ConfigMap referenced by ServeConfigRef
+	serveConfigRefNameIndexField = "spec.serveConfigRef.name"
 )
 
 // RayServiceReconciler reconciles a RayService object
@@ -50,26 +62,30 @@ type RayServiceReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-	// Currently, the Ray dashboard doesn't cache the Serve deployment config.
-	// To avoid reapplying the same config repeatedly, cache the config in this map.
-	ServeConfigs                 cmap.ConcurrentMap[string, string]
+	// Currently, the Ray dashboard doesn't cache the Serve deployment config. To avoid reapplying the same config
+	// repeatedly, the hash of the last applied config is stored in the RayCluster's annotations instead of in memory,
+	// so it survives operator restarts and leader failovers.
 	RayClusterDeletionTimestamps cmap.ConcurrentMap[string, time.Time]
 
 	dashboardClientFunc func() utils.RayDashboardClientInterface
 	httpProxyClientFunc func() utils.RayHttpProxyClientInterface
+	grpcProxyClientFunc func() utils.RayGrpcProxyClientInterface
 }
 
 // NewRayServiceReconciler returns a new reconcile.Reconciler
-func NewRayServiceReconciler(ctx context.Context, mgr manager.Manager, dashboardClientFunc func() utils.RayDashboardClientInterface, httpProxyClientFunc func() utils.RayHttpProxyClientInterface) *RayServiceReconciler {
+func NewRayServiceReconciler(ctx context.Context, mgr manager.Manager, dashboardClientFunc func() utils.RayDashboardClientInterface, httpProxyClientFunc func() utils.RayHttpProxyClientInterface, grpcProxyClientFunc func() utils.RayGrpcProxyClientInterface) *RayServiceReconciler {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &rayv1.RayService{}, serveConfigRefNameIndexField, indexServeConfigRefName); err != nil {
+		panic(err)
+	}
 	return &RayServiceReconciler{
 		Client:                       mgr.GetClient(),
 		Scheme:                       mgr.GetScheme(),
 		Recorder:                     mgr.GetEventRecorderFor("rayservice-controller"),
-		ServeConfigs:                 cmap.New[string](),
 		RayClusterDeletionTimestamps: cmap.New[time.Time](),
 
 		dashboardClientFunc: dashboardClientFunc,
 		httpProxyClientFunc: httpProxyClientFunc,
+		grpcProxyClientFunc: grpcProxyClientFunc,
 	}
 }
 
@@ -85,10 +101,13 @@ func NewRayServiceReconciler(ctx context.Context, mgr manager.Manager, dashboard
 // +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;list
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;delete;patch
 // +kubebuilder:rbac:groups=extensions,resources=ingresses,verbs=get;list;watch;create;update;delete;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles,verbs=get;list;watch;create;delete;update
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=rolebindings,verbs=get;list;watch;create;delete
@@ -115,11 +134,24 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	originalRayServiceInstance := rayServiceInstance.DeepCopy()
-	r.cleanUpServeConfigCache(ctx, rayServiceInstance)
+
+	if rayServiceInstance.Annotations[utils.RayServiceRollbackAnnotationKey] == "true" {
+		return r.rollbackRayService(ctx, rayServiceInstance)
+	}
 
 	// TODO (kevin85421): ObservedGeneration should be used to determine whether to update this CR or not.
 	rayServiceInstance.Status.ObservedGeneration = rayServiceInstance.ObjectMeta.Generation
 
+	if rayServiceInstance.Spec.Suspend {
+		return r.suspendRayService(ctx, originalRayServiceInstance, rayServiceInstance)
+	}
+	meta.RemoveStatusCondition(&rayServiceInstance.Status.Conditions, string(rayv1.RayServiceSuspended))
+
+	if err = r.resolveServeConfig(ctx, rayServiceInstance); err != nil {
+		err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToResolveServeConfig, err)
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, client.IgnoreNotFound(err)
+	}
+
 	// Find active and pending ray cluster objects given current service name.
 	var activeRayClusterInstance *rayv1.RayCluster
 	var pendingRayClusterInstance *rayv1.RayCluster
@@ -139,6 +171,18 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
 	}
 
+	// Give up the pending RayCluster and keep the active RayCluster if the pending RayCluster doesn't become ready in time.
+	if activeRayClusterInstance != nil && pendingRayClusterInstance != nil && isUpgradeTimedOut(rayServiceInstance, pendingRayClusterInstance) {
+		if err = r.Delete(ctx, pendingRayClusterInstance, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to delete the pending RayCluster after the upgrade timeout", "pendingRayCluster", pendingRayClusterInstance.Name)
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		r.markRolloutFailed(ctx, rayServiceInstance, pendingRayClusterInstance, rayv1.UpgradeTimeoutReason,
+			fmt.Sprintf("The pending RayCluster %s didn't become ready within %d seconds and was deleted. %s",
+				pendingRayClusterInstance.Name, *rayServiceInstance.Spec.UpgradeTimeoutSeconds, describeServeStatus(rayServiceInstance.Status.PendingServiceStatus)))
+		pendingRayClusterInstance = nil
+	}
+
 	/*
 		Update ray cluster for 4 possible situations.
 		If a ray cluster does not exist, clear its status.
@@ -163,6 +207,29 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 			logger.Error(err, "Fail to reconcileServe.")
 			return ctrlResult, nil
 		}
+
+		if !isTrafficShiftingInProgress(rayServiceInstance) && !isPromotionApproved(rayServiceInstance, pendingRayClusterInstance.Name) {
+			// The pending RayCluster waits for the manual promotion, and the active RayCluster keeps serving the traffic.
+			if isReady {
+				r.markAwaitingPromotion(ctx, rayServiceInstance, pendingRayClusterInstance)
+			}
+			isReady = true
+			pendingRayClusterInstance = nil
+		} else if shouldShiftTrafficGradually(rayServiceInstance) {
+			var isShiftingFinished bool
+			if isShiftingFinished, err = r.reconcileTrafficShifting(ctx, rayServiceInstance, activeRayClusterInstance, pendingRayClusterInstance, isReady); err != nil {
+				err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
+				return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+			}
+			if isShiftingFinished {
+				r.updateRayClusterInfo(ctx, rayServiceInstance, pendingRayClusterInstance)
+			} else if isTrafficShiftingInProgress(rayServiceInstance) || rayServiceInstance.Status.PendingServiceStatus.RayClusterName == "" {
+				// The traffic shifting is in progress or the rollout has failed. In both cases, the active RayCluster
+				// keeps serving the Kubernetes serve Service.
+				isReady = true
+				pendingRayClusterInstance = nil
+			}
+		}
 	} else if activeRayClusterInstance == nil && pendingRayClusterInstance != nil {
 		rayServiceInstance.Status.ActiveServiceStatus = rayv1.RayServiceStatus{}
 		if ctrlResult, isReady, err = r.reconcileServe(ctx, rayServiceInstance, pendingRayClusterInstance, false); err != nil {
@@ -201,20 +268,40 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 			err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 		}
-		if err := r.labelHeadPodForServeStatus(ctx, rayClusterInstance); err != nil {
+		if err := r.labelHeadPodForServeStatus(ctx, rayServiceInstance, rayClusterInstance); err != nil {
 			err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 		}
+		if activeRayClusterInstance != nil && rayClusterInstance.Name != activeRayClusterInstance.Name &&
+			activeRayClusterInstance.Name == rayServiceInstance.Status.ActiveServiceStatus.RayClusterName {
+			if err := r.updateServeGRPCProxyCondition(ctx, rayServiceInstance, activeRayClusterInstance); err != nil {
+				logger.Error(err, "Failed to check the Ray Serve gRPC proxy of the active RayCluster", "RayCluster", activeRayClusterInstance.Name)
+			}
+		}
 		if err := r.reconcileServices(ctx, rayServiceInstance, rayClusterInstance, utils.ServingService); err != nil {
 			err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 		}
+		if err := r.reconcileServeApplicationServices(ctx, rayServiceInstance, rayClusterInstance); err != nil {
+			err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		if rayServiceInstance.Spec.TrafficShifting != nil && !isTrafficShiftingInProgress(rayServiceInstance) {
+			if err := r.reconcileServeHTTPRoute(ctx, rayServiceInstance, rayClusterInstance); err != nil {
+				err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
+				return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+			}
+		}
 	}
 
 	if err := r.calculateStatus(ctx, rayServiceInstance); err != nil {
 		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 	}
 
+	if err := r.reconcileRolloutRevisions(ctx, rayServiceInstance); err != nil {
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+	}
+
 	// Final status update for any CR modification.
 	if r.inconsistentRayServiceStatuses(ctx, originalRayServiceInstance.Status, rayServiceInstance.Status) {
 		rayServiceInstance.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
@@ -313,6 +400,26 @@ func (r *RayServiceReconciler) inconsistentRayServiceStatuses(ctx context.Contex
 		return true
 	}
 
+	if !reflect.DeepEqual(oldStatus.TrafficShifting, newStatus.TrafficShifting) {
+		logger.Info("inconsistentRayServiceStatus RayService TrafficShifting changed")
+		return true
+	}
+
+	if oldStatus.ServeConfigHash != newStatus.ServeConfigHash {
+		logger.Info("inconsistentRayServiceStatus RayService ServeConfigHash changed")
+		return true
+	}
+
+	if oldStatus.RolloutFailedHash != newStatus.RolloutFailedHash || !reflect.DeepEqual(oldStatus.Conditions, newStatus.Conditions) {
+		logger.Info("inconsistentRayServiceStatus RayService rollout failure changed")
+		return true
+	}
+
+	if !reflect.DeepEqual(oldStatus.RolloutHistory, newStatus.RolloutHistory) {
+		logger.Info("inconsistentRayServiceStatus RayService RolloutHistory changed")
+		return true
+	}
+
 	if r.inconsistentRayServiceStatus(ctx, oldStatus.ActiveServiceStatus, newStatus.ActiveServiceStatus) {
 		logger.Info("inconsistentRayServiceStatus RayService ActiveServiceStatus changed")
 		return true
@@ -337,6 +444,8 @@ func (r *RayServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&rayv1.RayCluster{}).
 		Owns(&corev1.Service{}).
 		Owns(&networkingv1.Ingress{}).
+		// Only the metadata of ConfigMaps is cached. The ConfigMaps referenced by ServeConfigRef are read from the API server.
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.rayServicesForConfigMap), builder.OnlyMetadata).
 		WithOptions(controller.Options{
 			LogConstructor: func(request *reconcile.Request) logr.Logger {
 				logger := ctrl.Log.WithName("controllers").WithName("RayService")
@@ -349,6 +458,116 @@ func (r *RayServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
+// indexServeConfigRefName indexes RayServices by the name of the ConfigMap referenced by ServeConfigRef.
+func indexServeConfigRefName(obj client.Object) []string {
+	rayService := obj.(*rayv1.RayService)
+	if rayService.Spec.ServeConfigRef == nil {
+		return nil
+	}
+	return []string{rayService.Spec.ServeConfigRef.Name}
+}
+
+// rayServicesForConfigMap returns the requests of the RayServices whose ServeConfigRef refers to the ConfigMap.
+func (r *RayServiceReconciler) rayServicesForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	rayServiceList := rayv1.RayServiceList{}
+	if err := r.List(ctx, &rayServiceList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{serveConfigRefNameIndexField: obj.GetName()}); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list the RayServices that refer to the ConfigMap", "ConfigMap", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(rayServiceList.Items))
+	for _, rayService := range rayServiceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rayService)})
+	}
+	return requests
+}
+
+// resolveServeConfig reads the Serve config from the ConfigMap referenced by ServeConfigRef into ServeConfigV2 of the
+// in-memory RayService, so that the rest of the reconciliation handles both sources of the Serve config in the same way.
+// The RayService's spec is never written back. It also records the hash of the resolved Serve config in the status.
+func (r *RayServiceReconciler) resolveServeConfig(ctx context.Context, rayServiceInstance *rayv1.RayService) error {
+	if serveConfigRef := rayServiceInstance.Spec.ServeConfigRef; serveConfigRef != nil {
+		if rayServiceInstance.Spec.ServeConfigV2 != "" {
+			return fmt.Errorf("serveConfigV2 and serveConfigRef are mutually exclusive")
+		}
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: rayServiceInstance.Namespace, Name: serveConfigRef.Name}, configMap); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to get the ConfigMap referenced by serveConfigRef", "ConfigMap", serveConfigRef.Name)
+			return err
+		}
+		serveConfig, ok := configMap.Data[serveConfigRef.Key]
+		if !ok {
+			return fmt.Errorf("key %s is not found in ConfigMap %s/%s referenced by serveConfigRef", serveConfigRef.Key, configMap.Namespace, configMap.Name)
+		}
+		// The Serve config in the ConfigMap is not validated at admission time, so validate it here.
+		if errs := rayv1.ValidateServeConfigV2(serveConfig, field.NewPath("data").Key(serveConfigRef.Key)); len(errs) > 0 {
+			return fmt.Errorf("invalid Serve config in ConfigMap %s/%s: %v", configMap.Namespace, configMap.Name, errs.ToAggregate())
+		}
+		rayServiceInstance.Spec.ServeConfigV2 = serveConfig
+	}
+
+	serveConfigHash, err := generateServeConfigHash(rayServiceInstance.Spec.ServeConfigV2)
+	if err != nil {
+		return err
+	}
+	rayServiceInstance.Status.ServeConfigHash = serveConfigHash
+	return nil
+}
+
+// suspendRayService deletes the RayClusters of a suspended RayService. The Kubernetes Services of the RayService are
+// kept so that their names and addresses don't change, but they have no endpoints until the RayService is resumed.
+func (r *RayServiceReconciler) suspendRayService(ctx context.Context, originalRayServiceInstance *rayv1.RayService, rayServiceInstance *rayv1.RayService) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	rayClusterList := rayv1.RayClusterList{}
+	filterLabels := client.MatchingLabels{
+		utils.RayOriginatedFromCRNameLabelKey: rayServiceInstance.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+	}
+	if err := r.List(ctx, &rayClusterList, client.InNamespace(rayServiceInstance.Namespace), filterLabels); err != nil {
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+	}
+	for i := range rayClusterList.Items {
+		rayClusterInstance := &rayClusterList.Items[i]
+		r.RayClusterDeletionTimestamps.Remove(rayClusterInstance.Name)
+		if rayClusterInstance.DeletionTimestamp != nil {
+			continue
+		}
+		if err := r.Delete(ctx, rayClusterInstance, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to delete the RayCluster of the suspended RayService", "rayCluster", rayClusterInstance.Name)
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		logger.Info("Deleted the RayCluster of the suspended RayService", "rayCluster", rayClusterInstance.Name)
+	}
+
+	// The RayClusters are gone, so there is no Serve status to report. The other status fields, such as the conditions,
+	// are kept.
+	endActiveRollout(rayServiceInstance, rayv1.RolloutOutcomeSuperseded)
+	rayServiceInstance.Status.ActiveServiceStatus = rayv1.RayServiceStatus{}
+	rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
+	rayServiceInstance.Status.TrafficShifting = nil
+	rayServiceInstance.Status.NumServeEndpoints = 0
+	rayServiceInstance.Status.ServiceStatus = rayv1.ServiceSuspended
+	meta.RemoveStatusCondition(&rayServiceInstance.Status.Conditions, string(rayv1.AwaitingPromotion))
+	meta.RemoveStatusCondition(&rayServiceInstance.Status.Conditions, string(rayv1.ServeGRPCProxyReady))
+	if !meta.IsStatusConditionTrue(rayServiceInstance.Status.Conditions, string(rayv1.RayServiceSuspended)) {
+		meta.SetStatusCondition(&rayServiceInstance.Status.Conditions, metav1.Condition{
+			Type:    string(rayv1.RayServiceSuspended),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(rayv1.ServiceSuspended),
+			Message: "The RayClusters of the RayService are deleted because spec.suspend is true.",
+		})
+		r.Recorder.Event(rayServiceInstance, "Normal", string(rayv1.ServiceSuspended), "Suspended the RayService and deleted its RayClusters")
+	}
+
+	if r.inconsistentRayServiceStatuses(ctx, originalRayServiceInstance.Status, rayServiceInstance.Status) {
+		rayServiceInstance.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if err := r.Status().Update(ctx, rayServiceInstance); err != nil {
+			logger.Error(err, "Failed to update RayService status", "rayServiceInstance", rayServiceInstance)
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
 func (r *RayServiceReconciler) getRayServiceInstance(ctx context.Context, request ctrl.Request) (*rayv1.RayService, error) {
 	logger := ctrl.LoggerFrom(ctx)
 	rayServiceInstance := &rayv1.RayService{}
@@ -402,6 +621,10 @@ func (r *RayServiceReconciler) reconcileRayCluster(ctx context.Context, rayServi
 		if s := os.Getenv(ENABLE_ZERO_DOWNTIME); strings.ToLower(s) == "false" {
 			enableZeroDowntime = false
 		}
+		// The upgrade strategy of the RayService takes precedence over ENABLE_ZERO_DOWNTIME.
+		if rayServiceInstance.Spec.UpgradeStrategy != nil && rayServiceInstance.Spec.UpgradeStrategy.Type != nil {
+			enableZeroDowntime = true
+		}
 		if enableZeroDowntime || !enableZeroDowntime && activeRayCluster == nil {
 			// Add a pending cluster name. In the next reconcile loop, shouldPrepareNewRayCluster will return DoNothing and we will
 			// actually create the pending RayCluster instance.
@@ -413,6 +636,15 @@ func (r *RayServiceReconciler) reconcileRayCluster(ctx context.Context, rayServi
 	} else if clusterAction == Update {
 		// Update the active cluster.
 		logger.Info("Updating the active RayCluster instance.")
+		modifiedWorkerGroups, err := getModifiedWorkerGroups(activeRayCluster.Spec, rayServiceInstance.Spec.RayClusterSpec)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Existing worker Pods don't pick up the new template. Mark the Pods of the modified worker groups before updating
+		// the RayCluster, so that the Pods created from the new template are not marked, and recreate them in batches.
+		if err := r.markOutdatedWorkerPods(ctx, activeRayCluster, modifiedWorkerGroups); err != nil {
+			return nil, nil, err
+		}
 		if activeRayCluster, err = r.constructRayClusterForRayService(ctx, rayServiceInstance, activeRayCluster.Name); err != nil {
 			return nil, nil, err
 		}
@@ -422,6 +654,12 @@ func (r *RayServiceReconciler) reconcileRayCluster(ctx context.Context, rayServi
 		return activeRayCluster, nil, nil
 	}
 
+	if activeRayCluster != nil {
+		if err := r.rollOutdatedWorkerPods(ctx, activeRayCluster); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if pendingRayCluster, err = r.createRayClusterInstanceIfNeeded(ctx, rayServiceInstance, pendingRayCluster); err != nil {
 		return nil, nil, err
 	}
@@ -429,6 +667,97 @@ func (r *RayServiceReconciler) reconcileRayCluster(ctx context.Context, rayServi
 	return activeRayCluster, pendingRayCluster, nil
 }
 
+// markOutdatedWorkerPods annotates the worker Pods of the given worker groups as outdated so that rollOutdatedWorkerPods
+// recreates them from the new worker group templates.
+func (r *RayServiceReconciler) markOutdatedWorkerPods(ctx context.Context, rayCluster *rayv1.RayCluster, groupNames []string) error {
+	logger := ctrl.LoggerFrom(ctx)
+	for _, groupName := range groupNames {
+		podList := corev1.PodList{}
+		if err := r.List(ctx, &podList, client.InNamespace(rayCluster.Namespace), client.MatchingLabels{
+			utils.RayClusterLabelKey:   rayCluster.Name,
+			utils.RayNodeGroupLabelKey: groupName,
+		}); err != nil {
+			return err
+		}
+		logger.Info("Marking the worker Pods of the modified worker group as outdated", "rayCluster", rayCluster.Name, "groupName", groupName, "numPods", len(podList.Items))
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			if pod.Annotations[utils.RayServiceOutdatedWorkerPodAnnotationKey] == "true" {
+				continue
+			}
+			if pod.Annotations == nil {
+				pod.Annotations = make(map[string]string)
+			}
+			pod.Annotations[utils.RayServiceOutdatedWorkerPodAnnotationKey] = "true"
+			if err := r.Update(ctx, pod); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rollOutdatedWorkerPods deletes the outdated worker Pods of the RayCluster, which the RayCluster controller recreates from
+// the new worker group templates. At most a quarter of the desired Pods of a worker group, and at least one Pod, are
+// unavailable at a time, so the Serve replicas on the other worker Pods keep serving. Outdated Pods that are not ready
+// are deleted right away because they don't serve any traffic.
+func (r *RayServiceReconciler) rollOutdatedWorkerPods(ctx context.Context, rayCluster *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	for _, workerGroup := range rayCluster.Spec.WorkerGroupSpecs {
+		podList := corev1.PodList{}
+		if err := r.List(ctx, &podList, client.InNamespace(rayCluster.Namespace), client.MatchingLabels{
+			utils.RayClusterLabelKey:   rayCluster.Name,
+			utils.RayNodeGroupLabelKey: workerGroup.GroupName,
+		}); err != nil {
+			return err
+		}
+		var outdatedPods []*corev1.Pod
+		var readyPods int32
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			if !pod.DeletionTimestamp.IsZero() {
+				continue
+			}
+			if utils.IsRunningAndReady(pod) {
+				readyPods++
+			}
+			if pod.Annotations[utils.RayServiceOutdatedWorkerPodAnnotationKey] == "true" {
+				outdatedPods = append(outdatedPods, pod)
+			}
+		}
+		if len(outdatedPods) == 0 {
+			continue
+		}
+
+		numOfHosts := workerGroup.NumOfHosts
+		if numOfHosts < 1 {
+			numOfHosts = 1
+		}
+		desiredPods := utils.GetWorkerGroupDesiredReplicas(ctx, workerGroup) * numOfHosts
+		maxUnavailablePods := desiredPods / 4
+		if maxUnavailablePods < 1 {
+			maxUnavailablePods = 1
+		}
+		unavailablePods := desiredPods - readyPods
+		if unavailablePods < 0 {
+			unavailablePods = 0
+		}
+		for _, pod := range outdatedPods {
+			if utils.IsRunningAndReady(pod) {
+				if unavailablePods >= maxUnavailablePods {
+					continue
+				}
+				unavailablePods++
+			}
+			logger.Info("Deleting the outdated worker Pod", "rayCluster", rayCluster.Name, "groupName", workerGroup.GroupName, "pod", pod.Name)
+			if err := r.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // cleanUpRayClusterInstance cleans up all the dangling RayCluster instances that are owned by the RayService instance.
 func (r *RayServiceReconciler) cleanUpRayClusterInstance(ctx context.Context, rayServiceInstance *rayv1.RayService) error {
 	logger := ctrl.LoggerFrom(ctx)
@@ -491,27 +820,6 @@ func (r *RayServiceReconciler) getRayClusterByNamespacedName(ctx context.Context
 	return rayCluster, nil
 }
 
-// cleanUpServeConfigCache cleans up the unused serve deployments config in the cached map.
-func (r *RayServiceReconciler) cleanUpServeConfigCache(ctx context.Context, rayServiceInstance *rayv1.RayService) {
-	logger := ctrl.LoggerFrom(ctx)
-	activeConfigKey := r.generateConfigKey(rayServiceInstance, rayServiceInstance.Status.ActiveServiceStatus.RayClusterName)
-	pendingConfigKey := r.generateConfigKey(rayServiceInstance, rayServiceInstance.Status.PendingServiceStatus.RayClusterName)
-	configPrefix := r.generateConfigKeyPrefix(rayServiceInstance)
-
-	// Clean up RayCluster serve deployment configs.
-	for key := range r.ServeConfigs.Items() {
-		if key == activeConfigKey || key == pendingConfigKey {
-			continue
-		}
-		if !strings.HasPrefix(key, configPrefix) {
-			// Skip configs owned by other RayService Instance.
-			continue
-		}
-		logger.Info("cleanUpServeConfigCache", "activeConfigKey", activeConfigKey, "pendingConfigKey", pendingConfigKey, "remove key", key)
-		r.ServeConfigs.Remove(key)
-	}
-}
-
 type ClusterAction int
 
 const (
@@ -549,6 +857,24 @@ func (r *RayServiceReconciler) shouldPrepareNewRayCluster(ctx context.Context, r
 			return DoNothing
 		}
 
+		if rayServiceInstance.Status.RolloutFailedHash != "" {
+			rolloutHash, err := generateRolloutHash(rayServiceInstance)
+			if err != nil {
+				logger.Error(err, errContextFailedToSerialize)
+				return DoNothing
+			}
+			if rolloutHash == rayServiceInstance.Status.RolloutFailedHash {
+				logger.Info("The rollout of the goal config has failed. Skip preparing a new RayCluster until the config changes.", "rolloutHash", rolloutHash)
+				return DoNothing
+			}
+		}
+
+		upgradeType := getUpgradeType(rayServiceInstance)
+		if upgradeType == rayv1.RayServiceUpgradeNone {
+			logger.Info("The upgrade strategy is None. Skip applying the changes of the RayClusterSpec to the active RayCluster.")
+			return DoNothing
+		}
+
 		// Case 2: Otherwise, if everything is identical except for the Replicas and WorkersToDelete of
 		// the existing workergroups, and one or more new workergroups are added at the end, then update the cluster.
 		activeClusterNumWorkerGroups, err := strconv.Atoi(activeRayCluster.ObjectMeta.Annotations[utils.NumWorkerGroupsKey])
@@ -577,7 +903,20 @@ func (r *RayServiceReconciler) shouldPrepareNewRayCluster(ctx context.Context, r
 			}
 		}
 
-		// Case 3: Otherwise, rollout a new cluster.
+		// Case 3: Otherwise, if the upgrade strategy is InPlaceWhenPossible and only the worker groups are changed, then update the cluster.
+		if upgradeType == rayv1.RayServiceUpgradeInPlaceWhenPossible {
+			isHeadUnchanged, err := compareRayClusterJsonHash(activeRayCluster.Spec, rayServiceInstance.Spec.RayClusterSpec, generateHashWithoutWorkerGroups)
+			if err != nil {
+				logger.Error(err, errContextFailedToSerialize)
+				return DoNothing
+			}
+			if isHeadUnchanged {
+				logger.Info("Active RayCluster config matches goal config, except for WorkerGroupSpecs. Updating RayCluster in place.")
+				return Update
+			}
+		}
+
+		// Case 4: Otherwise, rollout a new cluster.
 		logger.Info("Active RayCluster config doesn't match goal config. " +
 			"RayService operator should prepare a new Ray cluster.\n" +
 			"* Active RayCluster config hash: " + activeClusterHash + "\n" +
@@ -653,9 +992,19 @@ func (r *RayServiceReconciler) updateRayClusterInstance(ctx context.Context, ray
 	// Update the fetched RayCluster with new changes
 	currentRayCluster.Spec = rayClusterInstance.Spec
 
-	// Update the labels and annotations
+	// Update the labels and annotations. Keep the hashes of the applied Serve config and of its applications because
+	// the Serve applications are still running on the RayCluster.
+	previousAnnotations := currentRayCluster.Annotations
 	currentRayCluster.Labels = rayClusterInstance.Labels
 	currentRayCluster.Annotations = rayClusterInstance.Annotations
+	for _, key := range []string{utils.ServeConfigHashKey, utils.ServeApplicationHashesKey} {
+		if value, ok := previousAnnotations[key]; ok {
+			if currentRayCluster.Annotations == nil {
+				currentRayCluster.Annotations = make(map[string]string)
+			}
+			currentRayCluster.Annotations[key] = value
+		}
+	}
 
 	// Update the RayCluster
 	if err = r.Update(ctx, currentRayCluster); err != nil {
@@ -756,54 +1105,66 @@ func (r *RayServiceReconciler) constructRayClusterForRayService(ctx context.Cont
 	return rayCluster, nil
 }
 
-func (r *RayServiceReconciler) checkIfNeedSubmitServeDeployment(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serveStatus *rayv1.RayServiceStatus) bool {
+// checkIfNeedSubmitServeDeployment compares the hash of the Serve config with the hash of the last config applied to the
+// RayCluster, which is stored in the RayCluster's annotations, and checks that the Serve applications are still live.
+// It returns an error instead of a decision if the live Serve applications can't be fetched from the dashboard, so that
+// a transient dashboard failure doesn't cause the Serve config to be applied again.
+func (r *RayServiceReconciler) checkIfNeedSubmitServeDeployment(ctx context.Context, rayDashboardClient utils.RayDashboardClientInterface, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) (bool, error) {
 	logger := ctrl.LoggerFrom(ctx)
 
-	// If the Serve config has not been cached, update the Serve config.
-	cacheKey := r.generateConfigKey(rayServiceInstance, rayClusterInstance.Name)
-	cachedServeConfigV2, exist := r.ServeConfigs.Get(cacheKey)
-
+	// If the Serve config has not been applied to the RayCluster, update the Serve config.
+	appliedServeConfigHash, exist := rayClusterInstance.Annotations[utils.ServeConfigHashKey]
 	if !exist {
-		logger.Info("shouldUpdate",
-			"shouldUpdateServe",
-			true,
-			"reason",
-			fmt.Sprintf(
-				"Nothing has been cached for cluster %s with key %s",
-				rayClusterInstance.Name,
-				cacheKey,
-			),
-		)
-		return true
+		logger.Info("shouldUpdate", "shouldUpdateServe", true,
+			"reason", fmt.Sprintf("No Serve config has been applied to cluster %s", rayClusterInstance.Name))
+		return true, nil
 	}
 
-	// Handle the case that the head Pod has crashed and GCS FT is not enabled.
-	if len(serveStatus.Applications) == 0 {
+	serveConfigHash, err := generateServeConfigHash(rayServiceInstance.Spec.ServeConfigV2)
+	if err != nil {
+		logger.Error(err, "Failed to generate the hash of the Serve config")
+		return true, nil
+	}
+	if appliedServeConfigHash != serveConfigHash {
+		logger.Info("shouldUpdate", "shouldUpdateServe", true,
+			"reason", fmt.Sprintf("Current V2 Serve config doesn't match the Serve config applied to cluster %s", rayClusterInstance.Name),
+			"appliedServeConfigHash", appliedServeConfigHash, "serveConfigHash", serveConfigHash)
+		return true, nil
+	}
+
+	// Handle the case that the head Pod has crashed and GCS FT is not enabled. The Serve applications are lost even though
+	// the config was applied before.
+	serveDetails, err := rayDashboardClient.GetServeDetails(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the live Serve applications of RayCluster %s: %w", rayClusterInstance.Name, err)
+	}
+	if len(serveDetails.Applications) == 0 {
 		logger.Info("shouldUpdate", "should create Serve applications", true,
 			"reason",
 			fmt.Sprintf(
 				"No Serve application found in RayCluster %s, need to create serve applications. "+
-					"A possible reason is the head Pod has crashed and GCS FT is not enabled. "+
-					"Hence, the RayService CR's Serve application status is set to empty in the previous reconcile.",
+					"A possible reason is the head Pod has crashed and GCS FT is not enabled.",
 				rayClusterInstance.Name))
-		return true
+		return true, nil
 	}
-
-	// If the Serve config has been cached, check if it needs to be updated.
-	shouldUpdate := false
-	reason := fmt.Sprintf("Current Serve config matches cached Serve config, "+
-		"and some deployments have been deployed for cluster %s", rayClusterInstance.Name)
-
-	if cachedServeConfigV2 != rayServiceInstance.Spec.ServeConfigV2 {
-		shouldUpdate = true
-		reason = fmt.Sprintf("Current V2 Serve config doesn't match cached Serve config for cluster %s with key %s", rayClusterInstance.Name, cacheKey)
+	if appNames, err := getServeConfigAppNames(rayServiceInstance.Spec.ServeConfigV2); err != nil {
+		logger.Info("Failed to parse the application names in the Serve config. Skip comparing them with the live Serve applications.", "error", err.Error())
+	} else {
+		for _, appName := range appNames {
+			if _, ok := serveDetails.Applications[appName]; !ok {
+				logger.Info("shouldUpdate", "shouldUpdateServe", true,
+					"reason", fmt.Sprintf("Serve application %s is not live in cluster %s", appName, rayClusterInstance.Name))
+				return true, nil
+			}
+		}
 	}
-	logger.Info("shouldUpdate", "shouldUpdateServe", shouldUpdate, "reason", reason, "cachedServeConfig", cachedServeConfigV2, "current Serve config", rayServiceInstance.Spec.ServeConfigV2)
 
-	return shouldUpdate
+	logger.Info("shouldUpdate", "shouldUpdateServe", false,
+		"reason", fmt.Sprintf("Current Serve config matches the Serve config applied to cluster %s, and the Serve applications are live", rayClusterInstance.Name))
+	return false, nil
 }
 
-func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, rayServiceInstance *rayv1.RayService, rayDashboardClient utils.RayDashboardClientInterface, clusterName string) error {
+func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, rayServiceInstance *rayv1.RayService, rayDashboardClient utils.RayDashboardClientInterface, rayClusterInstance *rayv1.RayCluster) error {
 	logger := ctrl.LoggerFrom(ctx)
 	logger.Info("updateServeDeployment", "V2 config", rayServiceInstance.Spec.ServeConfigV2)
 
@@ -826,12 +1187,108 @@ func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, raySer
 		return err
 	}
 
-	cacheKey := r.generateConfigKey(rayServiceInstance, clusterName)
-	r.ServeConfigs.Set(cacheKey, rayServiceInstance.Spec.ServeConfigV2)
-	logger.Info("updateServeDeployment", "message", fmt.Sprintf("Cached Serve config for Ray cluster %s with key %s", clusterName, cacheKey))
+	serveConfigHash, err := generateServeConfigHash(rayServiceInstance.Spec.ServeConfigV2)
+	if err != nil {
+		return err
+	}
+	patch := client.MergeFrom(rayClusterInstance.DeepCopy())
+	if rayClusterInstance.Annotations == nil {
+		rayClusterInstance.Annotations = make(map[string]string)
+	}
+	rayClusterInstance.Annotations[utils.ServeConfigHashKey] = serveConfigHash
+
+	// Only report the Serve applications that were created, updated, or deleted by this config as updating.
+	appDiffMessage := ""
+	if appHashes, err := generateServeApplicationHashes(rayServiceInstance.Spec.ServeConfigV2); err != nil {
+		logger.Info("Failed to generate the hashes of the Serve applications. Skip reporting the changed applications.", "error", err.Error())
+	} else {
+		// The annotation is missing if no Serve config has been applied to the RayCluster yet.
+		previousAppHashes := map[string]string{}
+		if previous, ok := rayClusterInstance.Annotations[utils.ServeApplicationHashesKey]; ok {
+			if err := json.Unmarshal([]byte(previous), &previousAppHashes); err != nil {
+				logger.Info("Failed to parse the hashes of the previous Serve applications", "error", err.Error())
+			}
+		}
+		created, updated, deleted := diffServeApplications(previousAppHashes, appHashes)
+		appDiffMessage = fmt.Sprintf(" (created applications: %v, updated applications: %v, deleted applications: %v)", created, updated, deleted)
+		appHashesJson, err := json.Marshal(appHashes)
+		if err != nil {
+			return err
+		}
+		rayClusterInstance.Annotations[utils.ServeApplicationHashesKey] = string(appHashesJson)
+	}
+
+	if err := r.Patch(ctx, rayClusterInstance, patch); err != nil {
+		logger.Error(err, "Failed to record the applied Serve config in the RayCluster's annotations", "rayCluster", rayClusterInstance.Name)
+		return err
+	}
+	logger.Info("updateServeDeployment", "message", fmt.Sprintf("Recorded the applied Serve config for Ray cluster %s", rayClusterInstance.Name), "serveConfigHash", serveConfigHash)
+	r.Recorder.Eventf(rayServiceInstance, "Normal", "SubmittedServeDeployment",
+		"Controller sent API request to update Serve deployments on cluster %s%s", rayClusterInstance.Name, appDiffMessage)
 	return nil
 }
 
+// generateServeConfigHash returns the hash of the Serve config that is recorded in the RayCluster's annotations.
+func generateServeConfigHash(serveConfigV2 string) (string, error) {
+	return utils.GenerateJsonHash(serveConfigV2)
+}
+
+// getServeConfigAppNames returns the names of the applications in the Serve config.
+func getServeConfigAppNames(serveConfigV2 string) ([]string, error) {
+	serveConfig := rayv1.ServeDeployConfig{}
+	if err := yaml.Unmarshal([]byte(serveConfigV2), &serveConfig); err != nil {
+		return nil, err
+	}
+	appNames := make([]string, 0, len(serveConfig.Applications))
+	for _, app := range serveConfig.Applications {
+		appNames = append(appNames, app.GetName())
+	}
+	return appNames, nil
+}
+
+// generateServeApplicationHashes returns the hash of the config of each application in the Serve config. The raw
+// configs are hashed rather than rayv1.ServeApplicationConfig, so that a change of a field unknown to KubeRay is
+// reported as well.
+func generateServeApplicationHashes(serveConfigV2 string) (map[string]string, error) {
+	serveConfig := struct {
+		Applications []map[string]interface{} `json:"applications"`
+	}{}
+	if err := yaml.Unmarshal([]byte(serveConfigV2), &serveConfig); err != nil {
+		return nil, err
+	}
+	appHashes := make(map[string]string, len(serveConfig.Applications))
+	for _, app := range serveConfig.Applications {
+		hash, err := utils.GenerateJsonHash(app)
+		if err != nil {
+			return nil, err
+		}
+		name, _ := app["name"].(string)
+		appHashes[rayv1.ServeApplicationConfig{Name: name}.GetName()] = hash
+	}
+	return appHashes, nil
+}
+
+// diffServeApplications compares the hashes of the Serve applications and returns the sorted names of the created,
+// updated, and deleted applications.
+func diffServeApplications(previousAppHashes map[string]string, appHashes map[string]string) (created []string, updated []string, deleted []string) {
+	for appName, hash := range appHashes {
+		if previousHash, ok := previousAppHashes[appName]; !ok {
+			created = append(created, appName)
+		} else if previousHash != hash {
+			updated = append(updated, appName)
+		}
+	}
+	for appName := range previousAppHashes {
+		if _, ok := appHashes[appName]; !ok {
+			deleted = append(deleted, appName)
+		}
+	}
+	sort.Strings(created)
+	sort.Strings(updated)
+	sort.Strings(deleted)
+	return created, updated, deleted
+}
+
 // `getAndCheckServeStatus` gets Serve applications' and deployments' statuses and check whether the
 // Serve applications are ready to serve incoming traffic or not. It returns two values:
 //
@@ -918,14 +1375,6 @@ func (r *RayServiceReconciler) getAndCheckServeStatus(ctx context.Context, dashb
 	return isReady, nil
 }
 
-func (r *RayServiceReconciler) generateConfigKey(rayServiceInstance *rayv1.RayService, clusterName string) string {
-	return r.generateConfigKeyPrefix(rayServiceInstance) + clusterName
-}
-
-func (r *RayServiceReconciler) generateConfigKeyPrefix(rayServiceInstance *rayv1.RayService) string {
-	return rayServiceInstance.Namespace + "/" + rayServiceInstance.Name + "/"
-}
-
 func (r *RayServiceReconciler) markRestartAndAddPendingClusterName(ctx context.Context, rayServiceInstance *rayv1.RayService) {
 	logger := ctrl.LoggerFrom(ctx)
 
@@ -935,14 +1384,21 @@ func (r *RayServiceReconciler) markRestartAndAddPendingClusterName(ctx context.C
 	rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{
 		RayClusterName: utils.GenerateRayClusterName(rayServiceInstance.Name),
 	}
+	rayServiceInstance.Status.TrafficShifting = nil
+	rayServiceInstance.Status.RolloutFailedHash = ""
+	meta.RemoveStatusCondition(&rayServiceInstance.Status.Conditions, string(rayv1.RolloutFailed))
+	meta.RemoveStatusCondition(&rayServiceInstance.Status.Conditions, string(rayv1.AwaitingPromotion))
 }
 
-func (r *RayServiceReconciler) updateRayClusterInfo(ctx context.Context, rayServiceInstance *rayv1.RayService, healthyClusterName string) {
+func (r *RayServiceReconciler) updateRayClusterInfo(ctx context.Context, rayServiceInstance *rayv1.RayService, healthyCluster *rayv1.RayCluster) {
 	logger := ctrl.LoggerFrom(ctx)
-	logger.Info("updateRayClusterInfo", "ActiveRayClusterName", rayServiceInstance.Status.ActiveServiceStatus.RayClusterName, "healthyClusterName", healthyClusterName)
-	if rayServiceInstance.Status.ActiveServiceStatus.RayClusterName != healthyClusterName {
+	logger.Info("updateRayClusterInfo", "ActiveRayClusterName", rayServiceInstance.Status.ActiveServiceStatus.RayClusterName, "healthyClusterName", healthyCluster.Name)
+	if rayServiceInstance.Status.ActiveServiceStatus.RayClusterName != healthyCluster.Name {
+		recordPromotedRollout(ctx, rayServiceInstance, healthyCluster)
 		rayServiceInstance.Status.ActiveServiceStatus = rayServiceInstance.Status.PendingServiceStatus
 		rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
+		rayServiceInstance.Status.TrafficShifting = nil
+		meta.RemoveStatusCondition(&rayServiceInstance.Status.Conditions, string(rayv1.AwaitingPromotion))
 	}
 }
 
@@ -1016,38 +1472,734 @@ func (r *RayServiceReconciler) reconcileServices(ctx context.Context, rayService
 	return nil
 }
 
-func (r *RayServiceReconciler) updateStatusForActiveCluster(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) error {
+// reconcileServeApplicationServices creates or updates the Kubernetes Service and the Ingress of each Serve application
+// listed in `spec.applicationServices`, and deletes the ones of the applications that are no longer listed.
+func (r *RayServiceReconciler) reconcileServeApplicationServices(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) error {
 	logger := ctrl.LoggerFrom(ctx)
-	rayServiceInstance.Status.ActiveServiceStatus.RayClusterStatus = rayClusterInstance.Status
-
-	var err error
-	var clientURL string
-	rayServiceStatus := &rayServiceInstance.Status.ActiveServiceStatus
 
-	if clientURL, err = utils.FetchHeadServiceURL(ctx, r.Client, rayClusterInstance, utils.DashboardPortName); err != nil || clientURL == "" {
-		return err
+	var routePrefixes map[string]string
+	if len(rayServiceInstance.Spec.ApplicationServices) > 0 {
+		var err error
+		if routePrefixes, err = getServeConfigRoutePrefixes(rayServiceInstance.Spec.ServeConfigV2); err != nil {
+			return err
+		}
 	}
 
-	rayDashboardClient := r.dashboardClientFunc()
-	rayDashboardClient.InitClient(clientURL)
+	expectedServices := make(map[string]bool)
+	expectedIngresses := make(map[string]bool)
+	for _, appService := range rayServiceInstance.Spec.ApplicationServices {
+		newSvc, err := common.BuildServeApplicationServiceForRayService(ctx, *rayServiceInstance, *rayClusterInstance, appService)
+		if err != nil {
+			return err
+		}
+		if err := r.createOrUpdateServeApplicationService(ctx, rayServiceInstance, newSvc); err != nil {
+			return err
+		}
+		expectedServices[newSvc.Name] = true
 
-	var isReady bool
-	if isReady, err = r.getAndCheckServeStatus(ctx, rayDashboardClient, rayServiceStatus); err != nil {
-		return err
+		if appService.Ingress == nil {
+			continue
+		}
+		routePrefix, ok := routePrefixes[appService.ApplicationName]
+		if !ok {
+			logger.Info("The Serve application is not found in the Serve config or has no route prefix. Skip creating its Ingress.", "application", appService.ApplicationName)
+			continue
+		}
+		newIngress, err := common.BuildIngressForServeApplication(*rayServiceInstance, appService, newSvc, routePrefix)
+		if err != nil {
+			return err
+		}
+		if err := r.createOrUpdateServeApplicationIngress(ctx, rayServiceInstance, newIngress); err != nil {
+			return err
+		}
+		expectedIngresses[newIngress.Name] = true
 	}
 
-	logger.Info("Check serve health", "isReady", isReady)
-
-	return err
-}
-
-// Reconciles the Serve applications on the RayCluster. Returns (ctrl.Result, isReady, error).
-// The `isReady` flag indicates whether the RayCluster is ready to handle incoming traffic.
-func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, isActive bool) (ctrl.Result, bool, error) {
-	logger := ctrl.LoggerFrom(ctx)
-	rayServiceInstance.Status.ActiveServiceStatus.RayClusterStatus = rayClusterInstance.Status
-	var err error
-	var clientURL string
+	filterLabels := client.MatchingLabels{
+		utils.RayOriginatedFromCRNameLabelKey: rayServiceInstance.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+	}
+	serviceList := corev1.ServiceList{}
+	if err := r.List(ctx, &serviceList, client.InNamespace(rayServiceInstance.Namespace), filterLabels, client.HasLabels{utils.ServeApplicationLabelKey}); err != nil {
+		return err
+	}
+	for i := range serviceList.Items {
+		if expectedServices[serviceList.Items[i].Name] {
+			continue
+		}
+		if err := r.Delete(ctx, &serviceList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		logger.Info("Deleted the Service of a Serve application that is no longer listed in applicationServices", "Service", serviceList.Items[i].Name)
+	}
+	ingressList := networkingv1.IngressList{}
+	if err := r.List(ctx, &ingressList, client.InNamespace(rayServiceInstance.Namespace), filterLabels, client.HasLabels{utils.ServeApplicationLabelKey}); err != nil {
+		return err
+	}
+	for i := range ingressList.Items {
+		if expectedIngresses[ingressList.Items[i].Name] {
+			continue
+		}
+		if err := r.Delete(ctx, &ingressList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		logger.Info("Deleted the Ingress of a Serve application that is no longer exposed", "Ingress", ingressList.Items[i].Name)
+	}
+	return nil
+}
+
+// createOrUpdateServeApplicationService creates the Service of a Serve application if it doesn't exist. Otherwise, it
+// updates the Service if its selector, ports, type, labels or annotations are outdated, e.g. after the RayCluster switches.
+func (r *RayServiceReconciler) createOrUpdateServeApplicationService(ctx context.Context, rayServiceInstance *rayv1.RayService, newSvc *corev1.Service) error {
+	logger := ctrl.LoggerFrom(ctx)
+	oldSvc := &corev1.Service{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(newSvc), oldSvc); errors.IsNotFound(err) {
+		if err := ctrl.SetControllerReference(rayServiceInstance, newSvc, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, newSvc); err != nil {
+			return err
+		}
+		logger.Info("Created the Service of a Serve application", "Service", newSvc.Name)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(oldSvc.Spec.Selector, newSvc.Spec.Selector) &&
+		reflect.DeepEqual(servicePortNumbers(oldSvc.Spec.Ports), servicePortNumbers(newSvc.Spec.Ports)) &&
+		(newSvc.Spec.Type == "" || oldSvc.Spec.Type == newSvc.Spec.Type) &&
+		isSubset(newSvc.Labels, oldSvc.Labels) && isSubset(newSvc.Annotations, oldSvc.Annotations) {
+		return nil
+	}
+
+	// ClusterIP is immutable, so keep the ClusterIP of the old Service. See `reconcileServices` for details.
+	if newSvc.Spec.ClusterIP == "" {
+		newSvc.Spec.ClusterIP = oldSvc.Spec.ClusterIP
+	}
+	oldSvc.Spec = *newSvc.Spec.DeepCopy()
+	oldSvc.Labels = mergeStringMaps(oldSvc.Labels, newSvc.Labels)
+	oldSvc.Annotations = mergeStringMaps(oldSvc.Annotations, newSvc.Annotations)
+	if err := r.Update(ctx, oldSvc); err != nil {
+		return err
+	}
+	logger.Info("Updated the Service of a Serve application", "Service", oldSvc.Name)
+	return nil
+}
+
+// createOrUpdateServeApplicationIngress creates the Ingress of a Serve application if it doesn't exist. Otherwise, it
+// updates the Ingress if its spec, labels or annotations are outdated.
+func (r *RayServiceReconciler) createOrUpdateServeApplicationIngress(ctx context.Context, rayServiceInstance *rayv1.RayService, newIngress *networkingv1.Ingress) error {
+	logger := ctrl.LoggerFrom(ctx)
+	oldIngress := &networkingv1.Ingress{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(newIngress), oldIngress); errors.IsNotFound(err) {
+		if err := ctrl.SetControllerReference(rayServiceInstance, newIngress, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, newIngress); err != nil {
+			return err
+		}
+		logger.Info("Created the Ingress of a Serve application", "Ingress", newIngress.Name)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(oldIngress.Spec, newIngress.Spec) &&
+		isSubset(newIngress.Labels, oldIngress.Labels) && isSubset(newIngress.Annotations, oldIngress.Annotations) {
+		return nil
+	}
+
+	oldIngress.Spec = *newIngress.Spec.DeepCopy()
+	oldIngress.Labels = mergeStringMaps(oldIngress.Labels, newIngress.Labels)
+	oldIngress.Annotations = mergeStringMaps(oldIngress.Annotations, newIngress.Annotations)
+	if err := r.Update(ctx, oldIngress); err != nil {
+		return err
+	}
+	logger.Info("Updated the Ingress of a Serve application", "Ingress", oldIngress.Name)
+	return nil
+}
+
+// getServeConfigRoutePrefixes returns the route prefix of each Serve application in the Serve config. Ray Serve uses
+// "/" if the route prefix of an application is not set.
+func getServeConfigRoutePrefixes(serveConfigV2 string) (map[string]string, error) {
+	serveConfig := rayv1.ServeDeployConfig{}
+	if err := yaml.Unmarshal([]byte(serveConfigV2), &serveConfig); err != nil {
+		return nil, err
+	}
+	routePrefixes := make(map[string]string, len(serveConfig.Applications))
+	for _, app := range serveConfig.Applications {
+		routePrefix := "/"
+		if app.RoutePrefix != nil {
+			routePrefix = *app.RoutePrefix
+		}
+		routePrefixes[app.GetName()] = routePrefix
+	}
+	return routePrefixes, nil
+}
+
+// servicePortNumbers returns the port number of each port name, ignoring the fields defaulted by the API server.
+func servicePortNumbers(ports []corev1.ServicePort) map[string]int32 {
+	portNumbers := make(map[string]int32, len(ports))
+	for _, port := range ports {
+		portNumbers[port.Name] = port.Port
+	}
+	return portNumbers
+}
+
+// isSubset returns whether every key-value pair of sub is in m.
+func isSubset(sub map[string]string, m map[string]string) bool {
+	for k, v := range sub {
+		if value, ok := m[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeStringMaps returns a copy of dst with the key-value pairs of src added.
+func mergeStringMaps(dst map[string]string, src map[string]string) map[string]string {
+	merged := make(map[string]string, len(dst)+len(src))
+	for k, v := range dst {
+		merged[k] = v
+	}
+	for k, v := range src {
+		merged[k] = v
+	}
+	return merged
+}
+
+// shouldShiftTrafficGradually returns whether the traffic is shifted from the active RayCluster to the pending RayCluster
+// gradually instead of all at once.
+func shouldShiftTrafficGradually(rayServiceInstance *rayv1.RayService) bool {
+	return rayServiceInstance.Spec.TrafficShifting != nil && len(rayServiceInstance.Spec.TrafficShifting.Steps) > 0 &&
+		rayServiceInstance.Status.ActiveServiceStatus.RayClusterName != ""
+}
+
+func isTrafficShiftingInProgress(rayServiceInstance *rayv1.RayService) bool {
+	return rayServiceInstance.Status.TrafficShifting != nil && rayServiceInstance.Status.TrafficShifting.StepStartTime != nil
+}
+
+// reconcileTrafficShifting shifts the traffic from the active RayCluster to the pending RayCluster step by step by updating
+// the weights of the HTTPRoute backends. If a Serve application on the pending RayCluster becomes UNHEALTHY during the
+// traffic shifting, the upgrade is aborted and all traffic is routed back to the active RayCluster. It returns true if the
+// last step has finished and the pending RayCluster should become the active RayCluster.
+func (r *RayServiceReconciler) reconcileTrafficShifting(ctx context.Context, rayServiceInstance *rayv1.RayService, activeRayCluster *rayv1.RayCluster, pendingRayCluster *rayv1.RayCluster, isPendingReady bool) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	steps := rayServiceInstance.Spec.TrafficShifting.Steps
+
+	if isTrafficShiftingInProgress(rayServiceInstance) {
+		for appName, app := range rayServiceInstance.Status.PendingServiceStatus.Applications {
+			if isServeAppUnhealthyOrDeployedFailed(app.Status) {
+				r.abortTrafficShifting(ctx, rayServiceInstance, activeRayCluster, pendingRayCluster, appName, app)
+				return false, nil
+			}
+		}
+	}
+	if !isPendingReady {
+		logger.Info("The Serve applications on the pending RayCluster are not ready. Keep the current traffic weights.", "pendingRayCluster", pendingRayCluster.Name)
+		return false, nil
+	}
+
+	now := metav1.Now()
+	trafficShifting := rayServiceInstance.Status.TrafficShifting
+	if !isTrafficShiftingInProgress(rayServiceInstance) {
+		trafficShifting = &rayv1.TrafficShiftingStatus{Step: 0, PendingClusterWeight: steps[0].Weight, StepStartTime: &now}
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, "TrafficShifting",
+			"Started shifting %d%% of the traffic to RayCluster %s", trafficShifting.PendingClusterWeight, pendingRayCluster.Name)
+	} else if step := int(trafficShifting.Step); step >= len(steps) || time.Since(trafficShifting.StepStartTime.Time) >= time.Duration(steps[step].SoakSeconds)*time.Second {
+		if step+1 >= len(steps) {
+			logger.Info("The traffic shifting has finished.", "pendingRayCluster", pendingRayCluster.Name)
+			return true, nil
+		}
+		trafficShifting = &rayv1.TrafficShiftingStatus{Step: int32(step + 1), PendingClusterWeight: steps[step+1].Weight, StepStartTime: &now}
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, "TrafficShifting",
+			"Shifted %d%% of the traffic to RayCluster %s", trafficShifting.PendingClusterWeight, pendingRayCluster.Name)
+	}
+
+	logger.Info("reconcileTrafficShifting", "step", trafficShifting.Step, "pendingClusterWeight", trafficShifting.PendingClusterWeight)
+	rayServiceInstance.Status.TrafficShifting = trafficShifting
+	return false, r.reconcileTrafficShiftingHTTPRoute(ctx, rayServiceInstance, activeRayCluster, pendingRayCluster, trafficShifting.PendingClusterWeight)
+}
+
+// abortTrafficShifting fails the rollout because a Serve application on the pending RayCluster is unhealthy. The dangling
+// pending RayCluster is deleted by cleanUpRayClusterInstance after the HTTPRoute stops routing traffic to it.
+func (r *RayServiceReconciler) abortTrafficShifting(ctx context.Context, rayServiceInstance *rayv1.RayService, activeRayCluster *rayv1.RayCluster, pendingRayCluster *rayv1.RayCluster, appName string, app rayv1.AppStatus) {
+	r.markRolloutFailed(ctx, rayServiceInstance, pendingRayCluster, rayv1.ServeApplicationUnhealthyReason,
+		fmt.Sprintf("Aborted the traffic shifting to RayCluster %s and routed all traffic back to RayCluster %s because the Serve application %s is %s. %s",
+			pendingRayCluster.Name, activeRayCluster.Name, appName, app.Status, describeServeStatus(rayServiceInstance.Status.PendingServiceStatus)))
+	rayServiceInstance.Status.TrafficShifting = &rayv1.TrafficShiftingStatus{
+		AbortedRayClusterHash: pendingRayCluster.Annotations[utils.HashWithoutReplicasAndWorkersToDeleteKey],
+	}
+}
+
+// markRolloutFailed gives up the pending RayCluster and records the failure in the RolloutFailed condition. KubeRay doesn't
+// prepare a new RayCluster for the same RayClusterSpec and Serve config again.
+func (r *RayServiceReconciler) markRolloutFailed(ctx context.Context, rayServiceInstance *rayv1.RayService, pendingRayCluster *rayv1.RayCluster, reason string, message string) {
+	logger := ctrl.LoggerFrom(ctx)
+	rolloutHash, err := generateRolloutHash(rayServiceInstance)
+	if err != nil {
+		logger.Error(err, "Failed to generate the hash of the rollout. The rollout may be retried.")
+	}
+	logger.Info("The rollout of the pending RayCluster has failed", "pendingRayCluster", pendingRayCluster.Name, "reason", reason, "message", message)
+	r.Recorder.Event(rayServiceInstance, corev1.EventTypeWarning, string(rayv1.RolloutFailed), message)
+
+	rayServiceInstance.Status.RolloutFailedHash = rolloutHash
+	recordFailedRollout(ctx, rayServiceInstance, pendingRayCluster)
+	meta.SetStatusCondition(&rayServiceInstance.Status.Conditions, metav1.Condition{
+		Type:               string(rayv1.RolloutFailed),
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: rayServiceInstance.Generation,
+	})
+	rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
+	rayServiceInstance.Status.TrafficShifting = nil
+}
+
+// rolloutSnapshot is the part of the RayService spec that is stored in a ControllerRevision for each recorded rollout,
+// so that the RayService can be rolled back to it.
+type rolloutSnapshot struct {
+	RayClusterSpec rayv1.RayClusterSpec `json:"rayClusterConfig"`
+	ServeConfigV2  string               `json:"serveConfigV2,omitempty"`
+}
+
+// getRolloutSnapshot returns the snapshot of the RayService spec. If the Serve config is read from a ConfigMap, the
+// snapshot stores the content that resolveServeConfig read into ServeConfigV2 rather than the reference, because the
+// ConfigMap may change after the rollout.
+func getRolloutSnapshot(rayServiceInstance *rayv1.RayService) rolloutSnapshot {
+	return rolloutSnapshot{
+		RayClusterSpec: *rayServiceInstance.Spec.RayClusterSpec.DeepCopy(),
+		ServeConfigV2:  rayServiceInstance.Spec.ServeConfigV2,
+	}
+}
+
+// generateRevisionName returns the name of the ControllerRevision that stores the snapshot.
+func generateRevisionName(rayServiceInstance *rayv1.RayService, snapshot rolloutSnapshot) (string, error) {
+	hash, err := utils.GenerateJsonHash(snapshot)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s", rayServiceInstance.Name, strings.ToLower(hash[:10])), nil
+}
+
+// newRolloutRecord returns a record of the rollout of the RayCluster. The hashes are the ones recorded in the annotations
+// of the RayCluster when it was created and when the Serve config was applied to it, because the RayService spec may
+// have changed since then. The hashes of the RayService spec are used if the RayCluster doesn't have the annotations.
+func newRolloutRecord(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, outcome rayv1.RolloutOutcome) rayv1.RayServiceRolloutRecord {
+	logger := ctrl.LoggerFrom(ctx)
+	specHash, ok := rayClusterInstance.Annotations[utils.HashWithoutReplicasAndWorkersToDeleteKey]
+	if !ok {
+		var err error
+		if specHash, err = generateHashWithoutReplicasAndWorkersToDelete(rayServiceInstance.Spec.RayClusterSpec); err != nil {
+			logger.Error(err, "Failed to generate the hash of the RayClusterSpec for the rollout history")
+		}
+	}
+	serveConfigHash, ok := rayClusterInstance.Annotations[utils.ServeConfigHashKey]
+	if !ok {
+		serveConfigHash = rayServiceInstance.Status.ServeConfigHash
+	}
+	return rayv1.RayServiceRolloutRecord{
+		RayClusterName:     rayClusterInstance.Name,
+		RayClusterSpecHash: specHash,
+		ServeConfigHash:    serveConfigHash,
+		Outcome:            outcome,
+	}
+}
+
+// recordPromotedRollout records that the RayCluster starts serving the traffic, and ends the rollout that was active.
+// The rollout only gets a revision if the RayService spec still matches the hashes recorded by the RayCluster, since
+// the snapshot in the ControllerRevision is taken from the spec.
+func recordPromotedRollout(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) {
+	logger := ctrl.LoggerFrom(ctx)
+	now := metav1.Now()
+	record := newRolloutRecord(ctx, rayServiceInstance, rayClusterInstance, rayv1.RolloutOutcomeActive)
+	record.ActiveStartTime = &now
+	specHash, err := generateHashWithoutReplicasAndWorkersToDelete(rayServiceInstance.Spec.RayClusterSpec)
+	if err != nil {
+		logger.Error(err, "Failed to generate the hash of the RayClusterSpec. The rollout can't be rolled back to.")
+	} else if specHash != record.RayClusterSpecHash || rayServiceInstance.Status.ServeConfigHash != record.ServeConfigHash {
+		logger.Info("The RayService spec has changed since the RayCluster was created. The rollout can't be rolled back to.", "RayCluster", rayClusterInstance.Name)
+	} else if record.Revision, err = generateRevisionName(rayServiceInstance, getRolloutSnapshot(rayServiceInstance)); err != nil {
+		logger.Error(err, "Failed to generate the revision of the rollout. The rollout can't be rolled back to.")
+	}
+
+	// The active rollout is rolled back if the new rollout has the same revision as a rollout before it.
+	outcome := rayv1.RolloutOutcomeSuperseded
+	for _, previous := range rayServiceInstance.Status.RolloutHistory {
+		if record.Revision != "" && previous.Revision == record.Revision && previous.Outcome != rayv1.RolloutOutcomeActive {
+			outcome = rayv1.RolloutOutcomeRolledBack
+		}
+	}
+	endActiveRollout(rayServiceInstance, outcome)
+	appendRolloutRecord(rayServiceInstance, record)
+}
+
+// recordFailedRollout records that the rollout of the pending RayCluster has failed.
+func recordFailedRollout(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) {
+	now := metav1.Now()
+	record := newRolloutRecord(ctx, rayServiceInstance, rayClusterInstance, rayv1.RolloutOutcomeFailed)
+	record.ActiveEndTime = &now
+	appendRolloutRecord(rayServiceInstance, record)
+}
+
+// endActiveRollout sets the outcome and the end time of the active rollout in the rollout history.
+func endActiveRollout(rayServiceInstance *rayv1.RayService, outcome rayv1.RolloutOutcome) {
+	now := metav1.Now()
+	for i := range rayServiceInstance.Status.RolloutHistory {
+		if record := &rayServiceInstance.Status.RolloutHistory[i]; record.Outcome == rayv1.RolloutOutcomeActive {
+			record.Outcome = outcome
+			record.ActiveEndTime = &now
+		}
+	}
+}
+
+// appendRolloutRecord appends the record to the rollout history and drops the oldest records beyond rolloutHistoryLimit.
+func appendRolloutRecord(rayServiceInstance *rayv1.RayService, record rayv1.RayServiceRolloutRecord) {
+	history := append(rayServiceInstance.Status.RolloutHistory, record)
+	if len(history) > rolloutHistoryLimit {
+		history = history[len(history)-rolloutHistoryLimit:]
+	}
+	rayServiceInstance.Status.RolloutHistory = history
+}
+
+// getRollbackTarget returns the most recent rollout in the rollout history that can be rolled back to, i.e. a rollout
+// that served the traffic before the active one and has a different revision.
+func getRollbackTarget(rayServiceInstance *rayv1.RayService) *rayv1.RayServiceRolloutRecord {
+	history := rayServiceInstance.Status.RolloutHistory
+	activeRevision := ""
+	for _, record := range history {
+		if record.Outcome == rayv1.RolloutOutcomeActive {
+			activeRevision = record.Revision
+		}
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		record := history[i]
+		if record.Revision == "" || record.Revision == activeRevision || record.ActiveStartTime == nil || record.Outcome == rayv1.RolloutOutcomeActive {
+			continue
+		}
+		return &history[i]
+	}
+	return nil
+}
+
+// rollbackRayService replaces the RayClusterSpec and the Serve config of the RayService with the ones of the previous
+// rollout and removes the rollback annotation. The RayService then rolls out the previous spec as usual.
+func (r *RayServiceReconciler) rollbackRayService(ctx context.Context, rayServiceInstance *rayv1.RayService) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	delete(rayServiceInstance.Annotations, utils.RayServiceRollbackAnnotationKey)
+
+	target := getRollbackTarget(rayServiceInstance)
+	if target == nil {
+		r.Recorder.Event(rayServiceInstance, corev1.EventTypeWarning, "RollbackFailed", "There is no previous rollout in the rollout history to roll back to")
+	} else {
+		revision := &appsv1.ControllerRevision{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: rayServiceInstance.Namespace, Name: target.Revision}, revision); err != nil {
+			if !errors.IsNotFound(err) {
+				return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+			}
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, "RollbackFailed", "The ControllerRevision %s of the previous rollout is not found", target.Revision)
+			target = nil
+		} else {
+			snapshot := rolloutSnapshot{}
+			if err := json.Unmarshal(revision.Data.Raw, &snapshot); err != nil {
+				r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, "RollbackFailed", "Failed to decode the ControllerRevision %s of the previous rollout: %v", target.Revision, err)
+				target = nil
+			} else {
+				// The Serve config of the previous rollout is restored inline even if it was read from a ConfigMap,
+				// because the ConfigMap may have changed since.
+				rayServiceInstance.Spec.RayClusterSpec = snapshot.RayClusterSpec
+				rayServiceInstance.Spec.ServeConfigV2 = snapshot.ServeConfigV2
+				rayServiceInstance.Spec.ServeConfigRef = nil
+			}
+		}
+	}
+
+	if err := r.Update(ctx, rayServiceInstance); err != nil {
+		logger.Error(err, "Failed to roll back the RayService")
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+	}
+	if target != nil {
+		logger.Info("Rolled back the RayService", "rayCluster", target.RayClusterName, "revision", target.Revision)
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, "RolledBack", "Rolled back to the rollout of RayCluster %s (revision %s)", target.RayClusterName, target.Revision)
+	}
+	return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
+}
+
+// reconcileRolloutRevisions creates the ControllerRevision of the active rollout if it doesn't exist, and deletes the
+// ControllerRevisions that are no longer referenced by the rollout history.
+func (r *RayServiceReconciler) reconcileRolloutRevisions(ctx context.Context, rayServiceInstance *rayv1.RayService) error {
+	logger := ctrl.LoggerFrom(ctx)
+	revisionList := appsv1.ControllerRevisionList{}
+	filterLabels := client.MatchingLabels{
+		utils.RayOriginatedFromCRNameLabelKey: rayServiceInstance.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+	}
+	if err := r.List(ctx, &revisionList, client.InNamespace(rayServiceInstance.Namespace), filterLabels); err != nil {
+		return err
+	}
+
+	existingRevisions := make(map[string]bool, len(revisionList.Items))
+	var maxRevision int64
+	for _, revision := range revisionList.Items {
+		existingRevisions[revision.Name] = true
+		if revision.Revision > maxRevision {
+			maxRevision = revision.Revision
+		}
+	}
+	referencedRevisions := make(map[string]bool, len(rayServiceInstance.Status.RolloutHistory))
+	var activeRevision string
+	for _, record := range rayServiceInstance.Status.RolloutHistory {
+		referencedRevisions[record.Revision] = true
+		if record.Outcome == rayv1.RolloutOutcomeActive {
+			activeRevision = record.Revision
+		}
+	}
+
+	// The spec may have changed since the active rollout was recorded, so the ControllerRevision is only created if
+	// the current spec still matches the revision of the active rollout.
+	if activeRevision != "" && !existingRevisions[activeRevision] {
+		snapshot := getRolloutSnapshot(rayServiceInstance)
+		revisionName, err := generateRevisionName(rayServiceInstance, snapshot)
+		if err != nil {
+			return err
+		}
+		if revisionName == activeRevision {
+			data, err := json.Marshal(snapshot)
+			if err != nil {
+				return err
+			}
+			revision := &appsv1.ControllerRevision{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      revisionName,
+					Namespace: rayServiceInstance.Namespace,
+					Labels:    filterLabels,
+				},
+				Data:     runtime.RawExtension{Raw: data},
+				Revision: maxRevision + 1,
+			}
+			if err := ctrl.SetControllerReference(rayServiceInstance, revision, r.Scheme); err != nil {
+				return err
+			}
+			if err := r.Create(ctx, revision); client.IgnoreAlreadyExists(err) != nil {
+				return err
+			}
+			logger.Info("Created the ControllerRevision of the active rollout", "revision", revisionName)
+		}
+	}
+
+	for i := range revisionList.Items {
+		if referencedRevisions[revisionList.Items[i].Name] {
+			continue
+		}
+		if err := r.Delete(ctx, &revisionList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		logger.Info("Deleted the ControllerRevision that is no longer in the rollout history", "revision", revisionList.Items[i].Name)
+	}
+	return nil
+}
+
+// isUpgradeTimedOut returns whether the pending RayCluster has failed to become ready within UpgradeTimeoutSeconds.
+// The timeout doesn't apply once the pending RayCluster is ready and waits for the manual promotion or the traffic
+// shifting to it has started.
+func isUpgradeTimedOut(rayServiceInstance *rayv1.RayService, pendingRayCluster *rayv1.RayCluster) bool {
+	if rayServiceInstance.Spec.UpgradeTimeoutSeconds == nil || isTrafficShiftingInProgress(rayServiceInstance) ||
+		meta.IsStatusConditionTrue(rayServiceInstance.Status.Conditions, string(rayv1.AwaitingPromotion)) {
+		return false
+	}
+	return time.Since(pendingRayCluster.CreationTimestamp.Time) > time.Duration(*rayServiceInstance.Spec.UpgradeTimeoutSeconds)*time.Second
+}
+
+// describeServeStatus summarizes the last observed statuses and messages of the Serve applications and deployments.
+func describeServeStatus(serveStatus rayv1.RayServiceStatus) string {
+	if len(serveStatus.Applications) == 0 {
+		return "No Serve application status was observed."
+	}
+	appNames := make([]string, 0, len(serveStatus.Applications))
+	for appName := range serveStatus.Applications {
+		appNames = append(appNames, appName)
+	}
+	sort.Strings(appNames)
+
+	var descriptions []string
+	for _, appName := range appNames {
+		app := serveStatus.Applications[appName]
+		descriptions = append(descriptions, fmt.Sprintf("Application %s: %s %s", appName, app.Status, app.Message))
+		deploymentNames := make([]string, 0, len(app.Deployments))
+		for deploymentName := range app.Deployments {
+			deploymentNames = append(deploymentNames, deploymentName)
+		}
+		sort.Strings(deploymentNames)
+		for _, deploymentName := range deploymentNames {
+			deployment := app.Deployments[deploymentName]
+			descriptions = append(descriptions, fmt.Sprintf("Deployment %s/%s: %s %s", appName, deploymentName, deployment.Status, deployment.Message))
+		}
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// reconcileTrafficShiftingHTTPRoute routes `pendingClusterWeight` percent of the traffic to the pending RayCluster and the
+// rest to the active RayCluster through the serve Service of each RayCluster.
+func (r *RayServiceReconciler) reconcileTrafficShiftingHTTPRoute(ctx context.Context, rayServiceInstance *rayv1.RayService, activeRayCluster *rayv1.RayCluster, pendingRayCluster *rayv1.RayCluster, pendingClusterWeight int32) error {
+	backends := make([]common.HTTPRouteBackend, 0, 2)
+	for _, backend := range []struct {
+		rayCluster *rayv1.RayCluster
+		weight     int32
+	}{
+		{rayCluster: activeRayCluster, weight: 100 - pendingClusterWeight},
+		{rayCluster: pendingRayCluster, weight: pendingClusterWeight},
+	} {
+		serveService, err := r.reconcileServeServiceForRayCluster(ctx, rayServiceInstance, backend.rayCluster)
+		if err != nil {
+			return err
+		}
+		servePort, err := getServePort(serveService)
+		if err != nil {
+			return err
+		}
+		backends = append(backends, common.HTTPRouteBackend{ServiceName: serveService.Name, Port: servePort, Weight: backend.weight})
+	}
+	return r.reconcileHTTPRoute(ctx, rayServiceInstance, backends)
+}
+
+// reconcileServeHTTPRoute routes all traffic of the HTTPRoute to the Kubernetes serve Service when no traffic shifting
+// is in progress, and deletes the serve Services of the individual RayClusters.
+func (r *RayServiceReconciler) reconcileServeHTTPRoute(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	serveService, err := common.BuildServeServiceForRayService(ctx, *rayServiceInstance, *rayClusterInstance)
+	if err != nil {
+		return err
+	}
+	servePort, err := getServePort(serveService)
+	if err != nil {
+		return err
+	}
+	if err := r.reconcileHTTPRoute(ctx, rayServiceInstance, []common.HTTPRouteBackend{{ServiceName: serveService.Name, Port: servePort, Weight: 100}}); err != nil {
+		return err
+	}
+
+	serviceList := corev1.ServiceList{}
+	filterLabels := client.MatchingLabels{
+		utils.RayOriginatedFromCRNameLabelKey: rayServiceInstance.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+	}
+	if err := r.List(ctx, &serviceList, client.InNamespace(rayServiceInstance.Namespace), filterLabels, client.HasLabels{utils.RayClusterLabelKey}); err != nil {
+		return err
+	}
+	for i := range serviceList.Items {
+		if err := r.Delete(ctx, &serviceList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		logger.Info("Deleted the serve Service of a RayCluster after the traffic shifting", "Service", serviceList.Items[i].Name)
+	}
+	return nil
+}
+
+// reconcileServeServiceForRayCluster creates the serve Service that only selects the serving Pods of the RayCluster if it doesn't exist.
+func (r *RayServiceReconciler) reconcileServeServiceForRayCluster(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) (*corev1.Service, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	newSvc, err := common.BuildServeServiceForRayServiceCluster(ctx, *rayServiceInstance, *rayClusterInstance)
+	if err != nil {
+		return nil, err
+	}
+	oldSvc := &corev1.Service{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(newSvc), oldSvc); err == nil {
+		return oldSvc, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err := ctrl.SetControllerReference(rayServiceInstance, newSvc, r.Scheme); err != nil {
+		return nil, err
+	}
+	if err := r.Create(ctx, newSvc); err != nil {
+		return nil, err
+	}
+	logger.Info("Created the serve Service of RayCluster", "Service", newSvc.Name, "RayCluster", rayClusterInstance.Name)
+	return newSvc, nil
+}
+
+// reconcileHTTPRoute creates or updates the HTTPRoute of the RayService with the given backends.
+func (r *RayServiceReconciler) reconcileHTTPRoute(ctx context.Context, rayServiceInstance *rayv1.RayService, backends []common.HTTPRouteBackend) error {
+	logger := ctrl.LoggerFrom(ctx)
+	newRoute, err := common.BuildHTTPRouteForRayService(*rayServiceInstance, backends)
+	if err != nil {
+		return err
+	}
+
+	oldRoute := &unstructured.Unstructured{}
+	oldRoute.SetGroupVersionKind(common.HTTPRouteGroupVersionKind)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(newRoute), oldRoute); errors.IsNotFound(err) {
+		if err := ctrl.SetControllerReference(rayServiceInstance, newRoute, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, newRoute); err != nil {
+			return err
+		}
+		logger.Info("Created HTTPRoute", "HTTPRoute", newRoute.GetName(), "backends", backends)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get HTTPRoute %s. Please make sure the Gateway API CRDs are installed: %w", newRoute.GetName(), err)
+	}
+
+	if oldRoute.GetAnnotations()[utils.HTTPRouteSpecHashKey] == newRoute.GetAnnotations()[utils.HTTPRouteSpecHashKey] {
+		return nil
+	}
+	oldRoute.Object["spec"] = newRoute.Object["spec"]
+	annotations := oldRoute.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[utils.HTTPRouteSpecHashKey] = newRoute.GetAnnotations()[utils.HTTPRouteSpecHashKey]
+	oldRoute.SetAnnotations(annotations)
+	if err := r.Update(ctx, oldRoute); err != nil {
+		return err
+	}
+	logger.Info("Updated HTTPRoute", "HTTPRoute", oldRoute.GetName(), "backends", backends)
+	return nil
+}
+
+func getServePort(serveService *corev1.Service) (int32, error) {
+	for _, port := range serveService.Spec.Ports {
+		if port.Name == utils.ServingPortName {
+			return port.Port, nil
+		}
+	}
+	return 0, fmt.Errorf("the serve Service %s doesn't have a port named %s", serveService.Name, utils.ServingPortName)
+}
+
+func (r *RayServiceReconciler) updateStatusForActiveCluster(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	rayServiceInstance.Status.ActiveServiceStatus.RayClusterStatus = rayClusterInstance.Status
+
+	var err error
+	var clientURL string
+	rayServiceStatus := &rayServiceInstance.Status.ActiveServiceStatus
+
+	if clientURL, err = utils.FetchHeadServiceURL(ctx, r.Client, rayClusterInstance, utils.DashboardPortName); err != nil || clientURL == "" {
+		return err
+	}
+
+	rayDashboardClient := r.dashboardClientFunc()
+	rayDashboardClient.InitClient(clientURL)
+
+	var isReady bool
+	if isReady, err = r.getAndCheckServeStatus(ctx, rayDashboardClient, rayServiceStatus); err != nil {
+		return err
+	}
+
+	logger.Info("Check serve health", "isReady", isReady)
+
+	return err
+}
+
+// Reconciles the Serve applications on the RayCluster. Returns (ctrl.Result, isReady, error).
+// The `isReady` flag indicates whether the RayCluster is ready to handle incoming traffic.
+func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, isActive bool) (ctrl.Result, bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	rayServiceInstance.Status.ActiveServiceStatus.RayClusterStatus = rayClusterInstance.Status
+	var err error
+	var clientURL string
 	var rayServiceStatus *rayv1.RayServiceStatus
 
 	// Pick up service status to be updated.
@@ -1081,15 +2233,18 @@ func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceIns
 	rayDashboardClient := r.dashboardClientFunc()
 	rayDashboardClient.InitClient(clientURL)
 
-	shouldUpdate := r.checkIfNeedSubmitServeDeployment(ctx, rayServiceInstance, rayClusterInstance, rayServiceStatus)
+	// If the live Serve applications can't be fetched, the Serve config is not applied again. `Requeue` without
+	// `RequeueAfter` requeues the RayService with the rate limiter's exponential backoff.
+	shouldUpdate, err := r.checkIfNeedSubmitServeDeployment(ctx, rayDashboardClient, rayServiceInstance, rayClusterInstance)
+	if err != nil {
+		err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToGetServeDeploymentStatus, err)
+		return ctrl.Result{Requeue: true}, false, err
+	}
 	if shouldUpdate {
-		if err = r.updateServeDeployment(ctx, rayServiceInstance, rayDashboardClient, rayClusterInstance.Name); err != nil {
+		if err = r.updateServeDeployment(ctx, rayServiceInstance, rayDashboardClient, rayClusterInstance); err != nil {
 			err = r.updateState(ctx, rayServiceInstance, rayv1.WaitForServeDeploymentReady, err)
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, false, err
 		}
-
-		r.Recorder.Eventf(rayServiceInstance, "Normal", "SubmittedServeDeployment",
-			"Controller sent API request to update Serve deployments on cluster %s", rayClusterInstance.Name)
 	}
 
 	var isReady bool
@@ -1102,7 +2257,11 @@ func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceIns
 
 	if isReady {
 		rayServiceInstance.Status.ServiceStatus = rayv1.Running
-		r.updateRayClusterInfo(ctx, rayServiceInstance, rayClusterInstance.Name)
+		// If the traffic is shifted gradually, the pending RayCluster becomes active after the traffic shifting finishes.
+		// With manual promotion, the pending RayCluster becomes active after the promotion is approved.
+		if isActive || (!shouldShiftTrafficGradually(rayServiceInstance) && isPromotionApproved(rayServiceInstance, rayClusterInstance.Name)) {
+			r.updateRayClusterInfo(ctx, rayServiceInstance, rayClusterInstance)
+		}
 		r.Recorder.Event(rayServiceInstance, "Normal", "Running", "The Serve applicaton is now running and healthy.")
 	} else {
 		rayServiceInstance.Status.ServiceStatus = rayv1.WaitForServeDeploymentReady
@@ -1115,7 +2274,10 @@ func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceIns
 	return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, isReady, nil
 }
 
-func (r *RayServiceReconciler) labelHeadPodForServeStatus(ctx context.Context, rayClusterInstance *rayv1.RayCluster) error {
+// labelHeadPodForServeStatus labels the head Pod with `ray.io/serve=true` if its Ray Serve proxies are healthy so that
+// the serve service routes the traffic to it. If the Serve config enables the gRPC proxies, both the HTTP proxy and the
+// gRPC proxy must be healthy, because the serve service exposes both of them.
+func (r *RayServiceReconciler) labelHeadPodForServeStatus(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) error {
 	logger := ctrl.LoggerFrom(ctx)
 	headPod, err := r.getHeadPod(ctx, rayClusterInstance)
 	if err != nil {
@@ -1138,7 +2300,19 @@ func (r *RayServiceReconciler) labelHeadPodForServeStatus(ctx context.Context, r
 		originalLabels[key] = value
 	}
 
-	if httpProxyClient.CheckHealth() == nil {
+	isServing := httpProxyClient.CheckHealth() == nil
+	grpcEnabled, grpcErr := r.checkServeGRPCProxyHealth(rayServiceInstance, headPod)
+	if grpcEnabled && grpcErr != nil {
+		logger.Info("The Ray Serve gRPC proxy on the head Pod is not healthy", "Pod", headPod.Name, "error", grpcErr)
+		isServing = false
+	}
+	// The ServeGRPCProxyReady condition describes the active RayCluster. The head Pod of the pending RayCluster is
+	// checked above only to label it.
+	if rayClusterInstance.Name == rayServiceInstance.Status.ActiveServiceStatus.RayClusterName {
+		setServeGRPCProxyCondition(rayServiceInstance, rayClusterInstance, headPod, grpcEnabled, grpcErr)
+	}
+
+	if isServing {
 		headPod.Labels[utils.RayClusterServingServiceLabelKey] = utils.EnableRayClusterServingServiceTrue
 	} else {
 		headPod.Labels[utils.RayClusterServingServiceLabelKey] = utils.EnableRayClusterServingServiceFalse
@@ -1154,6 +2328,54 @@ func (r *RayServiceReconciler) labelHeadPodForServeStatus(ctx context.Context, r
 	return nil
 }
 
+// updateServeGRPCProxyCondition sets the ServeGRPCProxyReady condition from the health of the Ray Serve gRPC proxy on
+// the head Pod of the active RayCluster. It is used when labelHeadPodForServeStatus checks the pending RayCluster.
+func (r *RayServiceReconciler) updateServeGRPCProxyCondition(ctx context.Context, rayServiceInstance *rayv1.RayService, activeRayCluster *rayv1.RayCluster) error {
+	headPod, err := r.getHeadPod(ctx, activeRayCluster)
+	if err != nil {
+		return err
+	}
+	grpcEnabled, grpcErr := r.checkServeGRPCProxyHealth(rayServiceInstance, headPod)
+	setServeGRPCProxyCondition(rayServiceInstance, activeRayCluster, headPod, grpcEnabled, grpcErr)
+	return nil
+}
+
+// checkServeGRPCProxyHealth checks the health of the Ray Serve gRPC proxy on the head Pod. It returns false if the Serve
+// config doesn't enable the gRPC proxies.
+func (r *RayServiceReconciler) checkServeGRPCProxyHealth(rayServiceInstance *rayv1.RayService, headPod *corev1.Pod) (bool, error) {
+	grpcPort, enabled := utils.GetServeGRPCPort(rayServiceInstance.Spec.ServeConfigV2)
+	if !enabled {
+		return false, nil
+	}
+	rayContainer := headPod.Spec.Containers[utils.RayContainerIndex]
+	grpcProxyClient := r.grpcProxyClientFunc()
+	grpcProxyClient.InitClient()
+	grpcProxyClient.SetHostIp(headPod.Status.PodIP, utils.FindContainerPort(&rayContainer, utils.ServingGRPCPortName, int(grpcPort)))
+	return true, grpcProxyClient.CheckHealth()
+}
+
+func setServeGRPCProxyCondition(rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, headPod *corev1.Pod, grpcEnabled bool, grpcErr error) {
+	if !grpcEnabled {
+		meta.RemoveStatusCondition(&rayServiceInstance.Status.Conditions, string(rayv1.ServeGRPCProxyReady))
+		return
+	}
+	if grpcErr != nil {
+		meta.SetStatusCondition(&rayServiceInstance.Status.Conditions, metav1.Condition{
+			Type:    string(rayv1.ServeGRPCProxyReady),
+			Status:  metav1.ConditionFalse,
+			Reason:  rayv1.GRPCProxyHealthCheckFailedReason,
+			Message: fmt.Sprintf("The Ray Serve gRPC proxy on the head Pod %s of RayCluster %s failed its health check.", headPod.Name, rayClusterInstance.Name),
+		})
+		return
+	}
+	meta.SetStatusCondition(&rayServiceInstance.Status.Conditions, metav1.Condition{
+		Type:    string(rayv1.ServeGRPCProxyReady),
+		Status:  metav1.ConditionTrue,
+		Reason:  rayv1.GRPCProxyHealthCheckSucceededReason,
+		Message: fmt.Sprintf("The Ray Serve gRPC proxy on the head Pod %s of RayCluster %s is healthy.", headPod.Name, rayClusterInstance.Name),
+	})
+}
+
 func getClusterAction(oldSpec rayv1.RayClusterSpec, newSpec rayv1.RayClusterSpec) (ClusterAction, error) {
 	// Return the appropriate action based on the difference in the old and new RayCluster specs.
 
@@ -1187,6 +2409,54 @@ func getClusterAction(oldSpec rayv1.RayClusterSpec, newSpec rayv1.RayClusterSpec
 	return RolloutNew, nil
 }
 
+// getUpgradeType returns the upgrade strategy of the RayService. It defaults to NewCluster.
+func getUpgradeType(rayServiceInstance *rayv1.RayService) rayv1.RayServiceUpgradeType {
+	if rayServiceInstance.Spec.UpgradeStrategy == nil || rayServiceInstance.Spec.UpgradeStrategy.Type == nil {
+		return rayv1.RayServiceUpgradeNewCluster
+	}
+	return *rayServiceInstance.Spec.UpgradeStrategy.Type
+}
+
+// isPromotionApproved returns whether the traffic can be switched from the active RayCluster to the pending RayCluster.
+// With manual promotion, the RayService must be annotated with the name of the pending RayCluster.
+func isPromotionApproved(rayServiceInstance *rayv1.RayService, pendingClusterName string) bool {
+	if rayServiceInstance.Spec.UpgradeStrategy == nil || !rayServiceInstance.Spec.UpgradeStrategy.ManualPromotion {
+		return true
+	}
+	// The first RayCluster of a RayService doesn't need an approval because there is no traffic to switch.
+	if rayServiceInstance.Status.ActiveServiceStatus.RayClusterName == "" {
+		return true
+	}
+	return rayServiceInstance.Annotations[utils.RayServicePromotePendingClusterAnnotationKey] == pendingClusterName
+}
+
+// markAwaitingPromotion records that the pending RayCluster is ready and waits for the manual promotion.
+func (r *RayServiceReconciler) markAwaitingPromotion(ctx context.Context, rayServiceInstance *rayv1.RayService, pendingRayCluster *rayv1.RayCluster) {
+	if meta.IsStatusConditionTrue(rayServiceInstance.Status.Conditions, string(rayv1.AwaitingPromotion)) {
+		return
+	}
+	message := fmt.Sprintf("The pending RayCluster %s is ready. Annotate the RayService with %s=%s to switch the traffic to it.",
+		pendingRayCluster.Name, utils.RayServicePromotePendingClusterAnnotationKey, pendingRayCluster.Name)
+	ctrl.LoggerFrom(ctx).Info(message)
+	r.Recorder.Event(rayServiceInstance, corev1.EventTypeNormal, string(rayv1.AwaitingPromotion), message)
+	meta.SetStatusCondition(&rayServiceInstance.Status.Conditions, metav1.Condition{
+		Type:               string(rayv1.AwaitingPromotion),
+		Status:             metav1.ConditionTrue,
+		Reason:             "ApprovalRequired",
+		Message:            message,
+		ObservedGeneration: rayServiceInstance.Generation,
+	})
+}
+
+// generateRolloutHash returns the hash of the RayClusterSpec and the Serve config that a rollout deploys.
+func generateRolloutHash(rayServiceInstance *rayv1.RayService) (string, error) {
+	clusterHash, err := generateHashWithoutReplicasAndWorkersToDelete(rayServiceInstance.Spec.RayClusterSpec)
+	if err != nil {
+		return "", err
+	}
+	return utils.GenerateJsonHash([]string{clusterHash, rayServiceInstance.Spec.ServeConfigV2})
+}
+
 func generateHashWithoutReplicasAndWorkersToDelete(rayClusterSpec rayv1.RayClusterSpec) (string, error) {
 	// Mute certain fields that will not trigger new RayCluster preparation. For example,
 	// Autoscaler will update `Replicas` and `WorkersToDelete` when scaling up/down.
@@ -1200,6 +2470,50 @@ func generateHashWithoutReplicasAndWorkersToDelete(rayClusterSpec rayv1.RayClust
 	return utils.GenerateJsonHash(updatedRayClusterSpec)
 }
 
+// generateHashWithoutWorkerGroups generates the hash of the RayClusterSpec except for the WorkerGroupSpecs.
+func generateHashWithoutWorkerGroups(rayClusterSpec rayv1.RayClusterSpec) (string, error) {
+	updatedRayClusterSpec := rayClusterSpec.DeepCopy()
+	updatedRayClusterSpec.WorkerGroupSpecs = nil
+	return utils.GenerateJsonHash(updatedRayClusterSpec)
+}
+
+// getModifiedWorkerGroups returns the names of the worker groups in both RayClusterSpecs whose Pods must be recreated to
+// apply the goal RayClusterSpec. Changes to the replicas and WorkersToDelete don't require recreating Pods.
+func getModifiedWorkerGroups(currentSpec rayv1.RayClusterSpec, goalSpec rayv1.RayClusterSpec) ([]string, error) {
+	hashWorkerGroup := func(workerGroup rayv1.WorkerGroupSpec) (string, error) {
+		workerGroup.Replicas = nil
+		workerGroup.MinReplicas = nil
+		workerGroup.MaxReplicas = nil
+		workerGroup.ScaleStrategy = rayv1.ScaleStrategy{}
+		return utils.GenerateJsonHash(workerGroup)
+	}
+
+	currentHashes := make(map[string]string, len(currentSpec.WorkerGroupSpecs))
+	for _, workerGroup := range currentSpec.WorkerGroupSpecs {
+		hash, err := hashWorkerGroup(workerGroup)
+		if err != nil {
+			return nil, err
+		}
+		currentHashes[workerGroup.GroupName] = hash
+	}
+
+	var modifiedWorkerGroups []string
+	for _, workerGroup := range goalSpec.WorkerGroupSpecs {
+		currentHash, ok := currentHashes[workerGroup.GroupName]
+		if !ok {
+			continue
+		}
+		goalHash, err := hashWorkerGroup(workerGroup)
+		if err != nil {
+			return nil, err
+		}
+		if currentHash != goalHash {
+			modifiedWorkerGroups = append(modifiedWorkerGroups, workerGroup.GroupName)
+		}
+	}
+	return modifiedWorkerGroups, nil
+}
+
 func compareRayClusterJsonHash(spec1 rayv1.RayClusterSpec, spec2 rayv1.RayClusterSpec, hashFunc func(rayv1.RayClusterSpec) (string, error)) (bool, error) {
 	hash1, err1 := hashFunc(spec1)
 	if err1 != nil {
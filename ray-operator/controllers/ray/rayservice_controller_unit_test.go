@@ -11,16 +11,22 @@ import (
 
 	cmap "github.com/orcaman/concurrent-map/v2"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	"github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 func TestGenerateHashWithoutReplicasAndWorkersToDelete(t *testing.T) {
@@ -418,6 +424,246 @@ func TestReconcileServices_UpdateService(t *testing.T) {
 	assert.False(t, reflect.DeepEqual(*oldSvc, svcList.Items[0]))
 }
 
+func TestLabelHeadPodForServeStatus(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	cluster := rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+		},
+	}
+	grpcServeConfig := `grpc_options:
+  grpc_servicer_functions:
+    - user_defined_protos_pb2_grpc.add_UserDefinedServiceServicer_to_server
+applications: []`
+
+	tests := map[string]struct {
+		serveConfigV2       string
+		grpcHealthCheckErr  error
+		isPending           bool
+		expectedLabel       string
+		expectedGRPCReady   metav1.ConditionStatus
+		expectGRPCCondition bool
+	}{
+		"gRPC proxies are disabled": {
+			serveConfigV2:       "applications: []",
+			expectedLabel:       utils.EnableRayClusterServingServiceTrue,
+			expectGRPCCondition: false,
+		},
+		"gRPC proxy is healthy": {
+			serveConfigV2:       grpcServeConfig,
+			expectedLabel:       utils.EnableRayClusterServingServiceTrue,
+			expectedGRPCReady:   metav1.ConditionTrue,
+			expectGRPCCondition: true,
+		},
+		"gRPC proxy is unhealthy": {
+			serveConfigV2:       grpcServeConfig,
+			grpcHealthCheckErr:  fmt.Errorf("connection refused"),
+			expectedLabel:       utils.EnableRayClusterServingServiceFalse,
+			expectedGRPCReady:   metav1.ConditionFalse,
+			expectGRPCCondition: true,
+		},
+		"gRPC proxy of the pending RayCluster is unhealthy": {
+			serveConfigV2:       grpcServeConfig,
+			grpcHealthCheckErr:  fmt.Errorf("connection refused"),
+			isPending:           true,
+			expectedLabel:       utils.EnableRayClusterServingServiceFalse,
+			expectGRPCCondition: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			headPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "head-pod",
+					Namespace: cluster.Namespace,
+					Labels: map[string]string{
+						utils.RayClusterLabelKey:  cluster.Name,
+						utils.RayNodeTypeLabelKey: string(rayv1.HeadNode),
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "ray-head"}},
+				},
+			}
+			rayService := rayv1.RayService{
+				Spec: rayv1.RayServiceSpec{ServeConfigV2: tc.serveConfigV2},
+			}
+			// The ServeGRPCProxyReady condition only reflects the active RayCluster.
+			if tc.isPending {
+				rayService.Status.ActiveServiceStatus.RayClusterName = "active-cluster"
+				rayService.Status.PendingServiceStatus.RayClusterName = cluster.Name
+			} else {
+				rayService.Status.ActiveServiceStatus.RayClusterName = cluster.Name
+			}
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(headPod).Build()
+			r := &RayServiceReconciler{
+				Client:   fakeClient,
+				Recorder: &record.FakeRecorder{},
+				Scheme:   scheme.Scheme,
+				httpProxyClientFunc: func() utils.RayHttpProxyClientInterface {
+					return &utils.FakeRayHttpProxyClient{}
+				},
+				grpcProxyClientFunc: func() utils.RayGrpcProxyClientInterface {
+					return &utils.FakeRayGrpcProxyClient{HealthCheckErr: tc.grpcHealthCheckErr}
+				},
+			}
+
+			err := r.labelHeadPodForServeStatus(context.TODO(), &rayService, &cluster)
+			assert.Nil(t, err)
+
+			pod := corev1.Pod{}
+			err = fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(headPod), &pod)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedLabel, pod.Labels[utils.RayClusterServingServiceLabelKey])
+
+			condition := meta.FindStatusCondition(rayService.Status.Conditions, string(rayv1.ServeGRPCProxyReady))
+			if !tc.expectGRPCCondition {
+				assert.Nil(t, condition)
+				return
+			}
+			assert.NotNil(t, condition)
+			assert.Equal(t, tc.expectedGRPCReady, condition.Status)
+		})
+	}
+}
+
+func TestReconcileServeApplicationServices(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = networkingv1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	cluster := rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "ray-head",
+								Ports: []corev1.ContainerPort{{Name: utils.ServingPortName, ContainerPort: 8000}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	ingressClassName := "nginx"
+	rayService := rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2: `applications:
+  - name: fruit_app
+    import_path: fruit.deployment_graph
+    route_prefix: /fruit
+  - name: math_app
+    import_path: conditional_dag.serve_dag`,
+			ApplicationServices: []rayv1.ServeApplicationService{
+				{
+					ApplicationName: "fruit_app",
+					Ingress:         &rayv1.ServeApplicationIngress{IngressClassName: &ingressClassName},
+				},
+				{
+					ApplicationName: "math_app",
+					Service: &corev1.Service{
+						Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+					},
+					Ingress: &rayv1.ServeApplicationIngress{IngressClassName: &ingressClassName},
+				},
+			},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).Build()
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+	ctx := context.TODO()
+
+	// Test 1: A Service and an Ingress are created for each application. The path of each Ingress is the route prefix
+	// of the application, which defaults to "/".
+	err := r.reconcileServeApplicationServices(ctx, &rayService, &cluster)
+	assert.Nil(t, err)
+
+	svcList := corev1.ServiceList{}
+	err = fakeClient.List(ctx, &svcList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(svcList.Items))
+	mathSvc := corev1.Service{}
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "test-service-math-app-serve-svc"}, &mathSvc)
+	assert.Nil(t, err)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, mathSvc.Spec.Type)
+	assert.Equal(t, "test-cluster", mathSvc.Spec.Selector[utils.RayClusterLabelKey])
+
+	ingressList := networkingv1.IngressList{}
+	err = fakeClient.List(ctx, &ingressList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ingressList.Items))
+	fruitIngress := networkingv1.Ingress{}
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "test-service-fruit-app-serve-ingress"}, &fruitIngress)
+	assert.Nil(t, err)
+	assert.Equal(t, "/fruit", fruitIngress.Spec.Rules[0].HTTP.Paths[0].Path)
+	assert.Equal(t, "test-service-fruit-app-serve-svc", fruitIngress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+	mathIngress := networkingv1.Ingress{}
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "test-service-math-app-serve-ingress"}, &mathIngress)
+	assert.Nil(t, err)
+	assert.Equal(t, "/", mathIngress.Spec.Rules[0].HTTP.Paths[0].Path)
+
+	// Test 2: When the RayCluster switches, the Services select the Pods of the new RayCluster.
+	cluster.Name = "new-cluster"
+	err = r.reconcileServeApplicationServices(ctx, &rayService, &cluster)
+	assert.Nil(t, err)
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "test-service-math-app-serve-svc"}, &mathSvc)
+	assert.Nil(t, err)
+	assert.Equal(t, "new-cluster", mathSvc.Spec.Selector[utils.RayClusterLabelKey])
+
+	// Test 3: The Service and the Ingress of an application that is no longer listed are deleted, and so is the
+	// Ingress of an application that is no longer exposed through an Ingress.
+	rayService.Spec.ApplicationServices = []rayv1.ServeApplicationService{{ApplicationName: "fruit_app"}}
+	err = r.reconcileServeApplicationServices(ctx, &rayService, &cluster)
+	assert.Nil(t, err)
+
+	svcList = corev1.ServiceList{}
+	err = fakeClient.List(ctx, &svcList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(svcList.Items))
+	assert.Equal(t, "test-service-fruit-app-serve-svc", svcList.Items[0].Name)
+	ingressList = networkingv1.IngressList{}
+	err = fakeClient.List(ctx, &ingressList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ingressList.Items))
+
+	// Test 4: The serve service of the RayService is not deleted.
+	serveSvc, err := common.BuildServeServiceForRayService(ctx, rayService, cluster)
+	assert.Nil(t, err)
+	err = fakeClient.Create(ctx, serveSvc)
+	assert.Nil(t, err)
+	rayService.Spec.ApplicationServices = nil
+	err = r.reconcileServeApplicationServices(ctx, &rayService, &cluster)
+	assert.Nil(t, err)
+	svcList = corev1.ServiceList{}
+	err = fakeClient.List(ctx, &svcList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(svcList.Items))
+	assert.Equal(t, serveSvc.Name, svcList.Items[0].Name)
+}
+
 func TestFetchHeadServiceURL(t *testing.T) {
 	// Create a new scheme with CRDs, Pod, Service schemes.
 	newScheme := runtime.NewScheme()
@@ -632,10 +878,9 @@ func TestCheckIfNeedSubmitServeDeployment(t *testing.T) {
 
 	// Initialize RayService reconciler.
 	r := RayServiceReconciler{
-		Client:       fakeClient,
-		Recorder:     &record.FakeRecorder{},
-		Scheme:       scheme.Scheme,
-		ServeConfigs: cmap.New[string](),
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
 	}
 
 	namespace := "ray"
@@ -656,45 +901,42 @@ applications:
 - name: myapp
   import_path: fruit.deployment_graph
   runtime_env:
-  working_dir: "https://github.com/ray-project/test_dag/archive/41d09119cbdf8450599f993f51318e9e27c59098.zip"
+    working_dir: "https://github.com/ray-project/test_dag/archive/41d09119cbdf8450599f993f51318e9e27c59098.zip"
   deployments:
   - name: MangoStand
-	num_replicas: 1
-	user_config:
-	price: 3
-	ray_actor_options:
-	num_cpus: 0.1`,
+    num_replicas: 1
+    user_config:
+      price: 3
+    ray_actor_options:
+      num_cpus: 0.1`,
 		},
 	}
 	ctx := context.Background()
+	fakeDashboardClient := &utils.FakeRayDashboardClient{}
 
 	// Test 1: The RayCluster is new, and this is the first reconciliation after the RayCluster becomes ready.
-	// No Serve application has been created yet, so the RayService's serve configuration has not been cached in
-	// `r.ServeConfigs`.
-	cacheKey := r.generateConfigKey(&rayService, cluster.Name)
-	_, exist := r.ServeConfigs.Get(cacheKey)
-	assert.False(t, exist)
-	shouldCreate := r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &rayv1.RayServiceStatus{})
+	// No Serve application has been created yet, so the hash of the Serve config has not been recorded in the
+	// RayCluster's annotations.
+	shouldCreate, err := r.checkIfNeedSubmitServeDeployment(ctx, fakeDashboardClient, &rayService, &cluster)
+	assert.Nil(t, err)
 	assert.True(t, shouldCreate)
 
 	// Test 2: The RayCluster is not new, but the head Pod without GCS FT-enabled crashes and restarts.
-	// Hence, the RayService's Serve application status is empty, but the KubeRay operator has cached the Serve
-	// application's configuration.
-	r.ServeConfigs.Set(cacheKey, rayService.Spec.ServeConfigV2) // Simulate the Serve application's configuration has been cached.
-	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &rayv1.RayServiceStatus{})
+	// Hence, no Serve application is live, but the hash of the Serve config has been recorded in the RayCluster's annotations.
+	serveConfigHash, err := generateServeConfigHash(rayService.Spec.ServeConfigV2)
+	assert.Nil(t, err)
+	cluster.Annotations = map[string]string{utils.ServeConfigHashKey: serveConfigHash}
+	shouldCreate, err = r.checkIfNeedSubmitServeDeployment(ctx, fakeDashboardClient, &rayService, &cluster)
+	assert.Nil(t, err)
 	assert.True(t, shouldCreate)
 
-	// Test 3: The Serve application has been created, and the RayService's status has been updated.
-	_, exist = r.ServeConfigs.Get(cacheKey)
-	assert.True(t, exist)
-	serveStatus := rayv1.RayServiceStatus{
-		Applications: map[string]rayv1.AppStatus{
-			"myapp": {
-				Status: rayv1.ApplicationStatusEnum.RUNNING,
-			},
-		},
-	}
-	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &serveStatus)
+	// Test 3: The Serve application has been created. The decision doesn't depend on the operator's memory, so it
+	// is the same after the operator restarts.
+	fakeDashboardClient.SetMultiApplicationStatuses(map[string]*utils.ServeApplicationStatus{
+		"myapp": {Name: "myapp", Status: rayv1.ApplicationStatusEnum.RUNNING},
+	})
+	shouldCreate, err = r.checkIfNeedSubmitServeDeployment(ctx, fakeDashboardClient, &rayService, &cluster)
+	assert.Nil(t, err)
 	assert.False(t, shouldCreate)
 
 	// Test 4: The Serve application has been created, but the Serve config has been updated.
@@ -703,8 +945,146 @@ applications:
 applications:
 - name: new_app_name
   import_path: fruit.deployment_graph`
-	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &serveStatus)
+	shouldCreate, err = r.checkIfNeedSubmitServeDeployment(ctx, fakeDashboardClient, &rayService, &cluster)
+	assert.Nil(t, err)
+	assert.True(t, shouldCreate)
+
+	// Test 5: The hash of the Serve config matches, but an application in the Serve config is not live.
+	serveConfigHash, err = generateServeConfigHash(rayService.Spec.ServeConfigV2)
+	assert.Nil(t, err)
+	cluster.Annotations[utils.ServeConfigHashKey] = serveConfigHash
+	shouldCreate, err = r.checkIfNeedSubmitServeDeployment(ctx, fakeDashboardClient, &rayService, &cluster)
+	assert.Nil(t, err)
 	assert.True(t, shouldCreate)
+
+	// Test 6: The hash of the Serve config matches, but the dashboard fails to return the live Serve applications.
+	// The failure is not treated as a mismatch, so the Serve config is not applied again.
+	fakeDashboardClient.SetServeDetailsError(fmt.Errorf("connection refused"))
+	shouldCreate, err = r.checkIfNeedSubmitServeDeployment(ctx, fakeDashboardClient, &rayService, &cluster)
+	assert.NotNil(t, err)
+	assert.False(t, shouldCreate)
+}
+
+func TestUpdateServeDeployment(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "ray",
+		},
+	}
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: "ray",
+		},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2: `
+applications:
+- name: myapp
+  import_path: fruit.deployment_graph`,
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster).Build()
+	r := RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+	ctx := context.Background()
+
+	err := r.updateServeDeployment(ctx, rayService, &utils.FakeRayDashboardClient{}, cluster.DeepCopy())
+	assert.Nil(t, err)
+
+	// The hash of the applied Serve config is persisted in the RayCluster's annotations.
+	serveConfigHash, err := generateServeConfigHash(rayService.Spec.ServeConfigV2)
+	assert.Nil(t, err)
+	updatedCluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(cluster), updatedCluster)
+	assert.Nil(t, err)
+	assert.Equal(t, serveConfigHash, updatedCluster.Annotations[utils.ServeConfigHashKey])
+	assert.Contains(t, updatedCluster.Annotations, utils.ServeApplicationHashesKey)
+
+	// Only the changed Serve application is reported as updated.
+	recorder := record.NewFakeRecorder(1)
+	r.Recorder = recorder
+	rayService.Spec.ServeConfigV2 = `
+applications:
+- name: myapp
+  import_path: fruit.deployment_graph
+  route_prefix: /fruit
+- name: newapp
+  import_path: conditional_dag.serve_dag`
+	err = r.updateServeDeployment(ctx, rayService, &utils.FakeRayDashboardClient{}, updatedCluster)
+	assert.Nil(t, err)
+	assert.Contains(t, <-recorder.Events, "created applications: [newapp], updated applications: [myapp], deleted applications: []")
+
+	// A change of a field unknown to KubeRay is reported as well.
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(cluster), updatedCluster)
+	assert.Nil(t, err)
+	rayService.Spec.ServeConfigV2 = `
+applications:
+- name: myapp
+  import_path: fruit.deployment_graph
+  route_prefix: /fruit
+- name: newapp
+  import_path: conditional_dag.serve_dag
+  external_scaler_enabled: true`
+	err = r.updateServeDeployment(ctx, rayService, &utils.FakeRayDashboardClient{}, updatedCluster)
+	assert.Nil(t, err)
+	assert.Contains(t, <-recorder.Events, "created applications: [], updated applications: [newapp], deleted applications: []")
+}
+
+func TestUpdateRayClusterInstanceKeepsServeHashes(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "ray",
+			Annotations: map[string]string{
+				utils.ServeConfigHashKey:        "config-hash",
+				utils.ServeApplicationHashesKey: `{"myapp":"app-hash"}`,
+			},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster).Build()
+	r := RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+	ctx := context.Background()
+
+	// The RayCluster is rebuilt from the RayService, without the annotations recording the applied Serve config.
+	rebuiltCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-cluster",
+			Namespace:   "ray",
+			Annotations: map[string]string{"key": "value"},
+		},
+	}
+	err := r.updateRayClusterInstance(ctx, rebuiltCluster)
+	assert.Nil(t, err)
+
+	updatedCluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(cluster), updatedCluster)
+	assert.Nil(t, err)
+	assert.Equal(t, "value", updatedCluster.Annotations["key"])
+	assert.Equal(t, "config-hash", updatedCluster.Annotations[utils.ServeConfigHashKey])
+	assert.Equal(t, `{"myapp":"app-hash"}`, updatedCluster.Annotations[utils.ServeApplicationHashesKey])
+}
+
+func TestDiffServeApplications(t *testing.T) {
+	previousAppHashes := map[string]string{"unchanged": "hash1", "updated": "hash2", "deleted": "hash3"}
+	appHashes := map[string]string{"unchanged": "hash1", "updated": "new-hash2", "created": "hash4"}
+	created, updated, deleted := diffServeApplications(previousAppHashes, appHashes)
+	assert.Equal(t, []string{"created"}, created)
+	assert.Equal(t, []string{"updated"}, updated)
+	assert.Equal(t, []string{"deleted"}, deleted)
 }
 
 func TestReconcileRayCluster(t *testing.T) {
@@ -740,6 +1120,7 @@ func TestReconcileRayCluster(t *testing.T) {
 		activeCluster           *rayv1.RayCluster
 		updateRayClusterSpec    bool
 		enableZeroDowntime      bool
+		rolloutFailed           bool
 		shouldPrepareNewCluster bool
 	}{
 		// Test 1: Neither active nor pending clusters exist. The `markRestart` function will be called, so the `PendingServiceStatus.RayClusterName` should be set.
@@ -777,6 +1158,14 @@ func TestReconcileRayCluster(t *testing.T) {
 			enableZeroDowntime:      false,
 			shouldPrepareNewCluster: true,
 		},
+		// Test 6: The active cluster exists, but the rollout of the goal config has failed before.
+		"Zero-downtime upgrade is enabled. The rollout of the goal config has failed before.": {
+			activeCluster:           activeCluster.DeepCopy(),
+			updateRayClusterSpec:    true,
+			enableZeroDowntime:      true,
+			rolloutFailed:           true,
+			shouldPrepareNewCluster: false,
+		},
 	}
 
 	for name, tc := range tests {
@@ -801,6 +1190,10 @@ func TestReconcileRayCluster(t *testing.T) {
 			if tc.activeCluster != nil {
 				service.Status.ActiveServiceStatus.RayClusterName = tc.activeCluster.Name
 			}
+			if tc.rolloutFailed {
+				service.Status.RolloutFailedHash, err = generateRolloutHash(service)
+				assert.Nil(t, err)
+			}
 			assert.Equal(t, "", service.Status.PendingServiceStatus.RayClusterName)
 			_, _, err = r.reconcileRayCluster(ctx, service)
 			assert.Nil(t, err)
@@ -815,9 +1208,830 @@ func TestReconcileRayCluster(t *testing.T) {
 	}
 }
 
+func TestSuspendRayService(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	ctx := context.TODO()
+	namespace := "ray"
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayServiceSpec{
+			Suspend: true,
+		},
+		Status: rayv1.RayServiceStatuses{
+			ServiceStatus:        rayv1.Running,
+			NumServeEndpoints:    2,
+			ServeConfigHash:      "serve-config-hash",
+			ActiveServiceStatus:  rayv1.RayServiceStatus{RayClusterName: "active-cluster"},
+			PendingServiceStatus: rayv1.RayServiceStatus{RayClusterName: "pending-cluster"},
+			Conditions: []metav1.Condition{
+				{Type: string(rayv1.AwaitingPromotion), Status: metav1.ConditionTrue, Reason: "PendingClusterReady"},
+			},
+		},
+	}
+	clusterLabels := map[string]string{
+		utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+	}
+	activeCluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "active-cluster", Namespace: namespace, Labels: clusterLabels}}
+	pendingCluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "pending-cluster", Namespace: namespace, Labels: clusterLabels}}
+	otherCluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "other-cluster", Namespace: namespace}}
+	serveService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: utils.GenerateServeServiceName(rayService.Name), Namespace: namespace, Labels: clusterLabels}}
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayService.DeepCopy(), activeCluster, pendingCluster, otherCluster, serveService).
+		WithStatusSubresource(&rayv1.RayService{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RayServiceReconciler{
+		Client:                       fakeClient,
+		Recorder:                     recorder,
+		Scheme:                       scheme.Scheme,
+		RayClusterDeletionTimestamps: cmap.New[time.Time](),
+	}
+
+	err := fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), rayService)
+	assert.Nil(t, err)
+
+	// Test 1: The RayClusters of the RayService are deleted, while the other RayClusters and the serve service are kept.
+	original := rayService.DeepCopy()
+	_, err = r.suspendRayService(ctx, original, rayService)
+	assert.Nil(t, err)
+
+	rayClusterList := rayv1.RayClusterList{}
+	err = fakeClient.List(ctx, &rayClusterList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rayClusterList.Items))
+	assert.Equal(t, otherCluster.Name, rayClusterList.Items[0].Name)
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(serveService), &corev1.Service{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, rayv1.ServiceSuspended, rayService.Status.ServiceStatus)
+	assert.Equal(t, int32(0), rayService.Status.NumServeEndpoints)
+	assert.Equal(t, "", rayService.Status.ActiveServiceStatus.RayClusterName)
+	assert.Equal(t, "", rayService.Status.PendingServiceStatus.RayClusterName)
+	assert.Equal(t, "serve-config-hash", rayService.Status.ServeConfigHash)
+	assert.True(t, meta.IsStatusConditionTrue(rayService.Status.Conditions, string(rayv1.RayServiceSuspended)))
+	assert.Nil(t, meta.FindStatusCondition(rayService.Status.Conditions, string(rayv1.AwaitingPromotion)))
+	assert.Equal(t, 1, len(recorder.Events))
+
+	// The status is persisted.
+	persisted := rayv1.RayService{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), &persisted)
+	assert.Nil(t, err)
+	assert.Equal(t, rayv1.ServiceSuspended, persisted.Status.ServiceStatus)
+
+	// Test 2: Reconciling the suspended RayService again doesn't emit another event.
+	original = rayService.DeepCopy()
+	_, err = r.suspendRayService(ctx, original, rayService)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(recorder.Events))
+
+	// Test 3: After the RayService is resumed, a new RayCluster is prepared.
+	rayService.Spec.Suspend = false
+	_, _, err = r.reconcileRayCluster(ctx, rayService)
+	assert.Nil(t, err)
+	assert.NotEqual(t, "", rayService.Status.PendingServiceStatus.RayClusterName)
+	assert.Equal(t, rayv1.Restarting, rayService.Status.ServiceStatus)
+}
+
 func initFakeDashboardClient(appName string, deploymentStatus string, appStatus string) utils.RayDashboardClientInterface {
 	fakeDashboardClient := utils.FakeRayDashboardClient{}
 	status := generateServeStatus(deploymentStatus, appStatus)
 	fakeDashboardClient.SetMultiApplicationStatuses(map[string]*utils.ServeApplicationStatus{appName: &status})
 	return &fakeDashboardClient
 }
+
+func TestReconcileTrafficShifting(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	newScheme.AddKnownTypeWithName(common.HTTPRouteGroupVersionKind, &unstructured.Unstructured{})
+
+	namespace := "ray"
+	newRayCluster := func(name string, hash string) *rayv1.RayCluster {
+		return &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: map[string]string{utils.HashWithoutReplicasAndWorkersToDeleteKey: hash},
+			},
+			Spec: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "ray-head",
+									Ports: []corev1.ContainerPort{{Name: utils.ServingPortName, ContainerPort: 8000}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	activeRayCluster := newRayCluster("active-cluster", "active-hash")
+	pendingRayCluster := newRayCluster("pending-cluster", "pending-hash")
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+			UID:       "test-service-uid",
+		},
+		Spec: rayv1.RayServiceSpec{
+			RayClusterSpec: pendingRayCluster.Spec,
+			TrafficShifting: &rayv1.TrafficShiftingSpec{
+				Gateway: rayv1.GatewayReference{Name: "gateway"},
+				Steps: []rayv1.TrafficShiftingStep{
+					{Weight: 10, SoakSeconds: 60},
+					{Weight: 100},
+				},
+			},
+		},
+		Status: rayv1.RayServiceStatuses{
+			ActiveServiceStatus:  rayv1.RayServiceStatus{RayClusterName: activeRayCluster.Name},
+			PendingServiceStatus: rayv1.RayServiceStatus{RayClusterName: pendingRayCluster.Name},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(activeRayCluster, pendingRayCluster).Build()
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+	ctx := context.TODO()
+	getBackendWeights := func() map[string]int64 {
+		httpRoute := &unstructured.Unstructured{}
+		httpRoute.SetGroupVersionKind(common.HTTPRouteGroupVersionKind)
+		err := fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: utils.GenerateHTTPRouteName(rayService.Name)}, httpRoute)
+		assert.Nil(t, err)
+		rules, _, _ := unstructured.NestedSlice(httpRoute.Object, "spec", "rules")
+		weights := make(map[string]int64)
+		for _, backendRef := range rules[0].(map[string]interface{})["backendRefs"].([]interface{}) {
+			backendRef := backendRef.(map[string]interface{})
+			weights[backendRef["name"].(string)] = backendRef["weight"].(int64)
+		}
+		return weights
+	}
+	activeServiceName := utils.GenerateServeServiceName(activeRayCluster.Name)
+	pendingServiceName := utils.GenerateServeServiceName(pendingRayCluster.Name)
+
+	// The traffic shifting doesn't start until the pending RayCluster is ready.
+	assert.True(t, shouldShiftTrafficGradually(rayService))
+	isShiftingFinished, err := r.reconcileTrafficShifting(ctx, rayService, activeRayCluster, pendingRayCluster, false)
+	assert.Nil(t, err)
+	assert.False(t, isShiftingFinished)
+	assert.Nil(t, rayService.Status.TrafficShifting)
+
+	// The first step routes 10% of the traffic to the pending RayCluster.
+	isShiftingFinished, err = r.reconcileTrafficShifting(ctx, rayService, activeRayCluster, pendingRayCluster, true)
+	assert.Nil(t, err)
+	assert.False(t, isShiftingFinished)
+	assert.Equal(t, int32(10), rayService.Status.TrafficShifting.PendingClusterWeight)
+	assert.Equal(t, map[string]int64{activeServiceName: 90, pendingServiceName: 10}, getBackendWeights())
+
+	// The weights don't change during the soak.
+	isShiftingFinished, err = r.reconcileTrafficShifting(ctx, rayService, activeRayCluster, pendingRayCluster, true)
+	assert.Nil(t, err)
+	assert.False(t, isShiftingFinished)
+	assert.Equal(t, int32(0), rayService.Status.TrafficShifting.Step)
+
+	// After the soak, the second step routes all traffic to the pending RayCluster.
+	rayService.Status.TrafficShifting.StepStartTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	isShiftingFinished, err = r.reconcileTrafficShifting(ctx, rayService, activeRayCluster, pendingRayCluster, true)
+	assert.Nil(t, err)
+	assert.False(t, isShiftingFinished)
+	assert.Equal(t, int32(1), rayService.Status.TrafficShifting.Step)
+	assert.Equal(t, map[string]int64{activeServiceName: 0, pendingServiceName: 100}, getBackendWeights())
+
+	// The traffic shifting finishes after the last step.
+	isShiftingFinished, err = r.reconcileTrafficShifting(ctx, rayService, activeRayCluster, pendingRayCluster, true)
+	assert.Nil(t, err)
+	assert.True(t, isShiftingFinished)
+
+	// The upgrade is aborted if a Serve application on the pending RayCluster becomes unhealthy during the traffic shifting.
+	rayService.Status.TrafficShifting = &rayv1.TrafficShiftingStatus{Step: 0, PendingClusterWeight: 10, StepStartTime: &metav1.Time{Time: time.Now()}}
+	rayService.Status.PendingServiceStatus.Applications = map[string]rayv1.AppStatus{
+		utils.DefaultServeAppName: {Status: rayv1.ApplicationStatusEnum.UNHEALTHY},
+	}
+	isShiftingFinished, err = r.reconcileTrafficShifting(ctx, rayService, activeRayCluster, pendingRayCluster, false)
+	assert.Nil(t, err)
+	assert.False(t, isShiftingFinished)
+	rolloutHash, err := generateRolloutHash(rayService)
+	assert.Nil(t, err)
+	assert.Equal(t, rolloutHash, rayService.Status.RolloutFailedHash)
+	assert.True(t, meta.IsStatusConditionTrue(rayService.Status.Conditions, string(rayv1.RolloutFailed)))
+	assert.Equal(t, rayv1.ServeApplicationUnhealthyReason, meta.FindStatusCondition(rayService.Status.Conditions, string(rayv1.RolloutFailed)).Reason)
+	assert.Equal(t, "pending-hash", rayService.Status.TrafficShifting.AbortedRayClusterHash)
+	assert.Empty(t, rayService.Status.PendingServiceStatus.RayClusterName)
+	assert.False(t, isTrafficShiftingInProgress(rayService))
+
+	// After the abort, all traffic is routed to the Kubernetes serve Service, and the serve Services of the RayClusters are deleted.
+	rayService.Status.ActiveServiceStatus.RayClusterName = activeRayCluster.Name
+	err = r.reconcileServeHTTPRoute(ctx, rayService, activeRayCluster)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{utils.GenerateServeServiceName(rayService.Name): 100}, getBackendWeights())
+	svcList := corev1.ServiceList{}
+	err = fakeClient.List(ctx, &svcList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	assert.Empty(t, svcList.Items)
+}
+
+func TestIsUpgradeTimedOut(t *testing.T) {
+	pendingRayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "pending-cluster",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+		},
+	}
+
+	tests := map[string]struct {
+		upgradeTimeoutSeconds *int32
+		trafficShifting       *rayv1.TrafficShiftingStatus
+		expected              bool
+	}{
+		"UpgradeTimeoutSeconds is not set": {
+			upgradeTimeoutSeconds: nil,
+			expected:              false,
+		},
+		"The pending RayCluster is within the timeout": {
+			upgradeTimeoutSeconds: pointer.Int32(300),
+			expected:              false,
+		},
+		"The pending RayCluster exceeds the timeout": {
+			upgradeTimeoutSeconds: pointer.Int32(60),
+			expected:              true,
+		},
+		"The traffic shifting has started": {
+			upgradeTimeoutSeconds: pointer.Int32(60),
+			trafficShifting:       &rayv1.TrafficShiftingStatus{StepStartTime: &metav1.Time{Time: time.Now()}},
+			expected:              false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayService := &rayv1.RayService{
+				Spec:   rayv1.RayServiceSpec{UpgradeTimeoutSeconds: tc.upgradeTimeoutSeconds},
+				Status: rayv1.RayServiceStatuses{TrafficShifting: tc.trafficShifting},
+			}
+			assert.Equal(t, tc.expected, isUpgradeTimedOut(rayService, pendingRayCluster))
+		})
+	}
+}
+
+func TestMarkRolloutFailed(t *testing.T) {
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "ray"},
+		Spec:       rayv1.RayServiceSpec{ServeConfigV2: "applications: []"},
+		Status: rayv1.RayServiceStatuses{
+			PendingServiceStatus: rayv1.RayServiceStatus{
+				RayClusterName: "pending-cluster",
+				Applications: map[string]rayv1.AppStatus{
+					utils.DefaultServeAppName: {
+						Status:  rayv1.ApplicationStatusEnum.DEPLOYING,
+						Message: "Deploying app",
+						Deployments: map[string]rayv1.ServeDeploymentStatus{
+							"model": {Status: rayv1.DeploymentStatusEnum.UPDATING, Message: "Waiting for replicas"},
+						},
+					},
+				},
+			},
+		},
+	}
+	recorder := record.NewFakeRecorder(1)
+	r := &RayServiceReconciler{Recorder: recorder}
+	pendingRayCluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "pending-cluster"}}
+
+	message := describeServeStatus(rayService.Status.PendingServiceStatus)
+	assert.Equal(t, "Application default: DEPLOYING Deploying app; Deployment default/model: UPDATING Waiting for replicas", message)
+	r.markRolloutFailed(context.TODO(), rayService, pendingRayCluster, rayv1.UpgradeTimeoutReason, message)
+
+	rolloutHash, err := generateRolloutHash(rayService)
+	assert.Nil(t, err)
+	assert.Equal(t, rolloutHash, rayService.Status.RolloutFailedHash)
+	condition := meta.FindStatusCondition(rayService.Status.Conditions, string(rayv1.RolloutFailed))
+	assert.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, rayv1.UpgradeTimeoutReason, condition.Reason)
+	assert.Equal(t, message, condition.Message)
+	assert.Empty(t, rayService.Status.PendingServiceStatus.RayClusterName)
+	assert.Len(t, recorder.Events, 1)
+
+	// Preparing a new RayCluster for a changed spec clears the failure.
+	r.markRestartAndAddPendingClusterName(context.TODO(), rayService)
+	assert.Empty(t, rayService.Status.RolloutFailedHash)
+	assert.Nil(t, meta.FindStatusCondition(rayService.Status.Conditions, string(rayv1.RolloutFailed)))
+}
+
+func TestRecordRolloutHistory(t *testing.T) {
+	ctx := context.TODO()
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "ray"},
+		Spec:       rayv1.RayServiceSpec{ServeConfigV2: "applications: []"},
+	}
+	r := &RayServiceReconciler{Recorder: record.NewFakeRecorder(10)}
+
+	// Test 1: The first promoted RayCluster is recorded as the active rollout.
+	rayService.Status.PendingServiceStatus.RayClusterName = "cluster-1"
+	r.updateRayClusterInfo(ctx, rayService, &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"}})
+	history := rayService.Status.RolloutHistory
+	assert.Len(t, history, 1)
+	assert.Equal(t, "cluster-1", history[0].RayClusterName)
+	assert.Equal(t, rayv1.RolloutOutcomeActive, history[0].Outcome)
+	assert.NotNil(t, history[0].ActiveStartTime)
+	assert.Nil(t, history[0].ActiveEndTime)
+	revision1, err := generateRevisionName(rayService, getRolloutSnapshot(rayService))
+	assert.Nil(t, err)
+	assert.Equal(t, revision1, history[0].Revision)
+	specHash, err := generateHashWithoutReplicasAndWorkersToDelete(rayService.Spec.RayClusterSpec)
+	assert.Nil(t, err)
+	assert.Equal(t, specHash, history[0].RayClusterSpecHash)
+
+	// Test 2: Promoting a RayCluster with a new spec supersedes the active rollout.
+	rayService.Spec.ServeConfigV2 = "applications:\n- import_path: fruit.deployment_graph"
+	rayService.Status.PendingServiceStatus.RayClusterName = "cluster-2"
+	r.updateRayClusterInfo(ctx, rayService, &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-2"}})
+	history = rayService.Status.RolloutHistory
+	assert.Len(t, history, 2)
+	assert.Equal(t, rayv1.RolloutOutcomeSuperseded, history[0].Outcome)
+	assert.NotNil(t, history[0].ActiveEndTime)
+	assert.Equal(t, rayv1.RolloutOutcomeActive, history[1].Outcome)
+	assert.NotEqual(t, revision1, history[1].Revision)
+
+	// Test 3: A failed rollout is recorded without a revision, and the active rollout is kept.
+	rayService.Status.PendingServiceStatus.RayClusterName = "cluster-3"
+	r.markRolloutFailed(ctx, rayService, &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-3"}}, rayv1.UpgradeTimeoutReason, "timed out")
+	history = rayService.Status.RolloutHistory
+	assert.Len(t, history, 3)
+	assert.Equal(t, rayv1.RolloutOutcomeActive, history[1].Outcome)
+	assert.Equal(t, rayv1.RolloutOutcomeFailed, history[2].Outcome)
+	assert.Empty(t, history[2].Revision)
+	assert.NotNil(t, history[2].ActiveEndTime)
+
+	// Test 4: Promoting a RayCluster with the spec of a previous rollout marks the active rollout as rolled back.
+	rayService.Spec.ServeConfigV2 = "applications: []"
+	rayService.Status.PendingServiceStatus.RayClusterName = "cluster-4"
+	r.updateRayClusterInfo(ctx, rayService, &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-4"}})
+	history = rayService.Status.RolloutHistory
+	assert.Len(t, history, 4)
+	assert.Equal(t, rayv1.RolloutOutcomeRolledBack, history[1].Outcome)
+	assert.Equal(t, revision1, history[3].Revision)
+
+	// Test 5: The rollout history is bounded.
+	for i := 0; i < rolloutHistoryLimit; i++ {
+		rayService.Status.PendingServiceStatus.RayClusterName = fmt.Sprintf("cluster-%d", i+5)
+		r.updateRayClusterInfo(ctx, rayService, &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: rayService.Status.PendingServiceStatus.RayClusterName}})
+	}
+	history = rayService.Status.RolloutHistory
+	assert.Len(t, history, rolloutHistoryLimit)
+	assert.Equal(t, fmt.Sprintf("cluster-%d", rolloutHistoryLimit+4), history[rolloutHistoryLimit-1].RayClusterName)
+
+	// Test 6: The rollout is recorded with the hashes recorded by the RayCluster. The RayClusterSpec has changed since the
+	// RayCluster was created, so the rollout gets no revision, because the snapshot of the spec doesn't match the RayCluster.
+	rayService.Status.ServeConfigHash, err = generateServeConfigHash(rayService.Spec.ServeConfigV2)
+	assert.Nil(t, err)
+	staleCluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{
+		Name: "stale-cluster",
+		Annotations: map[string]string{
+			utils.HashWithoutReplicasAndWorkersToDeleteKey: "recorded-spec-hash",
+			utils.ServeConfigHashKey:                       rayService.Status.ServeConfigHash,
+		},
+	}}
+	rayService.Status.PendingServiceStatus.RayClusterName = staleCluster.Name
+	r.updateRayClusterInfo(ctx, rayService, staleCluster)
+	history = rayService.Status.RolloutHistory
+	record := history[len(history)-1]
+	assert.Equal(t, "stale-cluster", record.RayClusterName)
+	assert.Equal(t, "recorded-spec-hash", record.RayClusterSpecHash)
+	assert.Equal(t, rayService.Status.ServeConfigHash, record.ServeConfigHash)
+	assert.Empty(t, record.Revision)
+}
+
+func TestReconcileRolloutRevisionsAndRollback(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
+
+	ctx := context.TODO()
+	namespace := "ray"
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: namespace},
+		Spec:       rayv1.RayServiceSpec{ServeConfigV2: "applications: []"},
+	}
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayService.DeepCopy()).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
+		Scheme:   newScheme,
+	}
+	err := fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), rayService)
+	assert.Nil(t, err)
+
+	listRevisions := func() []appsv1.ControllerRevision {
+		revisionList := appsv1.ControllerRevisionList{}
+		err := fakeClient.List(ctx, &revisionList, client.InNamespace(namespace))
+		assert.Nil(t, err)
+		return revisionList.Items
+	}
+
+	// Test 1: Rolling back without a previous rollout only removes the annotation.
+	rayService.Annotations = map[string]string{utils.RayServiceRollbackAnnotationKey: "true"}
+	_, err = r.rollbackRayService(ctx, rayService)
+	assert.Nil(t, err)
+	assert.NotContains(t, rayService.Annotations, utils.RayServiceRollbackAnnotationKey)
+	assert.Len(t, recorder.Events, 1)
+	<-recorder.Events
+
+	// Test 2: The ControllerRevision of the active rollout is created.
+	r.updateRayClusterInfo(ctx, rayService, &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"}})
+	err = r.reconcileRolloutRevisions(ctx, rayService)
+	assert.Nil(t, err)
+	revisions := listRevisions()
+	assert.Len(t, revisions, 1)
+	assert.Equal(t, rayService.Status.RolloutHistory[0].Revision, revisions[0].Name)
+	assert.Equal(t, int64(1), revisions[0].Revision)
+	assert.True(t, metav1.IsControlledBy(&revisions[0], rayService))
+
+	// Test 3: The ControllerRevision is not created if the spec has changed since the promotion.
+	rayService.Spec.ServeConfigV2 = "applications:\n- import_path: fruit.deployment_graph"
+	r.updateRayClusterInfo(ctx, rayService, &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-2"}})
+	rayService.Spec.ServeConfigV2 = "applications:\n- import_path: conditional_dag.serve_dag"
+	err = r.reconcileRolloutRevisions(ctx, rayService)
+	assert.Nil(t, err)
+	assert.Len(t, listRevisions(), 1)
+	rayService.Spec.ServeConfigV2 = "applications:\n- import_path: fruit.deployment_graph"
+	err = r.reconcileRolloutRevisions(ctx, rayService)
+	assert.Nil(t, err)
+	assert.Len(t, listRevisions(), 2)
+
+	// Test 4: Rolling back restores the spec of the previous rollout. A Serve config that is now read from a ConfigMap
+	// is replaced by the Serve config of the previous rollout.
+	rayService.Annotations = map[string]string{utils.RayServiceRollbackAnnotationKey: "true"}
+	rayService.Spec.ServeConfigV2 = ""
+	rayService.Spec.ServeConfigRef = &rayv1.ServeConfigReference{Name: "serve-config", Key: "serveConfigV2"}
+	_, err = r.rollbackRayService(ctx, rayService)
+	assert.Nil(t, err)
+	updated := &rayv1.RayService{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), updated)
+	assert.Nil(t, err)
+	assert.Equal(t, "applications: []", updated.Spec.ServeConfigV2)
+	assert.Nil(t, updated.Spec.ServeConfigRef)
+	assert.NotContains(t, updated.Annotations, utils.RayServiceRollbackAnnotationKey)
+	assert.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "RolledBack")
+
+	// Test 5: The ControllerRevisions that are no longer in the rollout history are deleted.
+	rayService.Status.RolloutHistory = rayService.Status.RolloutHistory[1:]
+	err = r.reconcileRolloutRevisions(ctx, rayService)
+	assert.Nil(t, err)
+	revisions = listRevisions()
+	assert.Len(t, revisions, 1)
+	assert.Equal(t, rayService.Status.RolloutHistory[0].Revision, revisions[0].Name)
+}
+
+func TestShouldPrepareNewRayClusterWithUpgradeStrategy(t *testing.T) {
+	rayClusterSpec := rayv1.RayClusterSpec{
+		RayVersion: "2.9.0",
+		WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+			{
+				GroupName: "gpu-group",
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.9.0"}}},
+				},
+			},
+		},
+	}
+	hash, err := generateHashWithoutReplicasAndWorkersToDelete(rayClusterSpec)
+	assert.Nil(t, err)
+	activeRayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "active-cluster",
+			Annotations: map[string]string{
+				utils.HashWithoutReplicasAndWorkersToDeleteKey: hash,
+				utils.NumWorkerGroupsKey:                       "1",
+			},
+		},
+		Spec: rayClusterSpec,
+	}
+	updateWorkerImage := func(spec *rayv1.RayClusterSpec) {
+		spec.WorkerGroupSpecs[0].Template.Spec.Containers[0].Image = "rayproject/ray:2.9.1"
+	}
+	updateRayVersion := func(spec *rayv1.RayClusterSpec) {
+		spec.RayVersion = "2.9.1"
+	}
+	inPlaceWhenPossible := rayv1.RayServiceUpgradeInPlaceWhenPossible
+	none := rayv1.RayServiceUpgradeNone
+
+	tests := map[string]struct {
+		upgradeType    *rayv1.RayServiceUpgradeType
+		updateSpec     func(spec *rayv1.RayClusterSpec)
+		expectedAction ClusterAction
+	}{
+		"NewCluster rolls out a new RayCluster for worker group changes": {
+			upgradeType:    nil,
+			updateSpec:     updateWorkerImage,
+			expectedAction: RolloutNew,
+		},
+		"InPlaceWhenPossible updates the RayCluster for worker group changes": {
+			upgradeType:    &inPlaceWhenPossible,
+			updateSpec:     updateWorkerImage,
+			expectedAction: Update,
+		},
+		"InPlaceWhenPossible rolls out a new RayCluster for other changes": {
+			upgradeType:    &inPlaceWhenPossible,
+			updateSpec:     updateRayVersion,
+			expectedAction: RolloutNew,
+		},
+		"None doesn't apply changes": {
+			upgradeType:    &none,
+			updateSpec:     updateRayVersion,
+			expectedAction: DoNothing,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayService := &rayv1.RayService{
+				Spec: rayv1.RayServiceSpec{
+					RayClusterSpec:  *rayClusterSpec.DeepCopy(),
+					UpgradeStrategy: &rayv1.RayServiceUpgradeStrategy{Type: tc.upgradeType},
+				},
+			}
+			tc.updateSpec(&rayService.Spec.RayClusterSpec)
+			r := &RayServiceReconciler{}
+			assert.Equal(t, tc.expectedAction, r.shouldPrepareNewRayCluster(context.TODO(), rayService, activeRayCluster))
+		})
+	}
+}
+
+func TestGetModifiedWorkerGroups(t *testing.T) {
+	currentSpec := rayv1.RayClusterSpec{
+		WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+			{GroupName: "cpu-group", Replicas: pointer.Int32(1), RayStartParams: map[string]string{"num-cpus": "1"}},
+			{GroupName: "gpu-group", Replicas: pointer.Int32(1), RayStartParams: map[string]string{"num-gpus": "1"}},
+		},
+	}
+	goalSpec := currentSpec.DeepCopy()
+	// Scaling the CPU group doesn't recreate its Pods, but changing the RayStartParams of the GPU group does.
+	goalSpec.WorkerGroupSpecs[0].Replicas = pointer.Int32(3)
+	goalSpec.WorkerGroupSpecs[1].RayStartParams["num-gpus"] = "2"
+	goalSpec.WorkerGroupSpecs = append(goalSpec.WorkerGroupSpecs, rayv1.WorkerGroupSpec{GroupName: "new-group"})
+
+	modifiedWorkerGroups, err := getModifiedWorkerGroups(currentSpec, *goalSpec)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"gpu-group"}, modifiedWorkerGroups)
+}
+
+func TestRollOutdatedWorkerPods(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "active-cluster", Namespace: namespace},
+		Spec: rayv1.RayClusterSpec{
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{GroupName: "modified-group", Replicas: pointer.Int32(4), MinReplicas: pointer.Int32(0), MaxReplicas: pointer.Int32(10)},
+				{GroupName: "other-group", Replicas: pointer.Int32(1), MinReplicas: pointer.Int32(0), MaxReplicas: pointer.Int32(10)},
+			},
+		},
+	}
+	newWorkerPod := func(name string, groupName string, ready bool) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:   rayCluster.Name,
+					utils.RayNodeGroupLabelKey: groupName,
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if ready {
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return pod
+	}
+	objects := []runtime.Object{newWorkerPod("other-0", "other-group", true)}
+	for i := 0; i < 4; i++ {
+		objects = append(objects, newWorkerPod(fmt.Sprintf("old-%d", i), "modified-group", i != 3))
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(objects...).Build()
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+	ctx := context.TODO()
+	listPodNames := func() []string {
+		podList := corev1.PodList{}
+		err := fakeClient.List(ctx, &podList, client.InNamespace(namespace))
+		assert.Nil(t, err)
+		names := make([]string, 0, len(podList.Items))
+		for _, pod := range podList.Items {
+			names = append(names, pod.Name)
+		}
+		return names
+	}
+
+	// Only the Pods of the modified worker group are marked.
+	err := r.markOutdatedWorkerPods(ctx, rayCluster, []string{"modified-group"})
+	assert.Nil(t, err)
+	pod := &corev1.Pod{}
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "old-0"}, pod)
+	assert.Nil(t, err)
+	assert.Equal(t, "true", pod.Annotations[utils.RayServiceOutdatedWorkerPodAnnotationKey])
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "other-0"}, pod)
+	assert.Nil(t, err)
+	assert.NotContains(t, pod.Annotations, utils.RayServiceOutdatedWorkerPodAnnotationKey)
+
+	// `old-3` isn't ready, so it is deleted, and no ready Pod is deleted because a quarter of the worker group is
+	// already unavailable.
+	err = r.rollOutdatedWorkerPods(ctx, rayCluster)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"other-0", "old-0", "old-1", "old-2"}, listPodNames())
+
+	// Once the RayCluster controller has recreated a ready Pod, the next outdated Pod is deleted.
+	err = fakeClient.Create(ctx, newWorkerPod("new-0", "modified-group", true))
+	assert.Nil(t, err)
+	err = r.rollOutdatedWorkerPods(ctx, rayCluster)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"other-0", "new-0", "old-1", "old-2"}, listPodNames())
+
+	// The Pod that replaces it isn't ready yet, so the rollout waits.
+	err = fakeClient.Create(ctx, newWorkerPod("new-1", "modified-group", false))
+	assert.Nil(t, err)
+	err = r.rollOutdatedWorkerPods(ctx, rayCluster)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"other-0", "new-0", "new-1", "old-1", "old-2"}, listPodNames())
+}
+
+func TestIsPromotionApproved(t *testing.T) {
+	tests := map[string]struct {
+		manualPromotion   bool
+		activeClusterName string
+		annotations       map[string]string
+		expected          bool
+	}{
+		"Manual promotion is disabled": {
+			manualPromotion:   false,
+			activeClusterName: "active-cluster",
+			expected:          true,
+		},
+		"The first RayCluster doesn't need an approval": {
+			manualPromotion:   true,
+			activeClusterName: "",
+			expected:          true,
+		},
+		"The promotion isn't approved": {
+			manualPromotion:   true,
+			activeClusterName: "active-cluster",
+			expected:          false,
+		},
+		"The approval is for another RayCluster": {
+			manualPromotion:   true,
+			activeClusterName: "active-cluster",
+			annotations:       map[string]string{utils.RayServicePromotePendingClusterAnnotationKey: "old-pending-cluster"},
+			expected:          false,
+		},
+		"The promotion is approved": {
+			manualPromotion:   true,
+			activeClusterName: "active-cluster",
+			annotations:       map[string]string{utils.RayServicePromotePendingClusterAnnotationKey: "pending-cluster"},
+			expected:          true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayService := &rayv1.RayService{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec:       rayv1.RayServiceSpec{UpgradeStrategy: &rayv1.RayServiceUpgradeStrategy{ManualPromotion: tc.manualPromotion}},
+				Status: rayv1.RayServiceStatuses{
+					ActiveServiceStatus: rayv1.RayServiceStatus{RayClusterName: tc.activeClusterName},
+				},
+			}
+			assert.Equal(t, tc.expected, isPromotionApproved(rayService, "pending-cluster"))
+		})
+	}
+}
+
+func TestResolveServeConfig(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	serveConfig := `
+applications:
+- name: myapp
+  import_path: fruit.deployment_graph`
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "serve-config", Namespace: namespace},
+		Data: map[string]string{
+			"serveConfigV2": serveConfig,
+			"invalid":       "applications:\n- name: myapp\n  import_pth: fruit.deployment_graph",
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(configMap).Build()
+	r := &RayServiceReconciler{Client: fakeClient, Scheme: newScheme}
+	ctx := context.Background()
+	serveConfigHash, err := generateServeConfigHash(serveConfig)
+	assert.Nil(t, err)
+
+	tests := map[string]struct {
+		serveConfigV2  string
+		serveConfigRef *rayv1.ServeConfigReference
+		expectError    bool
+	}{
+		"The Serve config is embedded in the RayService": {
+			serveConfigV2: serveConfig,
+		},
+		"The Serve config is read from the ConfigMap": {
+			serveConfigRef: &rayv1.ServeConfigReference{Name: "serve-config", Key: "serveConfigV2"},
+		},
+		"The ConfigMap doesn't exist": {
+			serveConfigRef: &rayv1.ServeConfigReference{Name: "not-found", Key: "serveConfigV2"},
+			expectError:    true,
+		},
+		"The key doesn't exist in the ConfigMap": {
+			serveConfigRef: &rayv1.ServeConfigReference{Name: "serve-config", Key: "not-found"},
+			expectError:    true,
+		},
+		"The Serve config in the ConfigMap is invalid": {
+			serveConfigRef: &rayv1.ServeConfigReference{Name: "serve-config", Key: "invalid"},
+			expectError:    true,
+		},
+		"ServeConfigV2 and ServeConfigRef are both set": {
+			serveConfigV2:  serveConfig,
+			serveConfigRef: &rayv1.ServeConfigReference{Name: "serve-config", Key: "serveConfigV2"},
+			expectError:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayService := &rayv1.RayService{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: namespace},
+				Spec: rayv1.RayServiceSpec{
+					ServeConfigV2:  tc.serveConfigV2,
+					ServeConfigRef: tc.serveConfigRef,
+				},
+			}
+			err := r.resolveServeConfig(ctx, rayService)
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, serveConfig, rayService.Spec.ServeConfigV2)
+			assert.Equal(t, serveConfigHash, rayService.Status.ServeConfigHash)
+		})
+	}
+}
+
+func TestRayServicesForConfigMap(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	newRayService := func(name string, namespace string, serveConfigRef *rayv1.ServeConfigReference) *rayv1.RayService {
+		return &rayv1.RayService{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       rayv1.RayServiceSpec{ServeConfigRef: serveConfigRef},
+		}
+	}
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(
+			newRayService("referencing-service", namespace, &rayv1.ServeConfigReference{Name: "serve-config", Key: "serveConfigV2"}),
+			newRayService("other-config-service", namespace, &rayv1.ServeConfigReference{Name: "other-config", Key: "serveConfigV2"}),
+			newRayService("inline-config-service", namespace, nil),
+			newRayService("other-namespace-service", "other", &rayv1.ServeConfigReference{Name: "serve-config", Key: "serveConfigV2"}),
+		).
+		WithIndex(&rayv1.RayService{}, serveConfigRefNameIndexField, indexServeConfigRefName).
+		Build()
+	r := &RayServiceReconciler{Client: fakeClient, Scheme: newScheme}
+
+	configMap := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "serve-config", Namespace: namespace}}
+	requests := r.rayServicesForConfigMap(context.Background(), configMap)
+	assert.Equal(t, []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "referencing-service"}}}, requests)
+}
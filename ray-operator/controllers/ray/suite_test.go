@@ -49,6 +49,7 @@ var (
 
 	fakeRayDashboardClient *utils.FakeRayDashboardClient
 	fakeRayHttpProxyClient *utils.FakeRayHttpProxyClient
+	fakeRayGrpcProxyClient *utils.FakeRayGrpcProxyClient
 )
 
 func TestAPIs(t *testing.T) {
@@ -95,6 +96,7 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 
 	fakeRayDashboardClient = prepareFakeRayDashboardClient()
 	fakeRayHttpProxyClient = &utils.FakeRayHttpProxyClient{}
+	fakeRayGrpcProxyClient = &utils.FakeRayGrpcProxyClient{}
 
 	options := RayClusterReconcilerOptions{
 		HeadSidecarContainers: []corev1.Container{
@@ -111,6 +113,8 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 		return fakeRayDashboardClient
 	}, func() utils.RayHttpProxyClientInterface {
 		return fakeRayHttpProxyClient
+	}, func() utils.RayGrpcProxyClientInterface {
+		return fakeRayGrpcProxyClient
 	}).SetupWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred(), "failed to setup RayService controller")
 
@@ -24,6 +24,28 @@ const (
 	HashWithoutReplicasAndWorkersToDeleteKey = "ray.io/hash-without-replicas-and-workers-to-delete"
 	NumWorkerGroupsKey                       = "ray.io/num-worker-groups"
 
+	// ServeConfigHashKey is the hash of the Serve config that the RayService controller last applied to the RayCluster.
+	ServeConfigHashKey = "ray.io/serve-config-hash"
+	// ServeApplicationHashesKey is the JSON-encoded map from the name of each Serve application to the hash of its config
+	// that the RayService controller last applied to the RayCluster. It is used to report only the changed applications.
+	ServeApplicationHashesKey = "ray.io/serve-application-hashes"
+	// ServeApplicationLabelKey is set on the Kubernetes Services and Ingresses that the RayService controller creates for
+	// the Serve applications listed in `spec.applicationServices`, and its value is the sanitized name of the application.
+	// See GenerateServeApplicationLabel.
+	ServeApplicationLabelKey = "ray.io/serve-application"
+
+	// RayClusterPoolLabelKey is set on every RayCluster created by a RayClusterPool, and its value is the name of the pool.
+	// The label is kept after a RayJob claims the RayCluster, but the RayCluster's controller reference is handed over
+	// from the RayClusterPool to the RayJob. Hence, the idle RayClusters of a pool are the ones that have this label and
+	// are still controlled by the pool.
+	RayClusterPoolLabelKey = "ray.io/cluster-pool"
+	// RayClusterPoolTemplateHashKey is the hash of the pool's RayClusterSpec that was used to create the RayCluster.
+	// Idle RayClusters with an outdated hash are replaced when the pool's template changes.
+	RayClusterPoolTemplateHashKey = "ray.io/cluster-pool-template-hash"
+	// HTTPRouteSpecHashKey is the hash of the HTTPRoute spec that KubeRay last applied. The API server adds default
+	// values to the spec, so KubeRay compares the hashes instead of the specs to decide whether to update the HTTPRoute.
+	HTTPRouteSpecHashKey = "ray.io/httproute-spec-hash"
+
 	// In KubeRay, the Ray container must be the first application container in a head or worker Pod.
 	RayContainerIndex = 0
 
@@ -68,6 +90,7 @@ const (
 	DefaultMetricsPort              = 8080
 	DefaultDashboardAgentListenPort = 52365
 	DefaultServingPort              = 8000
+	DefaultServingGRPCPort          = 9000
 
 	ClientPortName               = "client"
 	RedisPortName                = "redis"
@@ -75,6 +98,7 @@ const (
 	MetricsPortName              = "metrics"
 	DashboardAgentListenPortName = "dashboard-agent"
 	ServingPortName              = "serve"
+	ServingGRPCPortName          = "serve-grpc"
 
 	// The default AppProtocol for Kubernetes service
 	DefaultServiceAppProtocol = "tcp"
@@ -111,6 +135,9 @@ const (
 	// Example: ray job submit --address=http://$RAY_DASHBOARD_ADDRESS --submission-id=$RAY_JOB_SUBMISSION_ID ...
 	RAY_DASHBOARD_ADDRESS = "RAY_DASHBOARD_ADDRESS"
 	RAY_JOB_SUBMISSION_ID = "RAY_JOB_SUBMISSION_ID"
+	// The environment variables of RuntimeEnv read from Secrets are passed to the submitter as KUBERAY_RUNTIME_ENV_SECRET_<index>,
+	// and referenced as $(KUBERAY_RUNTIME_ENV_SECRET_<index>) in its command, so that the values are not stored in the Job.
+	KUBERAY_RUNTIME_ENV_SECRET_PREFIX = "KUBERAY_RUNTIME_ENV_SECRET_"
 
 	// This environment variable is used by Ray Autoscaler V2. For the Autoscaler V2 alpha
 	// release, its value is the Pod name. This may change in the future.
@@ -160,9 +187,41 @@ const (
 	RayDashboardGCSHealthPath = "api/gcs_healthz"
 	RayServeProxyHealthPath   = "-/healthz"
 	BaseWgetHealthCommand     = "wget -T 2 -q -O- http://localhost:%d/%s | grep success"
+	// RayServeGRPCProxyHealthMethod is the full name of the gRPC method that the Ray Serve gRPC proxy serves for health checks.
+	RayServeGRPCProxyHealthMethod = "/ray.serve.RayServeAPIService/Healthz"
 
 	// Finalizers for RayJob
 	RayJobStopJobFinalizer = "ray.io/rayjob-finalizer"
+
+	// RayClusterMaxConcurrentJobsAnnotationKey limits the number of RayJobs that can run on a RayCluster at the same time
+	// when the RayJobs select the RayCluster via `ClusterSelector`. RayJobs that exceed the limit stay in the `Queued` status.
+	RayClusterMaxConcurrentJobsAnnotationKey = "ray.io/max-concurrent-jobs"
+	// RayClusterJobSlotsAnnotationKey records the comma-separated names of the RayJobs that hold a slot on the RayCluster.
+	// It is only updated with the RayCluster's resourceVersion as a precondition so that concurrent reconciliations
+	// cannot oversubscribe the RayCluster.
+	RayClusterJobSlotsAnnotationKey = "ray.io/job-slots"
+
+	// RayJobQueueAdmittedAnnotationKey is set to "false" by the RayJob controller when it suspends a RayJob to queue it in a
+	// RayJobQueue, and to "true" by the RayJobQueue controller when it admits the RayJob. The RayJobQueue controller drops it
+	// when the user suspends an admitted RayJob, so that the RayJob is held until the user resumes it.
+	RayJobQueueAdmittedAnnotationKey = "ray.io/rayjob-queue-admitted"
+
+	// RayJobSetIndexLabelKey is the index of the parameter set that a RayJob created by a RayJobSet runs with.
+	RayJobSetIndexLabelKey = "ray.io/rayjobset-index"
+	// RayJobSetParametersAnnotationKey is the JSON-encoded parameter set that a RayJob created by a RayJobSet runs with.
+	RayJobSetParametersAnnotationKey = "ray.io/rayjobset-parameters"
+
+	// RayServicePromotePendingClusterAnnotationKey approves switching the traffic to the pending RayCluster when the
+	// RayService's upgrade strategy requires manual promotion. Its value is the name of the pending RayCluster.
+	RayServicePromotePendingClusterAnnotationKey = "ray.io/promote-pending-cluster"
+	// RayServiceRollbackAnnotationKey requests a one-step rollback of the RayService when it is set to "true". The
+	// RayService controller replaces the RayClusterSpec and the Serve config of the RayService with the ones of the
+	// previous rollout in the rollout history, and then removes the annotation. A Serve config that was read from a
+	// ConfigMap is restored in `serveConfigV2`.
+	RayServiceRollbackAnnotationKey = "ray.io/rollback"
+	// RayServiceOutdatedWorkerPodAnnotationKey is set to "true" on the worker Pods that were created from the previous template
+	// of a worker group updated in place by the RayService controller, which recreates these Pods in batches.
+	RayServiceOutdatedWorkerPodAnnotationKey = "ray.io/outdated-worker-pod"
 )
 
 type ServiceType string
@@ -5,9 +5,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
+	"golang.org/x/net/websocket"
+
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	fmtErrors "github.com/pkg/errors"
@@ -41,6 +46,7 @@ type RayDashboardClientInterface interface {
 	SubmitJob(ctx context.Context, rayJob *rayv1.RayJob) (string, error)
 	SubmitJobReq(ctx context.Context, request *RayJobRequest, name *string) (string, error)
 	GetJobLog(ctx context.Context, jobName string) (*string, error)
+	TailJobLog(ctx context.Context, jobName string) (io.ReadCloser, error)
 	StopJob(ctx context.Context, jobName string) error
 	DeleteJob(ctx context.Context, jobName string) error
 }
@@ -363,6 +369,49 @@ func (r *RayDashboardClient) GetJobLog(ctx context.Context, jobName string) (*st
 	return &jobLog.Logs, nil
 }
 
+// TailJobLog follows the log of a job through the log tail websocket of the dashboard. The returned reader reads the
+// log from the beginning, and returns io.EOF once the job reaches a terminal state and all of its log is read.
+func (r *RayDashboardClient) TailJobLog(ctx context.Context, jobName string) (io.ReadCloser, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Tail ray job log", "rayJob", jobName)
+
+	config, err := websocket.NewConfig("ws"+strings.TrimPrefix(r.dashboardURL, "http")+JobPath+jobName+"/logs/tail", r.dashboardURL)
+	if err != nil {
+		return nil, err
+	}
+	config.Dialer = &net.Dialer{Timeout: r.client.Timeout}
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("TailJobLog fail: %w", err)
+	}
+
+	reader := &jobLogTailReader{Conn: conn, done: make(chan struct{})}
+	// The websocket does not support contexts, so close it when the context is done to unblock reads.
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = reader.Close()
+		case <-reader.done:
+		}
+	}()
+	return reader, nil
+}
+
+// jobLogTailReader reads the log of a job from the log tail websocket of the dashboard.
+type jobLogTailReader struct {
+	*websocket.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (r *jobLogTailReader) Close() (err error) {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		err = r.Conn.Close()
+	})
+	return err
+}
+
 func (r *RayDashboardClient) StopJob(ctx context.Context, jobName string) (err error) {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Stop a ray job", "rayJob", jobName)
@@ -424,13 +473,11 @@ func ConvertRayJobToReq(rayJob *rayv1.RayJob) (*RayJobRequest, error) {
 		SubmissionId: rayJob.Status.JobId,
 		Metadata:     rayJob.Spec.Metadata,
 	}
-	if len(rayJob.Spec.RuntimeEnvYAML) != 0 {
-		runtimeEnv, err := UnmarshalRuntimeEnvYAML(rayJob.Spec.RuntimeEnvYAML)
-		if err != nil {
-			return nil, err
-		}
-		req.RuntimeEnv = runtimeEnv
+	runtimeEnv, err := GetRuntimeEnv(rayJob)
+	if err != nil {
+		return nil, err
 	}
+	req.RuntimeEnv = runtimeEnv
 	req.NumCpus = rayJob.Spec.EntrypointNumCpus
 	req.NumGpus = rayJob.Spec.EntrypointNumGpus
 	if rayJob.Spec.EntrypointResources != "" {
@@ -449,3 +496,62 @@ func UnmarshalRuntimeEnvYAML(runtimeEnvYAML string) (RuntimeEnvType, error) {
 	}
 	return runtimeEnv, nil
 }
+
+// GetRuntimeEnv returns the runtime environment of the RayJob from either RuntimeEnv or RuntimeEnvYAML.
+// It returns nil if neither of them is set. The environment variables of RuntimeEnv that reference
+// Secrets or ConfigMaps must have been resolved to values before calling this function.
+func GetRuntimeEnv(rayJob *rayv1.RayJob) (RuntimeEnvType, error) {
+	if rayJob.Spec.RuntimeEnv != nil {
+		return ConvertRuntimeEnv(rayJob.Spec.RuntimeEnv)
+	}
+	if len(rayJob.Spec.RuntimeEnvYAML) != 0 {
+		return UnmarshalRuntimeEnvYAML(rayJob.Spec.RuntimeEnvYAML)
+	}
+	return nil, nil
+}
+
+// ConvertRuntimeEnv converts the structured RuntimeEnv to the format of the runtime environment in the Ray Job API.
+func ConvertRuntimeEnv(runtimeEnv *rayv1.RuntimeEnv) (RuntimeEnvType, error) {
+	result := RuntimeEnvType{}
+	if len(runtimeEnv.Pip) != 0 {
+		result["pip"] = runtimeEnv.Pip
+	}
+	if conda := runtimeEnv.Conda; conda != nil {
+		if conda.Name != "" {
+			result["conda"] = conda.Name
+		} else {
+			condaEnv := map[string]interface{}{}
+			if len(conda.Channels) != 0 {
+				condaEnv["channels"] = conda.Channels
+			}
+			if len(conda.Dependencies) != 0 {
+				condaEnv["dependencies"] = conda.Dependencies
+			}
+			result["conda"] = condaEnv
+		}
+	}
+	if len(runtimeEnv.EnvVars) != 0 {
+		envVars := make(map[string]string, len(runtimeEnv.EnvVars))
+		for _, envVar := range runtimeEnv.EnvVars {
+			if envVar.ValueFrom != nil {
+				return nil, fmt.Errorf("the value of the environment variable %s in RuntimeEnv has not been resolved", envVar.Name)
+			}
+			envVars[envVar.Name] = envVar.Value
+		}
+		result["env_vars"] = envVars
+	}
+	if runtimeEnv.WorkingDir != "" {
+		result["working_dir"] = runtimeEnv.WorkingDir
+	}
+	if len(runtimeEnv.PyModules) != 0 {
+		result["py_modules"] = runtimeEnv.PyModules
+	}
+	if container := runtimeEnv.Container; container != nil {
+		containerEnv := map[string]interface{}{"image": container.Image}
+		if len(container.RunOptions) != 0 {
+			containerEnv["run_options"] = container.RunOptions
+		}
+		result["container"] = containerEnv
+	}
+	return result, nil
+}
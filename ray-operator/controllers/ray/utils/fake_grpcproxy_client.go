@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"fmt"
+)
+
+type FakeRayGrpcProxyClient struct {
+	grpcProxyAddr string
+	// HealthCheckErr is returned by CheckHealth.
+	HealthCheckErr error
+}
+
+func (r *FakeRayGrpcProxyClient) InitClient() {}
+
+func (r *FakeRayGrpcProxyClient) SetHostIp(hostIp string, port int) {
+	r.grpcProxyAddr = fmt.Sprintf("%s:%d", hostIp, port)
+}
+
+func (r *FakeRayGrpcProxyClient) CheckHealth() error {
+	return r.HealthCheckErr
+}
@@ -3,7 +3,9 @@ package utils
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"sync/atomic"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -13,6 +15,7 @@ type FakeRayDashboardClient struct {
 	BaseDashboardClient
 	multiAppStatuses map[string]*ServeApplicationStatus
 	serveDetails     ServeDetails
+	serveDetailsErr  error
 
 	GetJobInfoMock atomic.Pointer[func(context.Context, string) (*RayJobInfo, error)]
 }
@@ -33,8 +36,29 @@ func (r *FakeRayDashboardClient) GetMultiApplicationStatus(_ context.Context) (m
 	return r.multiAppStatuses, nil
 }
 
+// GetServeDetails returns the Serve details set by SetServeDetails. If they are not set, the Serve details are derived
+// from the application statuses set by SetMultiApplicationStatuses.
 func (r *FakeRayDashboardClient) GetServeDetails(_ context.Context) (*ServeDetails, error) {
-	return &r.serveDetails, nil
+	if r.serveDetailsErr != nil {
+		return nil, r.serveDetailsErr
+	}
+	if r.serveDetails.Applications != nil {
+		return &r.serveDetails, nil
+	}
+	serveDetails := ServeDetails{Applications: make(map[string]ServeApplicationDetails, len(r.multiAppStatuses))}
+	for appName, appStatus := range r.multiAppStatuses {
+		serveDetails.Applications[appName] = ServeApplicationDetails{ServeApplicationStatus: *appStatus}
+	}
+	return &serveDetails, nil
+}
+
+func (r *FakeRayDashboardClient) SetServeDetails(serveDetails ServeDetails) {
+	r.serveDetails = serveDetails
+}
+
+// SetServeDetailsError makes GetServeDetails fail with err until it is reset with nil.
+func (r *FakeRayDashboardClient) SetServeDetailsError(err error) {
+	r.serveDetailsErr = err
 }
 
 func (r *FakeRayDashboardClient) SetMultiApplicationStatuses(statuses map[string]*ServeApplicationStatus) {
@@ -72,6 +96,10 @@ func (r *FakeRayDashboardClient) GetJobLog(_ context.Context, jobName string) (*
 	return &lg, nil
 }
 
+func (r *FakeRayDashboardClient) TailJobLog(_ context.Context, jobName string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("log")), nil
+}
+
 func (r *FakeRayDashboardClient) StopJob(_ context.Context, jobName string) (err error) {
 	return nil
 }
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type RayGrpcProxyClientInterface interface {
+	InitClient()
+	CheckHealth() error
+	SetHostIp(hostIp string, port int)
+}
+
+func GetRayGrpcProxyClient() RayGrpcProxyClientInterface {
+	return &RayGrpcProxyClient{}
+}
+
+type RayGrpcProxyClient struct {
+	timeout       time.Duration
+	grpcProxyAddr string
+}
+
+func (r *RayGrpcProxyClient) InitClient() {
+	// Unlike the HTTP health check, the gRPC health check also needs to set up an HTTP/2 connection.
+	r.timeout = 100 * time.Millisecond
+}
+
+func (r *RayGrpcProxyClient) SetHostIp(hostIp string, port int) {
+	r.grpcProxyAddr = fmt.Sprintf("%s:%d", hostIp, port)
+}
+
+// CheckHealth calls the Healthz method of the Ray Serve gRPC proxy. The request and the response of the method have
+// no fields that KubeRay needs, so they are sent and received as empty messages instead of depending on Ray's protos.
+func (r *RayGrpcProxyClient) CheckHealth() error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, r.grpcProxyAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.Invoke(ctx, RayServeGRPCProxyHealthMethod, &emptypb.Empty{}, &emptypb.Empty{}); err != nil {
+		return fmt.Errorf("RayGrpcProxyClient CheckHealth fail: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestRayGrpcProxyClientCheckHealth(t *testing.T) {
+	var healthy atomic.Bool
+	healthy.Store(true)
+	// The fake Ray Serve gRPC proxy only serves the Healthz method.
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		method, _ := grpc.MethodFromServerStream(stream)
+		if method != RayServeGRPCProxyHealthMethod {
+			return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+		}
+		if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+			return err
+		}
+		if !healthy.Load() {
+			return status.Error(codes.Unavailable, "draining")
+		}
+		return stream.SendMsg(&emptypb.Empty{})
+	}))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	client := GetRayGrpcProxyClient()
+	client.InitClient()
+	client.SetHostIp("127.0.0.1", listener.Addr().(*net.TCPAddr).Port)
+	assert.Nil(t, client.CheckHealth())
+
+	healthy.Store(false)
+	assert.NotNil(t, client.CheckHealth())
+}
@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -35,16 +36,20 @@ const (
 type CRDType string
 
 const (
-	RayClusterCRD CRDType = "RayCluster"
-	RayJobCRD     CRDType = "RayJob"
-	RayServiceCRD CRDType = "RayService"
-	UnknownCRD    CRDType = "Unknown"
+	RayClusterCRD     CRDType = "RayCluster"
+	RayJobCRD         CRDType = "RayJob"
+	RayServiceCRD     CRDType = "RayService"
+	RayClusterPoolCRD CRDType = "RayClusterPool"
+	RayJobSetCRD      CRDType = "RayJobSet"
+	UnknownCRD        CRDType = "Unknown"
 )
 
 var crdMap = map[string]CRDType{
-	"RayCluster": RayClusterCRD,
-	"RayJob":     RayJobCRD,
-	"RayService": RayServiceCRD,
+	"RayCluster":     RayClusterCRD,
+	"RayJob":         RayJobCRD,
+	"RayService":     RayServiceCRD,
+	"RayClusterPool": RayClusterPoolCRD,
+	"RayJobSet":      RayJobSetCRD,
 }
 
 func GetCRDType(key string) CRDType {
@@ -211,11 +216,37 @@ func GenerateServeServiceName(serviceName string) string {
 	return CheckName(fmt.Sprintf("%s-%s-%s", serviceName, ServeName, "svc"))
 }
 
+// GenerateServeApplicationServiceName generates name for the Kubernetes Service of a Serve application.
+func GenerateServeApplicationServiceName(serviceName string, appName string) string {
+	return CheckName(fmt.Sprintf("%s-%s-%s-%s", serviceName, rayv1.SanitizeServeApplicationName(appName), ServeName, "svc"))
+}
+
+// GenerateServeApplicationIngressName generates name for the Ingress of a Serve application.
+func GenerateServeApplicationIngressName(serviceName string, appName string) string {
+	return CheckName(fmt.Sprintf("%s-%s-%s-%s", serviceName, rayv1.SanitizeServeApplicationName(appName), ServeName, "ingress"))
+}
+
 // GenerateServeServiceLabel generates label value for serve service selector.
 func GenerateServeServiceLabel(serviceName string) string {
 	return fmt.Sprintf("%s-%s", serviceName, ServeName)
 }
 
+// GenerateServeApplicationLabel generates the value of the ServeApplicationLabelKey label of a Serve application. Like
+// the names of the Service and the Ingress of the application, it is derived from the sanitized application name,
+// because the application name can be any string while a label value can't.
+func GenerateServeApplicationLabel(appName string) string {
+	sanitizedAppName := rayv1.SanitizeServeApplicationName(appName)
+	if sanitizedAppName == "" {
+		return ""
+	}
+	return CheckLabel(sanitizedAppName)
+}
+
+// GenerateHTTPRouteName generates a Gateway API HTTPRoute name from RayService name
+func GenerateHTTPRouteName(serviceName string) string {
+	return CheckName(fmt.Sprintf("%s-%s-%s", serviceName, ServeName, "httproute"))
+}
+
 // GenerateIngressName generates an ingress name from cluster name
 func GenerateIngressName(clusterName string) string {
 	return fmt.Sprintf("%s-%s-%s", clusterName, rayv1.HeadNode, "ingress")
@@ -338,7 +369,10 @@ func CalculateMinResources(cluster *rayv1.RayCluster) corev1.ResourceList {
 func calculatePodResource(podSpec corev1.PodSpec) corev1.ResourceList {
 	podResource := corev1.ResourceList{}
 	for _, container := range podSpec.Containers {
-		containerResource := container.Resources.Requests
+		containerResource := corev1.ResourceList{}
+		for name, quantity := range container.Resources.Requests {
+			containerResource[name] = quantity
+		}
 		for name, quantity := range container.Resources.Limits {
 			if _, ok := containerResource[name]; !ok {
 				containerResource[name] = quantity
@@ -528,6 +562,23 @@ func FindContainerPort(container *corev1.Container, portName string, defaultPort
 	return defaultPort
 }
 
+// GetServeGRPCPort returns the port of the Ray Serve gRPC proxies and whether the Serve config enables them. Ray Serve
+// only starts the gRPC proxies if `grpc_options.grpc_servicer_functions` is not empty.
+func GetServeGRPCPort(serveConfigV2 string) (int32, bool) {
+	serveConfig := rayv1.ServeDeployConfig{}
+	if err := yaml.Unmarshal([]byte(serveConfigV2), &serveConfig); err != nil {
+		return 0, false
+	}
+	grpcOptions := serveConfig.GRPCOptions
+	if grpcOptions == nil || len(grpcOptions.GRPCServicerFunctions) == 0 {
+		return 0, false
+	}
+	if grpcOptions.Port != nil {
+		return *grpcOptions.Port, true
+	}
+	return DefaultServingGRPCPort, true
+}
+
 // IsJobFinished checks whether the given Job has finished execution.
 // It does not discriminate between successful and failed terminations.
 // src: https://github.com/kubernetes/kubernetes/blob/a8a1abc25cad87333840cd7d54be2efaf31a3177/pkg/controller/job/utils.go#L26
@@ -550,6 +550,40 @@ func TestGenerateHeadServiceName(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestGenerateServeApplicationServiceName(t *testing.T) {
+	tests := map[string]struct {
+		appName      string
+		expectedName string
+	}{
+		"Lowercase application name": {
+			appName:      "fruit",
+			expectedName: "rayservice-sample-fruit-serve-svc",
+		},
+		"Application name with underscores and uppercase letters": {
+			appName:      "Fruit_App",
+			expectedName: "rayservice-sample-fruit-app-serve-svc",
+		},
+		"Application name with leading and trailing punctuations": {
+			appName:      "_math.",
+			expectedName: "rayservice-sample-math-serve-svc",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedName, GenerateServeApplicationServiceName("rayservice-sample", tc.appName))
+		})
+	}
+	assert.Equal(t, "rayservice-sample-fruit-app-serve-ingress", GenerateServeApplicationIngressName("rayservice-sample", "fruit_app"))
+}
+
+func TestGenerateServeApplicationLabel(t *testing.T) {
+	// The label value agrees with the names of the Service and the Ingress of the application.
+	assert.Equal(t, "fruit-app-v2", GenerateServeApplicationLabel("Fruit/App v2"))
+	assert.Equal(t, "math", GenerateServeApplicationLabel("_math."))
+	assert.Equal(t, "", GenerateServeApplicationLabel("__"))
+}
+
 func TestGetWorkerGroupDesiredReplicas(t *testing.T) {
 	ctx := context.Background()
 	// Test 1: `WorkerGroupSpec.Replicas` is nil.
@@ -649,6 +683,45 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 	}
 }
 
+func TestGetServeGRPCPort(t *testing.T) {
+	tests := map[string]struct {
+		serveConfigV2   string
+		expectedPort    int32
+		expectedEnabled bool
+	}{
+		"No grpc_options": {
+			serveConfigV2:   "applications: []",
+			expectedEnabled: false,
+		},
+		"grpc_options without servicer functions": {
+			serveConfigV2:   "grpc_options:\n  port: 9001\napplications: []",
+			expectedEnabled: false,
+		},
+		"grpc_options without port": {
+			serveConfigV2:   "grpc_options:\n  grpc_servicer_functions:\n    - user_defined_protos_pb2_grpc.add_UserDefinedServiceServicer_to_server\napplications: []",
+			expectedPort:    DefaultServingGRPCPort,
+			expectedEnabled: true,
+		},
+		"grpc_options with port": {
+			serveConfigV2:   "grpc_options:\n  port: 9001\n  grpc_servicer_functions:\n    - user_defined_protos_pb2_grpc.add_UserDefinedServiceServicer_to_server\napplications: []",
+			expectedPort:    9001,
+			expectedEnabled: true,
+		},
+		"Invalid Serve config": {
+			serveConfigV2:   "grpc_options: [",
+			expectedEnabled: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			port, enabled := GetServeGRPCPort(tc.serveConfigV2)
+			assert.Equal(t, tc.expectedEnabled, enabled)
+			assert.Equal(t, tc.expectedPort, port)
+		})
+	}
+}
+
 func TestUnmarshalRuntimeEnv(t *testing.T) {
 	tests := map[string]struct {
 		runtimeEnvYAML string
@@ -682,3 +755,46 @@ env_vars:
 		})
 	}
 }
+
+func TestConvertRuntimeEnv(t *testing.T) {
+	tests := map[string]struct {
+		runtimeEnv *rayv1.RuntimeEnv
+		expected   RuntimeEnvType
+	}{
+		"Empty RuntimeEnv": {
+			runtimeEnv: &rayv1.RuntimeEnv{},
+			expected:   RuntimeEnvType{},
+		},
+		"Existing conda environment": {
+			runtimeEnv: &rayv1.RuntimeEnv{
+				Conda:     &rayv1.RuntimeEnvConda{Name: "pytorch"},
+				PyModules: []string{"s3://bucket/module.zip"},
+			},
+			expected: RuntimeEnvType{
+				"conda":      "pytorch",
+				"py_modules": []string{"s3://bucket/module.zip"},
+			},
+		},
+		"New conda environment": {
+			runtimeEnv: &rayv1.RuntimeEnv{
+				Conda: &rayv1.RuntimeEnvConda{Channels: []string{"conda-forge"}, Dependencies: []string{"pytorch"}},
+				EnvVars: []rayv1.RuntimeEnvVar{
+					{Name: "A", Value: "1"},
+					{Name: "B"},
+				},
+			},
+			expected: RuntimeEnvType{
+				"conda":    map[string]interface{}{"channels": []string{"conda-forge"}, "dependencies": []string{"pytorch"}},
+				"env_vars": map[string]string{"A": "1", "B": ""},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runtimeEnv, err := ConvertRuntimeEnv(tc.runtimeEnv)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, runtimeEnv)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -172,6 +173,14 @@ func main() {
 		Cache: cache.Options{
 			DefaultNamespaces: map[string]cache.Config{},
 		},
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// The RayJob controller only reads the Secrets and ConfigMaps referenced by RuntimeEnv when
+				// submitting a Ray job, and the RayService controller only watches the metadata of ConfigMaps,
+				// so they are read from the API server instead of being cached.
+				DisableFor: []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}},
+			},
+		},
 		Scheme: scheme,
 		Metrics: metricsserver.Options{
 			BindAddress: config.MetricsAddr,
@@ -218,14 +227,22 @@ func main() {
 	ctx := ctrl.SetupSignalHandler()
 	exitOnError(ray.NewReconciler(ctx, mgr, rayClusterOptions).SetupWithManager(mgr, config.ReconcileConcurrency),
 		"unable to create controller", "controller", "RayCluster")
-	exitOnError(ray.NewRayServiceReconciler(ctx, mgr, utils.GetRayDashboardClient, utils.GetRayHttpProxyClient).SetupWithManager(mgr),
+	exitOnError(ray.NewRayServiceReconciler(ctx, mgr, utils.GetRayDashboardClient, utils.GetRayHttpProxyClient, utils.GetRayGrpcProxyClient).SetupWithManager(mgr),
 		"unable to create controller", "controller", "RayService")
 	exitOnError(ray.NewRayJobReconciler(ctx, mgr, utils.GetRayDashboardClient).SetupWithManager(mgr),
 		"unable to create controller", "controller", "RayJob")
+	exitOnError(ray.NewRayClusterPoolReconciler(ctx, mgr).SetupWithManager(mgr),
+		"unable to create controller", "controller", "RayClusterPool")
+	exitOnError(ray.NewRayJobQueueReconciler(ctx, mgr).SetupWithManager(mgr),
+		"unable to create controller", "controller", "RayJobQueue")
+	exitOnError(ray.NewRayJobSetReconciler(ctx, mgr).SetupWithManager(mgr),
+		"unable to create controller", "controller", "RayJobSet")
 
 	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
 		exitOnError((&rayv1.RayCluster{}).SetupWebhookWithManager(mgr),
 			"unable to create webhook", "webhook", "RayCluster")
+		exitOnError((&rayv1.RayService{}).SetupWebhookWithManager(mgr),
+			"unable to create webhook", "webhook", "RayService")
 	}
 	// +kubebuilder:scaffold:builder
 
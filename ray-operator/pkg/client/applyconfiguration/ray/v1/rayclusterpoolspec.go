@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RayClusterPoolSpecApplyConfiguration represents an declarative configuration of the RayClusterPoolSpec type for use
+// with apply.
+type RayClusterPoolSpecApplyConfiguration struct {
+	Replicas       *int32                            `json:"replicas,omitempty"`
+	RayClusterSpec *RayClusterSpecApplyConfiguration `json:"rayClusterSpec,omitempty"`
+}
+
+// RayClusterPoolSpecApplyConfiguration constructs an declarative configuration of the RayClusterPoolSpec type for use with
+// apply.
+func RayClusterPoolSpec() *RayClusterPoolSpecApplyConfiguration {
+	return &RayClusterPoolSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *RayClusterPoolSpecApplyConfiguration) WithReplicas(value int32) *RayClusterPoolSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithRayClusterSpec sets the RayClusterSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RayClusterSpec field is set to the value of the last call.
+func (b *RayClusterPoolSpecApplyConfiguration) WithRayClusterSpec(value *RayClusterSpecApplyConfiguration) *RayClusterPoolSpecApplyConfiguration {
+	b.RayClusterSpec = value
+	return b
+}
@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayClusterPoolStatusApplyConfiguration represents an declarative configuration of the RayClusterPoolStatus type for use
+// with apply.
+type RayClusterPoolStatusApplyConfiguration struct {
+	IdleReplicas       *int32   `json:"idleReplicas,omitempty"`
+	ReadyReplicas      *int32   `json:"readyReplicas,omitempty"`
+	LastUpdateTime     *v1.Time `json:"lastUpdateTime,omitempty"`
+	ObservedGeneration *int64   `json:"observedGeneration,omitempty"`
+}
+
+// RayClusterPoolStatusApplyConfiguration constructs an declarative configuration of the RayClusterPoolStatus type for use with
+// apply.
+func RayClusterPoolStatus() *RayClusterPoolStatusApplyConfiguration {
+	return &RayClusterPoolStatusApplyConfiguration{}
+}
+
+// WithIdleReplicas sets the IdleReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleReplicas field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithIdleReplicas(value int32) *RayClusterPoolStatusApplyConfiguration {
+	b.IdleReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithReadyReplicas(value int32) *RayClusterPoolStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithLastUpdateTime(value v1.Time) *RayClusterPoolStatusApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithObservedGeneration(value int64) *RayClusterPoolStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
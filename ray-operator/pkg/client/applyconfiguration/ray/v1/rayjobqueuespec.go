@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// RayJobQueueSpecApplyConfiguration represents an declarative configuration of the RayJobQueueSpec type for use
+// with apply.
+type RayJobQueueSpecApplyConfiguration struct {
+	MaxRunningJobs *int32           `json:"maxRunningJobs,omitempty"`
+	MaxResources   *v1.ResourceList `json:"maxResources,omitempty"`
+}
+
+// RayJobQueueSpecApplyConfiguration constructs an declarative configuration of the RayJobQueueSpec type for use with
+// apply.
+func RayJobQueueSpec() *RayJobQueueSpecApplyConfiguration {
+	return &RayJobQueueSpecApplyConfiguration{}
+}
+
+// WithMaxRunningJobs sets the MaxRunningJobs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRunningJobs field is set to the value of the last call.
+func (b *RayJobQueueSpecApplyConfiguration) WithMaxRunningJobs(value int32) *RayJobQueueSpecApplyConfiguration {
+	b.MaxRunningJobs = &value
+	return b
+}
+
+// WithMaxResources sets the MaxResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxResources field is set to the value of the last call.
+func (b *RayJobQueueSpecApplyConfiguration) WithMaxResources(value v1.ResourceList) *RayJobQueueSpecApplyConfiguration {
+	b.MaxResources = &value
+	return b
+}
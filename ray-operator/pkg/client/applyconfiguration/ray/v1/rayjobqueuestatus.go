@@ -0,0 +1,64 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayJobQueueStatusApplyConfiguration represents an declarative configuration of the RayJobQueueStatus type for use
+// with apply.
+type RayJobQueueStatusApplyConfiguration struct {
+	RunningJobs        *int32           `json:"runningJobs,omitempty"`
+	PendingJobs        *int32           `json:"pendingJobs,omitempty"`
+	UsedResources      *v1.ResourceList `json:"usedResources,omitempty"`
+	LastUpdateTime     *metav1.Time     `json:"lastUpdateTime,omitempty"`
+	ObservedGeneration *int64           `json:"observedGeneration,omitempty"`
+}
+
+// RayJobQueueStatusApplyConfiguration constructs an declarative configuration of the RayJobQueueStatus type for use with
+// apply.
+func RayJobQueueStatus() *RayJobQueueStatusApplyConfiguration {
+	return &RayJobQueueStatusApplyConfiguration{}
+}
+
+// WithRunningJobs sets the RunningJobs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunningJobs field is set to the value of the last call.
+func (b *RayJobQueueStatusApplyConfiguration) WithRunningJobs(value int32) *RayJobQueueStatusApplyConfiguration {
+	b.RunningJobs = &value
+	return b
+}
+
+// WithPendingJobs sets the PendingJobs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingJobs field is set to the value of the last call.
+func (b *RayJobQueueStatusApplyConfiguration) WithPendingJobs(value int32) *RayJobQueueStatusApplyConfiguration {
+	b.PendingJobs = &value
+	return b
+}
+
+// WithUsedResources sets the UsedResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsedResources field is set to the value of the last call.
+func (b *RayJobQueueStatusApplyConfiguration) WithUsedResources(value v1.ResourceList) *RayJobQueueStatusApplyConfiguration {
+	b.UsedResources = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *RayJobQueueStatusApplyConfiguration) WithLastUpdateTime(value metav1.Time) *RayJobQueueStatusApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *RayJobQueueStatusApplyConfiguration) WithObservedGeneration(value int64) *RayJobQueueStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
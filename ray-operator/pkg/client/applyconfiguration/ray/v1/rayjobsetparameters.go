@@ -0,0 +1,40 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RayJobSetParametersApplyConfiguration represents an declarative configuration of the RayJobSetParameters type for use
+// with apply.
+type RayJobSetParametersApplyConfiguration struct {
+	List   []map[string]string `json:"list,omitempty"`
+	Matrix map[string][]string `json:"matrix,omitempty"`
+}
+
+// RayJobSetParametersApplyConfiguration constructs an declarative configuration of the RayJobSetParameters type for use with
+// apply.
+func RayJobSetParameters() *RayJobSetParametersApplyConfiguration {
+	return &RayJobSetParametersApplyConfiguration{}
+}
+
+// WithList adds the given value to the List field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the List field.
+func (b *RayJobSetParametersApplyConfiguration) WithList(values ...map[string]string) *RayJobSetParametersApplyConfiguration {
+	for i := range values {
+		b.List = append(b.List, values[i])
+	}
+	return b
+}
+
+// WithMatrix puts the entries into the Matrix field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Matrix field,
+// overwriting an existing map entries in Matrix field with the same key.
+func (b *RayJobSetParametersApplyConfiguration) WithMatrix(entries map[string][]string) *RayJobSetParametersApplyConfiguration {
+	if b.Matrix == nil && len(entries) > 0 {
+		b.Matrix = make(map[string][]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Matrix[k] = v
+	}
+	return b
+}
@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RayJobSetSpecApplyConfiguration represents an declarative configuration of the RayJobSetSpec type for use
+// with apply.
+type RayJobSetSpecApplyConfiguration struct {
+	Template      *RayJobTemplateSpecApplyConfiguration  `json:"template,omitempty"`
+	Parameters    *RayJobSetParametersApplyConfiguration `json:"parameters,omitempty"`
+	Parallelism   *int32                                 `json:"parallelism,omitempty"`
+	SharedCluster *bool                                  `json:"sharedCluster,omitempty"`
+}
+
+// RayJobSetSpecApplyConfiguration constructs an declarative configuration of the RayJobSetSpec type for use with
+// apply.
+func RayJobSetSpec() *RayJobSetSpecApplyConfiguration {
+	return &RayJobSetSpecApplyConfiguration{}
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithTemplate(value *RayJobTemplateSpecApplyConfiguration) *RayJobSetSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
+
+// WithParameters sets the Parameters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parameters field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithParameters(value *RayJobSetParametersApplyConfiguration) *RayJobSetSpecApplyConfiguration {
+	b.Parameters = value
+	return b
+}
+
+// WithParallelism sets the Parallelism field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parallelism field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithParallelism(value int32) *RayJobSetSpecApplyConfiguration {
+	b.Parallelism = &value
+	return b
+}
+
+// WithSharedCluster sets the SharedCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SharedCluster field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithSharedCluster(value bool) *RayJobSetSpecApplyConfiguration {
+	b.SharedCluster = &value
+	return b
+}
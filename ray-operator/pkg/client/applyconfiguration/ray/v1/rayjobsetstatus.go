@@ -0,0 +1,91 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayJobSetStatusApplyConfiguration represents an declarative configuration of the RayJobSetStatus type for use
+// with apply.
+type RayJobSetStatusApplyConfiguration struct {
+	State                *v1.RayJobSetState `json:"state,omitempty"`
+	Total                *int32             `json:"total,omitempty"`
+	Active               *int32             `json:"active,omitempty"`
+	Succeeded            *int32             `json:"succeeded,omitempty"`
+	Failed               *int32             `json:"failed,omitempty"`
+	SharedRayClusterName *string            `json:"sharedRayClusterName,omitempty"`
+	LastUpdateTime       *metav1.Time       `json:"lastUpdateTime,omitempty"`
+	ObservedGeneration   *int64             `json:"observedGeneration,omitempty"`
+}
+
+// RayJobSetStatusApplyConfiguration constructs an declarative configuration of the RayJobSetStatus type for use with
+// apply.
+func RayJobSetStatus() *RayJobSetStatusApplyConfiguration {
+	return &RayJobSetStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithState(value v1.RayJobSetState) *RayJobSetStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithTotal sets the Total field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Total field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithTotal(value int32) *RayJobSetStatusApplyConfiguration {
+	b.Total = &value
+	return b
+}
+
+// WithActive sets the Active field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Active field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithActive(value int32) *RayJobSetStatusApplyConfiguration {
+	b.Active = &value
+	return b
+}
+
+// WithSucceeded sets the Succeeded field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Succeeded field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithSucceeded(value int32) *RayJobSetStatusApplyConfiguration {
+	b.Succeeded = &value
+	return b
+}
+
+// WithFailed sets the Failed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failed field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithFailed(value int32) *RayJobSetStatusApplyConfiguration {
+	b.Failed = &value
+	return b
+}
+
+// WithSharedRayClusterName sets the SharedRayClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SharedRayClusterName field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithSharedRayClusterName(value string) *RayJobSetStatusApplyConfiguration {
+	b.SharedRayClusterName = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithLastUpdateTime(value metav1.Time) *RayJobSetStatusApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *RayJobSetStatusApplyConfiguration) WithObservedGeneration(value int64) *RayJobSetStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
@@ -13,12 +13,16 @@ type RayJobSpecApplyConfiguration struct {
 	Entrypoint               *string                                   `json:"entrypoint,omitempty"`
 	Metadata                 map[string]string                         `json:"metadata,omitempty"`
 	RuntimeEnvYAML           *string                                   `json:"runtimeEnvYAML,omitempty"`
+	RuntimeEnv               *RuntimeEnvApplyConfiguration             `json:"runtimeEnv,omitempty"`
 	JobId                    *string                                   `json:"jobId,omitempty"`
 	ShutdownAfterJobFinishes *bool                                     `json:"shutdownAfterJobFinishes,omitempty"`
 	TTLSecondsAfterFinished  *int32                                    `json:"ttlSecondsAfterFinished,omitempty"`
 	ActiveDeadlineSeconds    *int32                                    `json:"activeDeadlineSeconds,omitempty"`
 	RayClusterSpec           *RayClusterSpecApplyConfiguration         `json:"rayClusterSpec,omitempty"`
 	ClusterSelector          map[string]string                         `json:"clusterSelector,omitempty"`
+	RayClusterPoolName       *string                                   `json:"rayClusterPoolName,omitempty"`
+	RayJobQueueName          *string                                   `json:"rayJobQueueName,omitempty"`
+	QueuePriority            *int32                                    `json:"queuePriority,omitempty"`
 	SubmissionMode           *rayv1.JobSubmissionMode                  `json:"submissionMode,omitempty"`
 	Suspend                  *bool                                     `json:"suspend,omitempty"`
 	SubmitterPodTemplate     *corev1.PodTemplateSpecApplyConfiguration `json:"submitterPodTemplate,omitempty"`
@@ -63,6 +67,14 @@ func (b *RayJobSpecApplyConfiguration) WithRuntimeEnvYAML(value string) *RayJobS
 	return b
 }
 
+// WithRuntimeEnv sets the RuntimeEnv field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuntimeEnv field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithRuntimeEnv(value *RuntimeEnvApplyConfiguration) *RayJobSpecApplyConfiguration {
+	b.RuntimeEnv = value
+	return b
+}
+
 // WithJobId sets the JobId field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the JobId field is set to the value of the last call.
@@ -117,6 +129,30 @@ func (b *RayJobSpecApplyConfiguration) WithClusterSelector(entries map[string]st
 	return b
 }
 
+// WithRayClusterPoolName sets the RayClusterPoolName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RayClusterPoolName field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithRayClusterPoolName(value string) *RayJobSpecApplyConfiguration {
+	b.RayClusterPoolName = &value
+	return b
+}
+
+// WithRayJobQueueName sets the RayJobQueueName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RayJobQueueName field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithRayJobQueueName(value string) *RayJobSpecApplyConfiguration {
+	b.RayJobQueueName = &value
+	return b
+}
+
+// WithQueuePriority sets the QueuePriority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueuePriority field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithQueuePriority(value int32) *RayJobSpecApplyConfiguration {
+	b.QueuePriority = &value
+	return b
+}
+
 // WithSubmissionMode sets the SubmissionMode field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SubmissionMode field is set to the value of the last call.
@@ -0,0 +1,82 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayServiceRolloutRecordApplyConfiguration represents an declarative configuration of the RayServiceRolloutRecord type for use
+// with apply.
+type RayServiceRolloutRecordApplyConfiguration struct {
+	RayClusterName     *string               `json:"rayClusterName,omitempty"`
+	RayClusterSpecHash *string               `json:"rayClusterSpecHash,omitempty"`
+	ServeConfigHash    *string               `json:"serveConfigHash,omitempty"`
+	Revision           *string               `json:"revision,omitempty"`
+	ActiveStartTime    *v1.Time              `json:"activeStartTime,omitempty"`
+	ActiveEndTime      *v1.Time              `json:"activeEndTime,omitempty"`
+	Outcome            *rayv1.RolloutOutcome `json:"outcome,omitempty"`
+}
+
+// RayServiceRolloutRecordApplyConfiguration constructs an declarative configuration of the RayServiceRolloutRecord type for use with
+// apply.
+func RayServiceRolloutRecord() *RayServiceRolloutRecordApplyConfiguration {
+	return &RayServiceRolloutRecordApplyConfiguration{}
+}
+
+// WithRayClusterName sets the RayClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RayClusterName field is set to the value of the last call.
+func (b *RayServiceRolloutRecordApplyConfiguration) WithRayClusterName(value string) *RayServiceRolloutRecordApplyConfiguration {
+	b.RayClusterName = &value
+	return b
+}
+
+// WithRayClusterSpecHash sets the RayClusterSpecHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RayClusterSpecHash field is set to the value of the last call.
+func (b *RayServiceRolloutRecordApplyConfiguration) WithRayClusterSpecHash(value string) *RayServiceRolloutRecordApplyConfiguration {
+	b.RayClusterSpecHash = &value
+	return b
+}
+
+// WithServeConfigHash sets the ServeConfigHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServeConfigHash field is set to the value of the last call.
+func (b *RayServiceRolloutRecordApplyConfiguration) WithServeConfigHash(value string) *RayServiceRolloutRecordApplyConfiguration {
+	b.ServeConfigHash = &value
+	return b
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *RayServiceRolloutRecordApplyConfiguration) WithRevision(value string) *RayServiceRolloutRecordApplyConfiguration {
+	b.Revision = &value
+	return b
+}
+
+// WithActiveStartTime sets the ActiveStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveStartTime field is set to the value of the last call.
+func (b *RayServiceRolloutRecordApplyConfiguration) WithActiveStartTime(value v1.Time) *RayServiceRolloutRecordApplyConfiguration {
+	b.ActiveStartTime = &value
+	return b
+}
+
+// WithActiveEndTime sets the ActiveEndTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveEndTime field is set to the value of the last call.
+func (b *RayServiceRolloutRecordApplyConfiguration) WithActiveEndTime(value v1.Time) *RayServiceRolloutRecordApplyConfiguration {
+	b.ActiveEndTime = &value
+	return b
+}
+
+// WithOutcome sets the Outcome field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Outcome field is set to the value of the last call.
+func (b *RayServiceRolloutRecordApplyConfiguration) WithOutcome(value rayv1.RolloutOutcome) *RayServiceRolloutRecordApplyConfiguration {
+	b.Outcome = &value
+	return b
+}
@@ -9,11 +9,17 @@ import (
 // RayServiceSpecApplyConfiguration represents an declarative configuration of the RayServiceSpec type for use
 // with apply.
 type RayServiceSpecApplyConfiguration struct {
-	ServeConfigV2                      *string                           `json:"serveConfigV2,omitempty"`
-	RayClusterSpec                     *RayClusterSpecApplyConfiguration `json:"rayClusterConfig,omitempty"`
-	ServiceUnhealthySecondThreshold    *int32                            `json:"serviceUnhealthySecondThreshold,omitempty"`
-	DeploymentUnhealthySecondThreshold *int32                            `json:"deploymentUnhealthySecondThreshold,omitempty"`
-	ServeService                       *corev1.Service                   `json:"serveService,omitempty"`
+	ServeConfigV2                      *string                                      `json:"serveConfigV2,omitempty"`
+	ServeConfigRef                     *ServeConfigReferenceApplyConfiguration      `json:"serveConfigRef,omitempty"`
+	RayClusterSpec                     *RayClusterSpecApplyConfiguration            `json:"rayClusterConfig,omitempty"`
+	ServiceUnhealthySecondThreshold    *int32                                       `json:"serviceUnhealthySecondThreshold,omitempty"`
+	DeploymentUnhealthySecondThreshold *int32                                       `json:"deploymentUnhealthySecondThreshold,omitempty"`
+	ServeService                       *corev1.Service                              `json:"serveService,omitempty"`
+	ApplicationServices                []ServeApplicationServiceApplyConfiguration  `json:"applicationServices,omitempty"`
+	TrafficShifting                    *TrafficShiftingSpecApplyConfiguration       `json:"trafficShifting,omitempty"`
+	UpgradeTimeoutSeconds              *int32                                       `json:"upgradeTimeoutSeconds,omitempty"`
+	UpgradeStrategy                    *RayServiceUpgradeStrategyApplyConfiguration `json:"upgradeStrategy,omitempty"`
+	Suspend                            *bool                                        `json:"suspend,omitempty"`
 }
 
 // RayServiceSpecApplyConfiguration constructs an declarative configuration of the RayServiceSpec type for use with
@@ -30,6 +36,14 @@ func (b *RayServiceSpecApplyConfiguration) WithServeConfigV2(value string) *RayS
 	return b
 }
 
+// WithServeConfigRef sets the ServeConfigRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServeConfigRef field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithServeConfigRef(value *ServeConfigReferenceApplyConfiguration) *RayServiceSpecApplyConfiguration {
+	b.ServeConfigRef = value
+	return b
+}
+
 // WithRayClusterSpec sets the RayClusterSpec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RayClusterSpec field is set to the value of the last call.
@@ -61,3 +75,48 @@ func (b *RayServiceSpecApplyConfiguration) WithServeService(value corev1.Service
 	b.ServeService = &value
 	return b
 }
+
+// WithApplicationServices adds the given value to the ApplicationServices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ApplicationServices field.
+func (b *RayServiceSpecApplyConfiguration) WithApplicationServices(values ...*ServeApplicationServiceApplyConfiguration) *RayServiceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithApplicationServices")
+		}
+		b.ApplicationServices = append(b.ApplicationServices, *values[i])
+	}
+	return b
+}
+
+// WithTrafficShifting sets the TrafficShifting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrafficShifting field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithTrafficShifting(value *TrafficShiftingSpecApplyConfiguration) *RayServiceSpecApplyConfiguration {
+	b.TrafficShifting = value
+	return b
+}
+
+// WithUpgradeTimeoutSeconds sets the UpgradeTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeTimeoutSeconds field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithUpgradeTimeoutSeconds(value int32) *RayServiceSpecApplyConfiguration {
+	b.UpgradeTimeoutSeconds = &value
+	return b
+}
+
+// WithUpgradeStrategy sets the UpgradeStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeStrategy field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithUpgradeStrategy(value *RayServiceUpgradeStrategyApplyConfiguration) *RayServiceSpecApplyConfiguration {
+	b.UpgradeStrategy = value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithSuspend(value bool) *RayServiceSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
@@ -10,12 +10,17 @@ import (
 // RayServiceStatusesApplyConfiguration represents an declarative configuration of the RayServiceStatuses type for use
 // with apply.
 type RayServiceStatusesApplyConfiguration struct {
-	ActiveServiceStatus  *RayServiceStatusApplyConfiguration `json:"activeServiceStatus,omitempty"`
-	PendingServiceStatus *RayServiceStatusApplyConfiguration `json:"pendingServiceStatus,omitempty"`
-	ServiceStatus        *rayv1.ServiceStatus                `json:"serviceStatus,omitempty"`
-	NumServeEndpoints    *int32                              `json:"numServeEndpoints,omitempty"`
-	ObservedGeneration   *int64                              `json:"observedGeneration,omitempty"`
-	LastUpdateTime       *metav1.Time                        `json:"lastUpdateTime,omitempty"`
+	ActiveServiceStatus  *RayServiceStatusApplyConfiguration         `json:"activeServiceStatus,omitempty"`
+	PendingServiceStatus *RayServiceStatusApplyConfiguration         `json:"pendingServiceStatus,omitempty"`
+	ServiceStatus        *rayv1.ServiceStatus                        `json:"serviceStatus,omitempty"`
+	NumServeEndpoints    *int32                                      `json:"numServeEndpoints,omitempty"`
+	ObservedGeneration   *int64                                      `json:"observedGeneration,omitempty"`
+	LastUpdateTime       *metav1.Time                                `json:"lastUpdateTime,omitempty"`
+	ServeConfigHash      *string                                     `json:"serveConfigHash,omitempty"`
+	TrafficShifting      *TrafficShiftingStatusApplyConfiguration    `json:"trafficShifting,omitempty"`
+	RolloutFailedHash    *string                                     `json:"rolloutFailedHash,omitempty"`
+	Conditions           []metav1.Condition                          `json:"conditions,omitempty"`
+	RolloutHistory       []RayServiceRolloutRecordApplyConfiguration `json:"rolloutHistory,omitempty"`
 }
 
 // RayServiceStatusesApplyConfiguration constructs an declarative configuration of the RayServiceStatuses type for use with
@@ -71,3 +76,50 @@ func (b *RayServiceStatusesApplyConfiguration) WithLastUpdateTime(value metav1.T
 	b.LastUpdateTime = &value
 	return b
 }
+
+// WithServeConfigHash sets the ServeConfigHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServeConfigHash field is set to the value of the last call.
+func (b *RayServiceStatusesApplyConfiguration) WithServeConfigHash(value string) *RayServiceStatusesApplyConfiguration {
+	b.ServeConfigHash = &value
+	return b
+}
+
+// WithTrafficShifting sets the TrafficShifting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrafficShifting field is set to the value of the last call.
+func (b *RayServiceStatusesApplyConfiguration) WithTrafficShifting(value *TrafficShiftingStatusApplyConfiguration) *RayServiceStatusesApplyConfiguration {
+	b.TrafficShifting = value
+	return b
+}
+
+// WithRolloutFailedHash sets the RolloutFailedHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutFailedHash field is set to the value of the last call.
+func (b *RayServiceStatusesApplyConfiguration) WithRolloutFailedHash(value string) *RayServiceStatusesApplyConfiguration {
+	b.RolloutFailedHash = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RayServiceStatusesApplyConfiguration) WithConditions(values ...metav1.Condition) *RayServiceStatusesApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
+// WithRolloutHistory adds the given value to the RolloutHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RolloutHistory field.
+func (b *RayServiceStatusesApplyConfiguration) WithRolloutHistory(values ...*RayServiceRolloutRecordApplyConfiguration) *RayServiceStatusesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRolloutHistory")
+		}
+		b.RolloutHistory = append(b.RolloutHistory, *values[i])
+	}
+	return b
+}
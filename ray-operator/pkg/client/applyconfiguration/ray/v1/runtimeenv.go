@@ -0,0 +1,77 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RuntimeEnvApplyConfiguration represents an declarative configuration of the RuntimeEnv type for use
+// with apply.
+type RuntimeEnvApplyConfiguration struct {
+	Pip        []string                               `json:"pip,omitempty"`
+	Conda      *RuntimeEnvCondaApplyConfiguration     `json:"conda,omitempty"`
+	EnvVars    []RuntimeEnvVarApplyConfiguration      `json:"envVars,omitempty"`
+	WorkingDir *string                                `json:"workingDir,omitempty"`
+	PyModules  []string                               `json:"pyModules,omitempty"`
+	Container  *RuntimeEnvContainerApplyConfiguration `json:"container,omitempty"`
+}
+
+// RuntimeEnvApplyConfiguration constructs an declarative configuration of the RuntimeEnv type for use with
+// apply.
+func RuntimeEnv() *RuntimeEnvApplyConfiguration {
+	return &RuntimeEnvApplyConfiguration{}
+}
+
+// WithPip adds the given value to the Pip field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Pip field.
+func (b *RuntimeEnvApplyConfiguration) WithPip(values ...string) *RuntimeEnvApplyConfiguration {
+	for i := range values {
+		b.Pip = append(b.Pip, values[i])
+	}
+	return b
+}
+
+// WithConda sets the Conda field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conda field is set to the value of the last call.
+func (b *RuntimeEnvApplyConfiguration) WithConda(value *RuntimeEnvCondaApplyConfiguration) *RuntimeEnvApplyConfiguration {
+	b.Conda = value
+	return b
+}
+
+// WithEnvVars adds the given value to the EnvVars field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvVars field.
+func (b *RuntimeEnvApplyConfiguration) WithEnvVars(values ...*RuntimeEnvVarApplyConfiguration) *RuntimeEnvApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnvVars")
+		}
+		b.EnvVars = append(b.EnvVars, *values[i])
+	}
+	return b
+}
+
+// WithWorkingDir sets the WorkingDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkingDir field is set to the value of the last call.
+func (b *RuntimeEnvApplyConfiguration) WithWorkingDir(value string) *RuntimeEnvApplyConfiguration {
+	b.WorkingDir = &value
+	return b
+}
+
+// WithPyModules adds the given value to the PyModules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PyModules field.
+func (b *RuntimeEnvApplyConfiguration) WithPyModules(values ...string) *RuntimeEnvApplyConfiguration {
+	for i := range values {
+		b.PyModules = append(b.PyModules, values[i])
+	}
+	return b
+}
+
+// WithContainer sets the Container field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Container field is set to the value of the last call.
+func (b *RuntimeEnvApplyConfiguration) WithContainer(value *RuntimeEnvContainerApplyConfiguration) *RuntimeEnvApplyConfiguration {
+	b.Container = value
+	return b
+}
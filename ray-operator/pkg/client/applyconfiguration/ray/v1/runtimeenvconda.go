@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RuntimeEnvCondaApplyConfiguration represents an declarative configuration of the RuntimeEnvConda type for use
+// with apply.
+type RuntimeEnvCondaApplyConfiguration struct {
+	Name         *string  `json:"name,omitempty"`
+	Channels     []string `json:"channels,omitempty"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// RuntimeEnvCondaApplyConfiguration constructs an declarative configuration of the RuntimeEnvConda type for use with
+// apply.
+func RuntimeEnvConda() *RuntimeEnvCondaApplyConfiguration {
+	return &RuntimeEnvCondaApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RuntimeEnvCondaApplyConfiguration) WithName(value string) *RuntimeEnvCondaApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithChannels adds the given value to the Channels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Channels field.
+func (b *RuntimeEnvCondaApplyConfiguration) WithChannels(values ...string) *RuntimeEnvCondaApplyConfiguration {
+	for i := range values {
+		b.Channels = append(b.Channels, values[i])
+	}
+	return b
+}
+
+// WithDependencies adds the given value to the Dependencies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Dependencies field.
+func (b *RuntimeEnvCondaApplyConfiguration) WithDependencies(values ...string) *RuntimeEnvCondaApplyConfiguration {
+	for i := range values {
+		b.Dependencies = append(b.Dependencies, values[i])
+	}
+	return b
+}
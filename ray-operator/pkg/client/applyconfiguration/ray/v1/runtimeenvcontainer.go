@@ -0,0 +1,34 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RuntimeEnvContainerApplyConfiguration represents an declarative configuration of the RuntimeEnvContainer type for use
+// with apply.
+type RuntimeEnvContainerApplyConfiguration struct {
+	Image      *string  `json:"image,omitempty"`
+	RunOptions []string `json:"runOptions,omitempty"`
+}
+
+// RuntimeEnvContainerApplyConfiguration constructs an declarative configuration of the RuntimeEnvContainer type for use with
+// apply.
+func RuntimeEnvContainer() *RuntimeEnvContainerApplyConfiguration {
+	return &RuntimeEnvContainerApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *RuntimeEnvContainerApplyConfiguration) WithImage(value string) *RuntimeEnvContainerApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithRunOptions adds the given value to the RunOptions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RunOptions field.
+func (b *RuntimeEnvContainerApplyConfiguration) WithRunOptions(values ...string) *RuntimeEnvContainerApplyConfiguration {
+	for i := range values {
+		b.RunOptions = append(b.RunOptions, values[i])
+	}
+	return b
+}
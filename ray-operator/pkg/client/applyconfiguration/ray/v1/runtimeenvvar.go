@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RuntimeEnvVarApplyConfiguration represents an declarative configuration of the RuntimeEnvVar type for use
+// with apply.
+type RuntimeEnvVarApplyConfiguration struct {
+	Name      *string                                `json:"name,omitempty"`
+	Value     *string                                `json:"value,omitempty"`
+	ValueFrom *RuntimeEnvVarSourceApplyConfiguration `json:"valueFrom,omitempty"`
+}
+
+// RuntimeEnvVarApplyConfiguration constructs an declarative configuration of the RuntimeEnvVar type for use with
+// apply.
+func RuntimeEnvVar() *RuntimeEnvVarApplyConfiguration {
+	return &RuntimeEnvVarApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RuntimeEnvVarApplyConfiguration) WithName(value string) *RuntimeEnvVarApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *RuntimeEnvVarApplyConfiguration) WithValue(value string) *RuntimeEnvVarApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithValueFrom sets the ValueFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValueFrom field is set to the value of the last call.
+func (b *RuntimeEnvVarApplyConfiguration) WithValueFrom(value *RuntimeEnvVarSourceApplyConfiguration) *RuntimeEnvVarApplyConfiguration {
+	b.ValueFrom = value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// RuntimeEnvVarSourceApplyConfiguration represents an declarative configuration of the RuntimeEnvVarSource type for use
+// with apply.
+type RuntimeEnvVarSourceApplyConfiguration struct {
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	SecretKeyRef    *v1.SecretKeySelector    `json:"secretKeyRef,omitempty"`
+}
+
+// RuntimeEnvVarSourceApplyConfiguration constructs an declarative configuration of the RuntimeEnvVarSource type for use with
+// apply.
+func RuntimeEnvVarSource() *RuntimeEnvVarSourceApplyConfiguration {
+	return &RuntimeEnvVarSourceApplyConfiguration{}
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *RuntimeEnvVarSourceApplyConfiguration) WithConfigMapKeyRef(value v1.ConfigMapKeySelector) *RuntimeEnvVarSourceApplyConfiguration {
+	b.ConfigMapKeyRef = &value
+	return b
+}
+
+// WithSecretKeyRef sets the SecretKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretKeyRef field is set to the value of the last call.
+func (b *RuntimeEnvVarSourceApplyConfiguration) WithSecretKeyRef(value v1.SecretKeySelector) *RuntimeEnvVarSourceApplyConfiguration {
+	b.SecretKeyRef = &value
+	return b
+}
@@ -0,0 +1,62 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/networking/v1"
+)
+
+// ServeApplicationIngressApplyConfiguration represents an declarative configuration of the ServeApplicationIngress type for use
+// with apply.
+type ServeApplicationIngressApplyConfiguration struct {
+	IngressClassName *string           `json:"ingressClassName,omitempty"`
+	Annotations      map[string]string `json:"annotations,omitempty"`
+	Host             *string           `json:"host,omitempty"`
+	TLS              []v1.IngressTLS   `json:"tls,omitempty"`
+}
+
+// ServeApplicationIngressApplyConfiguration constructs an declarative configuration of the ServeApplicationIngress type for use with
+// apply.
+func ServeApplicationIngress() *ServeApplicationIngressApplyConfiguration {
+	return &ServeApplicationIngressApplyConfiguration{}
+}
+
+// WithIngressClassName sets the IngressClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IngressClassName field is set to the value of the last call.
+func (b *ServeApplicationIngressApplyConfiguration) WithIngressClassName(value string) *ServeApplicationIngressApplyConfiguration {
+	b.IngressClassName = &value
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *ServeApplicationIngressApplyConfiguration) WithAnnotations(entries map[string]string) *ServeApplicationIngressApplyConfiguration {
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
+
+// WithHost sets the Host field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Host field is set to the value of the last call.
+func (b *ServeApplicationIngressApplyConfiguration) WithHost(value string) *ServeApplicationIngressApplyConfiguration {
+	b.Host = &value
+	return b
+}
+
+// WithTLS adds the given value to the TLS field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TLS field.
+func (b *ServeApplicationIngressApplyConfiguration) WithTLS(values ...v1.IngressTLS) *ServeApplicationIngressApplyConfiguration {
+	for i := range values {
+		b.TLS = append(b.TLS, values[i])
+	}
+	return b
+}
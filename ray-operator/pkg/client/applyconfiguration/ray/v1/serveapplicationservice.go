@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ServeApplicationServiceApplyConfiguration represents an declarative configuration of the ServeApplicationService type for use
+// with apply.
+type ServeApplicationServiceApplyConfiguration struct {
+	ApplicationName *string                                    `json:"applicationName,omitempty"`
+	Service         *v1.Service                                `json:"service,omitempty"`
+	Ingress         *ServeApplicationIngressApplyConfiguration `json:"ingress,omitempty"`
+}
+
+// ServeApplicationServiceApplyConfiguration constructs an declarative configuration of the ServeApplicationService type for use with
+// apply.
+func ServeApplicationService() *ServeApplicationServiceApplyConfiguration {
+	return &ServeApplicationServiceApplyConfiguration{}
+}
+
+// WithApplicationName sets the ApplicationName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ApplicationName field is set to the value of the last call.
+func (b *ServeApplicationServiceApplyConfiguration) WithApplicationName(value string) *ServeApplicationServiceApplyConfiguration {
+	b.ApplicationName = &value
+	return b
+}
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *ServeApplicationServiceApplyConfiguration) WithService(value v1.Service) *ServeApplicationServiceApplyConfiguration {
+	b.Service = &value
+	return b
+}
+
+// WithIngress sets the Ingress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ingress field is set to the value of the last call.
+func (b *ServeApplicationServiceApplyConfiguration) WithIngress(value *ServeApplicationIngressApplyConfiguration) *ServeApplicationServiceApplyConfiguration {
+	b.Ingress = value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ServeConfigReferenceApplyConfiguration represents an declarative configuration of the ServeConfigReference type for use
+// with apply.
+type ServeConfigReferenceApplyConfiguration struct {
+	Name *string `json:"name,omitempty"`
+	Key  *string `json:"key,omitempty"`
+}
+
+// ServeConfigReferenceApplyConfiguration constructs an declarative configuration of the ServeConfigReference type for use with
+// apply.
+func ServeConfigReference() *ServeConfigReferenceApplyConfiguration {
+	return &ServeConfigReferenceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ServeConfigReferenceApplyConfiguration) WithName(value string) *ServeConfigReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *ServeConfigReferenceApplyConfiguration) WithKey(value string) *ServeConfigReferenceApplyConfiguration {
+	b.Key = &value
+	return b
+}
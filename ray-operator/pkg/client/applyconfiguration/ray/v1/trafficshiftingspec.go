@@ -0,0 +1,48 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TrafficShiftingSpecApplyConfiguration represents an declarative configuration of the TrafficShiftingSpec type for use
+// with apply.
+type TrafficShiftingSpecApplyConfiguration struct {
+	Gateway   *GatewayReferenceApplyConfiguration     `json:"gateway,omitempty"`
+	Hostnames []string                                `json:"hostnames,omitempty"`
+	Steps     []TrafficShiftingStepApplyConfiguration `json:"steps,omitempty"`
+}
+
+// TrafficShiftingSpecApplyConfiguration constructs an declarative configuration of the TrafficShiftingSpec type for use with
+// apply.
+func TrafficShiftingSpec() *TrafficShiftingSpecApplyConfiguration {
+	return &TrafficShiftingSpecApplyConfiguration{}
+}
+
+// WithGateway sets the Gateway field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Gateway field is set to the value of the last call.
+func (b *TrafficShiftingSpecApplyConfiguration) WithGateway(value *GatewayReferenceApplyConfiguration) *TrafficShiftingSpecApplyConfiguration {
+	b.Gateway = value
+	return b
+}
+
+// WithHostnames adds the given value to the Hostnames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Hostnames field.
+func (b *TrafficShiftingSpecApplyConfiguration) WithHostnames(values ...string) *TrafficShiftingSpecApplyConfiguration {
+	for i := range values {
+		b.Hostnames = append(b.Hostnames, values[i])
+	}
+	return b
+}
+
+// WithSteps adds the given value to the Steps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Steps field.
+func (b *TrafficShiftingSpecApplyConfiguration) WithSteps(values ...*TrafficShiftingStepApplyConfiguration) *TrafficShiftingSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSteps")
+		}
+		b.Steps = append(b.Steps, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrafficShiftingStatusApplyConfiguration represents an declarative configuration of the TrafficShiftingStatus type for use
+// with apply.
+type TrafficShiftingStatusApplyConfiguration struct {
+	Step                  *int32   `json:"step,omitempty"`
+	PendingClusterWeight  *int32   `json:"pendingClusterWeight,omitempty"`
+	StepStartTime         *v1.Time `json:"stepStartTime,omitempty"`
+	AbortedRayClusterHash *string  `json:"abortedRayClusterHash,omitempty"`
+}
+
+// TrafficShiftingStatusApplyConfiguration constructs an declarative configuration of the TrafficShiftingStatus type for use with
+// apply.
+func TrafficShiftingStatus() *TrafficShiftingStatusApplyConfiguration {
+	return &TrafficShiftingStatusApplyConfiguration{}
+}
+
+// WithStep sets the Step field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Step field is set to the value of the last call.
+func (b *TrafficShiftingStatusApplyConfiguration) WithStep(value int32) *TrafficShiftingStatusApplyConfiguration {
+	b.Step = &value
+	return b
+}
+
+// WithPendingClusterWeight sets the PendingClusterWeight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingClusterWeight field is set to the value of the last call.
+func (b *TrafficShiftingStatusApplyConfiguration) WithPendingClusterWeight(value int32) *TrafficShiftingStatusApplyConfiguration {
+	b.PendingClusterWeight = &value
+	return b
+}
+
+// WithStepStartTime sets the StepStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StepStartTime field is set to the value of the last call.
+func (b *TrafficShiftingStatusApplyConfiguration) WithStepStartTime(value v1.Time) *TrafficShiftingStatusApplyConfiguration {
+	b.StepStartTime = &value
+	return b
+}
+
+// WithAbortedRayClusterHash sets the AbortedRayClusterHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AbortedRayClusterHash field is set to the value of the last call.
+func (b *TrafficShiftingStatusApplyConfiguration) WithAbortedRayClusterHash(value string) *TrafficShiftingStatusApplyConfiguration {
+	b.AbortedRayClusterHash = &value
+	return b
+}
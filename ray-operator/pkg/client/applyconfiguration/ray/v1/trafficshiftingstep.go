@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TrafficShiftingStepApplyConfiguration represents an declarative configuration of the TrafficShiftingStep type for use
+// with apply.
+type TrafficShiftingStepApplyConfiguration struct {
+	Weight      *int32 `json:"weight,omitempty"`
+	SoakSeconds *int32 `json:"soakSeconds,omitempty"`
+}
+
+// TrafficShiftingStepApplyConfiguration constructs an declarative configuration of the TrafficShiftingStep type for use with
+// apply.
+func TrafficShiftingStep() *TrafficShiftingStepApplyConfiguration {
+	return &TrafficShiftingStepApplyConfiguration{}
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *TrafficShiftingStepApplyConfiguration) WithWeight(value int32) *TrafficShiftingStepApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithSoakSeconds sets the SoakSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SoakSeconds field is set to the value of the last call.
+func (b *TrafficShiftingStepApplyConfiguration) WithSoakSeconds(value int32) *TrafficShiftingStepApplyConfiguration {
+	b.SoakSeconds = &value
+	return b
+}
@@ -17,34 +17,84 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &rayv1.AppStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AutoscalerOptions"):
 		return &rayv1.AutoscalerOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GatewayReference"):
+		return &rayv1.GatewayReferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeadGroupSpec"):
 		return &rayv1.HeadGroupSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeadInfo"):
 		return &rayv1.HeadInfoApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayCluster"):
 		return &rayv1.RayClusterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayClusterPool"):
+		return &rayv1.RayClusterPoolApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayClusterPoolSpec"):
+		return &rayv1.RayClusterPoolSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayClusterPoolStatus"):
+		return &rayv1.RayClusterPoolStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayClusterSpec"):
 		return &rayv1.RayClusterSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayClusterStatus"):
 		return &rayv1.RayClusterStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayJob"):
 		return &rayv1.RayJobApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobQueue"):
+		return &rayv1.RayJobQueueApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobQueueSpec"):
+		return &rayv1.RayJobQueueSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobQueueStatus"):
+		return &rayv1.RayJobQueueStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSet"):
+		return &rayv1.RayJobSetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetParameters"):
+		return &rayv1.RayJobSetParametersApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetSpec"):
+		return &rayv1.RayJobSetSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetStatus"):
+		return &rayv1.RayJobSetStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayJobSpec"):
 		return &rayv1.RayJobSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayJobStatus"):
 		return &rayv1.RayJobStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobTemplateSpec"):
+		return &rayv1.RayJobTemplateSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayService"):
 		return &rayv1.RayServiceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayServiceRolloutRecord"):
+		return &rayv1.RayServiceRolloutRecordApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayServiceSpec"):
 		return &rayv1.RayServiceSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayServiceStatus"):
 		return &rayv1.RayServiceStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayServiceStatuses"):
 		return &rayv1.RayServiceStatusesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayServiceUpgradeStrategy"):
+		return &rayv1.RayServiceUpgradeStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuntimeEnv"):
+		return &rayv1.RuntimeEnvApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuntimeEnvConda"):
+		return &rayv1.RuntimeEnvCondaApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuntimeEnvContainer"):
+		return &rayv1.RuntimeEnvContainerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuntimeEnvVar"):
+		return &rayv1.RuntimeEnvVarApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuntimeEnvVarSource"):
+		return &rayv1.RuntimeEnvVarSourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ScaleStrategy"):
 		return &rayv1.ScaleStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServeApplicationIngress"):
+		return &rayv1.ServeApplicationIngressApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServeApplicationService"):
+		return &rayv1.ServeApplicationServiceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServeConfigReference"):
+		return &rayv1.ServeConfigReferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServeDeploymentStatus"):
 		return &rayv1.ServeDeploymentStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TrafficShiftingSpec"):
+		return &rayv1.TrafficShiftingSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TrafficShiftingStatus"):
+		return &rayv1.TrafficShiftingStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TrafficShiftingStep"):
+		return &rayv1.TrafficShiftingStepApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WorkerGroupSpec"):
 		return &rayv1.WorkerGroupSpecApplyConfiguration{}
 
@@ -16,10 +16,22 @@ func (c *FakeRayV1) RayClusters(namespace string) v1.RayClusterInterface {
 	return &FakeRayClusters{c, namespace}
 }
 
+func (c *FakeRayV1) RayClusterPools(namespace string) v1.RayClusterPoolInterface {
+	return &FakeRayClusterPools{c, namespace}
+}
+
 func (c *FakeRayV1) RayJobs(namespace string) v1.RayJobInterface {
 	return &FakeRayJobs{c, namespace}
 }
 
+func (c *FakeRayV1) RayJobQueues(namespace string) v1.RayJobQueueInterface {
+	return &FakeRayJobQueues{c, namespace}
+}
+
+func (c *FakeRayV1) RayJobSets(namespace string) v1.RayJobSetInterface {
+	return &FakeRayJobSets{c, namespace}
+}
+
 func (c *FakeRayV1) RayServices(namespace string) v1.RayServiceInterface {
 	return &FakeRayServices{c, namespace}
 }
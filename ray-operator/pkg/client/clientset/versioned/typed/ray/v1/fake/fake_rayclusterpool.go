@@ -0,0 +1,173 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRayClusterPools implements RayClusterPoolInterface
+type FakeRayClusterPools struct {
+	Fake *FakeRayV1
+	ns   string
+}
+
+var rayclusterpoolsResource = v1.SchemeGroupVersion.WithResource("rayclusterpools")
+
+var rayclusterpoolsKind = v1.SchemeGroupVersion.WithKind("RayClusterPool")
+
+// Get takes name of the rayClusterPool, and returns the corresponding rayClusterPool object, and an error if there is any.
+func (c *FakeRayClusterPools) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RayClusterPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(rayclusterpoolsResource, c.ns, name), &v1.RayClusterPool{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayClusterPool), err
+}
+
+// List takes label and field selectors, and returns the list of RayClusterPools that match those selectors.
+func (c *FakeRayClusterPools) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RayClusterPoolList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(rayclusterpoolsResource, rayclusterpoolsKind, c.ns, opts), &v1.RayClusterPoolList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.RayClusterPoolList{ListMeta: obj.(*v1.RayClusterPoolList).ListMeta}
+	for _, item := range obj.(*v1.RayClusterPoolList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested rayClusterPools.
+func (c *FakeRayClusterPools) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(rayclusterpoolsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a rayClusterPool and creates it.  Returns the server's representation of the rayClusterPool, and an error, if there is any.
+func (c *FakeRayClusterPools) Create(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.CreateOptions) (result *v1.RayClusterPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(rayclusterpoolsResource, c.ns, rayClusterPool), &v1.RayClusterPool{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayClusterPool), err
+}
+
+// Update takes the representation of a rayClusterPool and updates it. Returns the server's representation of the rayClusterPool, and an error, if there is any.
+func (c *FakeRayClusterPools) Update(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.UpdateOptions) (result *v1.RayClusterPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(rayclusterpoolsResource, c.ns, rayClusterPool), &v1.RayClusterPool{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayClusterPool), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRayClusterPools) UpdateStatus(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.UpdateOptions) (*v1.RayClusterPool, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(rayclusterpoolsResource, "status", c.ns, rayClusterPool), &v1.RayClusterPool{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayClusterPool), err
+}
+
+// Delete takes name of the rayClusterPool and deletes it. Returns an error if one occurs.
+func (c *FakeRayClusterPools) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(rayclusterpoolsResource, c.ns, name, opts), &v1.RayClusterPool{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRayClusterPools) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(rayclusterpoolsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.RayClusterPoolList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched rayClusterPool.
+func (c *FakeRayClusterPools) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayClusterPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayclusterpoolsResource, c.ns, name, pt, data, subresources...), &v1.RayClusterPool{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayClusterPool), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied rayClusterPool.
+func (c *FakeRayClusterPools) Apply(ctx context.Context, rayClusterPool *rayv1.RayClusterPoolApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayClusterPool, err error) {
+	if rayClusterPool == nil {
+		return nil, fmt.Errorf("rayClusterPool provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(rayClusterPool)
+	if err != nil {
+		return nil, err
+	}
+	name := rayClusterPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayClusterPool.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayclusterpoolsResource, c.ns, *name, types.ApplyPatchType, data), &v1.RayClusterPool{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayClusterPool), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeRayClusterPools) ApplyStatus(ctx context.Context, rayClusterPool *rayv1.RayClusterPoolApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayClusterPool, err error) {
+	if rayClusterPool == nil {
+		return nil, fmt.Errorf("rayClusterPool provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(rayClusterPool)
+	if err != nil {
+		return nil, err
+	}
+	name := rayClusterPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayClusterPool.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayclusterpoolsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1.RayClusterPool{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayClusterPool), err
+}
@@ -0,0 +1,173 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRayJobQueues implements RayJobQueueInterface
+type FakeRayJobQueues struct {
+	Fake *FakeRayV1
+	ns   string
+}
+
+var rayjobqueuesResource = v1.SchemeGroupVersion.WithResource("rayjobqueues")
+
+var rayjobqueuesKind = v1.SchemeGroupVersion.WithKind("RayJobQueue")
+
+// Get takes name of the rayJobQueue, and returns the corresponding rayJobQueue object, and an error if there is any.
+func (c *FakeRayJobQueues) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RayJobQueue, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(rayjobqueuesResource, c.ns, name), &v1.RayJobQueue{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobQueue), err
+}
+
+// List takes label and field selectors, and returns the list of RayJobQueues that match those selectors.
+func (c *FakeRayJobQueues) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RayJobQueueList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(rayjobqueuesResource, rayjobqueuesKind, c.ns, opts), &v1.RayJobQueueList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.RayJobQueueList{ListMeta: obj.(*v1.RayJobQueueList).ListMeta}
+	for _, item := range obj.(*v1.RayJobQueueList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested rayJobQueues.
+func (c *FakeRayJobQueues) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(rayjobqueuesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a rayJobQueue and creates it.  Returns the server's representation of the rayJobQueue, and an error, if there is any.
+func (c *FakeRayJobQueues) Create(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.CreateOptions) (result *v1.RayJobQueue, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(rayjobqueuesResource, c.ns, rayJobQueue), &v1.RayJobQueue{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobQueue), err
+}
+
+// Update takes the representation of a rayJobQueue and updates it. Returns the server's representation of the rayJobQueue, and an error, if there is any.
+func (c *FakeRayJobQueues) Update(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.UpdateOptions) (result *v1.RayJobQueue, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(rayjobqueuesResource, c.ns, rayJobQueue), &v1.RayJobQueue{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobQueue), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRayJobQueues) UpdateStatus(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.UpdateOptions) (*v1.RayJobQueue, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(rayjobqueuesResource, "status", c.ns, rayJobQueue), &v1.RayJobQueue{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobQueue), err
+}
+
+// Delete takes name of the rayJobQueue and deletes it. Returns an error if one occurs.
+func (c *FakeRayJobQueues) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(rayjobqueuesResource, c.ns, name, opts), &v1.RayJobQueue{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRayJobQueues) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(rayjobqueuesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.RayJobQueueList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched rayJobQueue.
+func (c *FakeRayJobQueues) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayJobQueue, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayjobqueuesResource, c.ns, name, pt, data, subresources...), &v1.RayJobQueue{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobQueue), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied rayJobQueue.
+func (c *FakeRayJobQueues) Apply(ctx context.Context, rayJobQueue *rayv1.RayJobQueueApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobQueue, err error) {
+	if rayJobQueue == nil {
+		return nil, fmt.Errorf("rayJobQueue provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(rayJobQueue)
+	if err != nil {
+		return nil, err
+	}
+	name := rayJobQueue.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobQueue.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayjobqueuesResource, c.ns, *name, types.ApplyPatchType, data), &v1.RayJobQueue{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobQueue), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeRayJobQueues) ApplyStatus(ctx context.Context, rayJobQueue *rayv1.RayJobQueueApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobQueue, err error) {
+	if rayJobQueue == nil {
+		return nil, fmt.Errorf("rayJobQueue provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(rayJobQueue)
+	if err != nil {
+		return nil, err
+	}
+	name := rayJobQueue.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobQueue.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayjobqueuesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1.RayJobQueue{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobQueue), err
+}
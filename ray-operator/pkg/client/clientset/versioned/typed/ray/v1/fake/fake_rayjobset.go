@@ -0,0 +1,173 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRayJobSets implements RayJobSetInterface
+type FakeRayJobSets struct {
+	Fake *FakeRayV1
+	ns   string
+}
+
+var rayjobsetsResource = v1.SchemeGroupVersion.WithResource("rayjobsets")
+
+var rayjobsetsKind = v1.SchemeGroupVersion.WithKind("RayJobSet")
+
+// Get takes name of the rayJobSet, and returns the corresponding rayJobSet object, and an error if there is any.
+func (c *FakeRayJobSets) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RayJobSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(rayjobsetsResource, c.ns, name), &v1.RayJobSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobSet), err
+}
+
+// List takes label and field selectors, and returns the list of RayJobSets that match those selectors.
+func (c *FakeRayJobSets) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RayJobSetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(rayjobsetsResource, rayjobsetsKind, c.ns, opts), &v1.RayJobSetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.RayJobSetList{ListMeta: obj.(*v1.RayJobSetList).ListMeta}
+	for _, item := range obj.(*v1.RayJobSetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested rayJobSets.
+func (c *FakeRayJobSets) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(rayjobsetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a rayJobSet and creates it.  Returns the server's representation of the rayJobSet, and an error, if there is any.
+func (c *FakeRayJobSets) Create(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.CreateOptions) (result *v1.RayJobSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(rayjobsetsResource, c.ns, rayJobSet), &v1.RayJobSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobSet), err
+}
+
+// Update takes the representation of a rayJobSet and updates it. Returns the server's representation of the rayJobSet, and an error, if there is any.
+func (c *FakeRayJobSets) Update(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.UpdateOptions) (result *v1.RayJobSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(rayjobsetsResource, c.ns, rayJobSet), &v1.RayJobSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobSet), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRayJobSets) UpdateStatus(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.UpdateOptions) (*v1.RayJobSet, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(rayjobsetsResource, "status", c.ns, rayJobSet), &v1.RayJobSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobSet), err
+}
+
+// Delete takes name of the rayJobSet and deletes it. Returns an error if one occurs.
+func (c *FakeRayJobSets) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(rayjobsetsResource, c.ns, name, opts), &v1.RayJobSet{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRayJobSets) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(rayjobsetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.RayJobSetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched rayJobSet.
+func (c *FakeRayJobSets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayJobSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayjobsetsResource, c.ns, name, pt, data, subresources...), &v1.RayJobSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobSet), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied rayJobSet.
+func (c *FakeRayJobSets) Apply(ctx context.Context, rayJobSet *rayv1.RayJobSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobSet, err error) {
+	if rayJobSet == nil {
+		return nil, fmt.Errorf("rayJobSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(rayJobSet)
+	if err != nil {
+		return nil, err
+	}
+	name := rayJobSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobSet.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayjobsetsResource, c.ns, *name, types.ApplyPatchType, data), &v1.RayJobSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobSet), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeRayJobSets) ApplyStatus(ctx context.Context, rayJobSet *rayv1.RayJobSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobSet, err error) {
+	if rayJobSet == nil {
+		return nil, fmt.Errorf("rayJobSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(rayJobSet)
+	if err != nil {
+		return nil, err
+	}
+	name := rayJobSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobSet.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rayjobsetsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1.RayJobSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RayJobSet), err
+}
@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	scheme "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RayJobQueuesGetter has a method to return a RayJobQueueInterface.
+// A group's client should implement this interface.
+type RayJobQueuesGetter interface {
+	RayJobQueues(namespace string) RayJobQueueInterface
+}
+
+// RayJobQueueInterface has methods to work with RayJobQueue resources.
+type RayJobQueueInterface interface {
+	Create(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.CreateOptions) (*v1.RayJobQueue, error)
+	Update(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.UpdateOptions) (*v1.RayJobQueue, error)
+	UpdateStatus(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.UpdateOptions) (*v1.RayJobQueue, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.RayJobQueue, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.RayJobQueueList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayJobQueue, err error)
+	Apply(ctx context.Context, rayJobQueue *rayv1.RayJobQueueApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobQueue, err error)
+	ApplyStatus(ctx context.Context, rayJobQueue *rayv1.RayJobQueueApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobQueue, err error)
+	RayJobQueueExpansion
+}
+
+// rayJobQueues implements RayJobQueueInterface
+type rayJobQueues struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRayJobQueues returns a RayJobQueues
+func newRayJobQueues(c *RayV1Client, namespace string) *rayJobQueues {
+	return &rayJobQueues{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the rayJobQueue, and returns the corresponding rayJobQueue object, and an error if there is any.
+func (c *rayJobQueues) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RayJobQueue, err error) {
+	result = &v1.RayJobQueue{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RayJobQueues that match those selectors.
+func (c *rayJobQueues) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RayJobQueueList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.RayJobQueueList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested rayJobQueues.
+func (c *rayJobQueues) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a rayJobQueue and creates it.  Returns the server's representation of the rayJobQueue, and an error, if there is any.
+func (c *rayJobQueues) Create(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.CreateOptions) (result *v1.RayJobQueue, err error) {
+	result = &v1.RayJobQueue{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayJobQueue).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a rayJobQueue and updates it. Returns the server's representation of the rayJobQueue, and an error, if there is any.
+func (c *rayJobQueues) Update(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.UpdateOptions) (result *v1.RayJobQueue, err error) {
+	result = &v1.RayJobQueue{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		Name(rayJobQueue.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayJobQueue).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *rayJobQueues) UpdateStatus(ctx context.Context, rayJobQueue *v1.RayJobQueue, opts metav1.UpdateOptions) (result *v1.RayJobQueue, err error) {
+	result = &v1.RayJobQueue{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		Name(rayJobQueue.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayJobQueue).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the rayJobQueue and deletes it. Returns an error if one occurs.
+func (c *rayJobQueues) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *rayJobQueues) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched rayJobQueue.
+func (c *rayJobQueues) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayJobQueue, err error) {
+	result = &v1.RayJobQueue{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied rayJobQueue.
+func (c *rayJobQueues) Apply(ctx context.Context, rayJobQueue *rayv1.RayJobQueueApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobQueue, err error) {
+	if rayJobQueue == nil {
+		return nil, fmt.Errorf("rayJobQueue provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(rayJobQueue)
+	if err != nil {
+		return nil, err
+	}
+	name := rayJobQueue.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobQueue.Name must be provided to Apply")
+	}
+	result = &v1.RayJobQueue{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *rayJobQueues) ApplyStatus(ctx context.Context, rayJobQueue *rayv1.RayJobQueueApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobQueue, err error) {
+	if rayJobQueue == nil {
+		return nil, fmt.Errorf("rayJobQueue provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(rayJobQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	name := rayJobQueue.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobQueue.Name must be provided to Apply")
+	}
+
+	result = &v1.RayJobQueue{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("rayjobqueues").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	scheme "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RayJobSetsGetter has a method to return a RayJobSetInterface.
+// A group's client should implement this interface.
+type RayJobSetsGetter interface {
+	RayJobSets(namespace string) RayJobSetInterface
+}
+
+// RayJobSetInterface has methods to work with RayJobSet resources.
+type RayJobSetInterface interface {
+	Create(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.CreateOptions) (*v1.RayJobSet, error)
+	Update(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.UpdateOptions) (*v1.RayJobSet, error)
+	UpdateStatus(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.UpdateOptions) (*v1.RayJobSet, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.RayJobSet, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.RayJobSetList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayJobSet, err error)
+	Apply(ctx context.Context, rayJobSet *rayv1.RayJobSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobSet, err error)
+	ApplyStatus(ctx context.Context, rayJobSet *rayv1.RayJobSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobSet, err error)
+	RayJobSetExpansion
+}
+
+// rayJobSets implements RayJobSetInterface
+type rayJobSets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRayJobSets returns a RayJobSets
+func newRayJobSets(c *RayV1Client, namespace string) *rayJobSets {
+	return &rayJobSets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the rayJobSet, and returns the corresponding rayJobSet object, and an error if there is any.
+func (c *rayJobSets) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RayJobSet, err error) {
+	result = &v1.RayJobSet{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RayJobSets that match those selectors.
+func (c *rayJobSets) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RayJobSetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.RayJobSetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested rayJobSets.
+func (c *rayJobSets) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a rayJobSet and creates it.  Returns the server's representation of the rayJobSet, and an error, if there is any.
+func (c *rayJobSets) Create(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.CreateOptions) (result *v1.RayJobSet, err error) {
+	result = &v1.RayJobSet{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayJobSet).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a rayJobSet and updates it. Returns the server's representation of the rayJobSet, and an error, if there is any.
+func (c *rayJobSets) Update(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.UpdateOptions) (result *v1.RayJobSet, err error) {
+	result = &v1.RayJobSet{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		Name(rayJobSet.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayJobSet).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *rayJobSets) UpdateStatus(ctx context.Context, rayJobSet *v1.RayJobSet, opts metav1.UpdateOptions) (result *v1.RayJobSet, err error) {
+	result = &v1.RayJobSet{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		Name(rayJobSet.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayJobSet).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the rayJobSet and deletes it. Returns an error if one occurs.
+func (c *rayJobSets) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *rayJobSets) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched rayJobSet.
+func (c *rayJobSets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayJobSet, err error) {
+	result = &v1.RayJobSet{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied rayJobSet.
+func (c *rayJobSets) Apply(ctx context.Context, rayJobSet *rayv1.RayJobSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobSet, err error) {
+	if rayJobSet == nil {
+		return nil, fmt.Errorf("rayJobSet provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(rayJobSet)
+	if err != nil {
+		return nil, err
+	}
+	name := rayJobSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobSet.Name must be provided to Apply")
+	}
+	result = &v1.RayJobSet{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *rayJobSets) ApplyStatus(ctx context.Context, rayJobSet *rayv1.RayJobSetApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayJobSet, err error) {
+	if rayJobSet == nil {
+		return nil, fmt.Errorf("rayJobSet provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(rayJobSet)
+	if err != nil {
+		return nil, err
+	}
+
+	name := rayJobSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayJobSet.Name must be provided to Apply")
+	}
+
+	result = &v1.RayJobSet{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("rayjobsets").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -10,8 +10,14 @@ import (
 type Interface interface {
 	// RayClusters returns a RayClusterInformer.
 	RayClusters() RayClusterInformer
+	// RayClusterPools returns a RayClusterPoolInformer.
+	RayClusterPools() RayClusterPoolInformer
 	// RayJobs returns a RayJobInformer.
 	RayJobs() RayJobInformer
+	// RayJobQueues returns a RayJobQueueInformer.
+	RayJobQueues() RayJobQueueInformer
+	// RayJobSets returns a RayJobSetInformer.
+	RayJobSets() RayJobSetInformer
 	// RayServices returns a RayServiceInformer.
 	RayServices() RayServiceInformer
 }
@@ -32,11 +38,26 @@ func (v *version) RayClusters() RayClusterInformer {
 	return &rayClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RayClusterPools returns a RayClusterPoolInformer.
+func (v *version) RayClusterPools() RayClusterPoolInformer {
+	return &rayClusterPoolInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // RayJobs returns a RayJobInformer.
 func (v *version) RayJobs() RayJobInformer {
 	return &rayJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RayJobQueues returns a RayJobQueueInformer.
+func (v *version) RayJobQueues() RayJobQueueInformer {
+	return &rayJobQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RayJobSets returns a RayJobSetInformer.
+func (v *version) RayJobSets() RayJobSetInformer {
+	return &rayJobSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // RayServices returns a RayServiceInformer.
 func (v *version) RayServices() RayServiceInformer {
 	return &rayServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	versioned "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/ray-project/kuberay/ray-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1 "github.com/ray-project/kuberay/ray-operator/pkg/client/listers/ray/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RayJobQueueInformer provides access to a shared informer and lister for
+// RayJobQueues.
+type RayJobQueueInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.RayJobQueueLister
+}
+
+type rayJobQueueInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewRayJobQueueInformer constructs a new informer for RayJobQueue type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewRayJobQueueInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredRayJobQueueInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredRayJobQueueInformer constructs a new informer for RayJobQueue type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredRayJobQueueInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayJobQueues(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayJobQueues(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&rayv1.RayJobQueue{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *rayJobQueueInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredRayJobQueueInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *rayJobQueueInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&rayv1.RayJobQueue{}, f.defaultInformer)
+}
+
+func (f *rayJobQueueInformer) Lister() v1.RayJobQueueLister {
+	return v1.NewRayJobQueueLister(f.Informer().GetIndexer())
+}
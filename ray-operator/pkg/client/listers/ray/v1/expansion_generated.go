@@ -10,6 +10,14 @@ type RayClusterListerExpansion interface{}
 // RayClusterNamespaceLister.
 type RayClusterNamespaceListerExpansion interface{}
 
+// RayClusterPoolListerExpansion allows custom methods to be added to
+// RayClusterPoolLister.
+type RayClusterPoolListerExpansion interface{}
+
+// RayClusterPoolNamespaceListerExpansion allows custom methods to be added to
+// RayClusterPoolNamespaceLister.
+type RayClusterPoolNamespaceListerExpansion interface{}
+
 // RayJobListerExpansion allows custom methods to be added to
 // RayJobLister.
 type RayJobListerExpansion interface{}
@@ -18,6 +26,22 @@ type RayJobListerExpansion interface{}
 // RayJobNamespaceLister.
 type RayJobNamespaceListerExpansion interface{}
 
+// RayJobQueueListerExpansion allows custom methods to be added to
+// RayJobQueueLister.
+type RayJobQueueListerExpansion interface{}
+
+// RayJobQueueNamespaceListerExpansion allows custom methods to be added to
+// RayJobQueueNamespaceLister.
+type RayJobQueueNamespaceListerExpansion interface{}
+
+// RayJobSetListerExpansion allows custom methods to be added to
+// RayJobSetLister.
+type RayJobSetListerExpansion interface{}
+
+// RayJobSetNamespaceListerExpansion allows custom methods to be added to
+// RayJobSetNamespaceLister.
+type RayJobSetNamespaceListerExpansion interface{}
+
 // RayServiceListerExpansion allows custom methods to be added to
 // RayServiceLister.
 type RayServiceListerExpansion interface{}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RayJobQueueLister helps list RayJobQueues.
+// All objects returned here must be treated as read-only.
+type RayJobQueueLister interface {
+	// List lists all RayJobQueues in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayJobQueue, err error)
+	// RayJobQueues returns an object that can list and get RayJobQueues.
+	RayJobQueues(namespace string) RayJobQueueNamespaceLister
+	RayJobQueueListerExpansion
+}
+
+// rayJobQueueLister implements the RayJobQueueLister interface.
+type rayJobQueueLister struct {
+	indexer cache.Indexer
+}
+
+// NewRayJobQueueLister returns a new RayJobQueueLister.
+func NewRayJobQueueLister(indexer cache.Indexer) RayJobQueueLister {
+	return &rayJobQueueLister{indexer: indexer}
+}
+
+// List lists all RayJobQueues in the indexer.
+func (s *rayJobQueueLister) List(selector labels.Selector) (ret []*v1.RayJobQueue, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayJobQueue))
+	})
+	return ret, err
+}
+
+// RayJobQueues returns an object that can list and get RayJobQueues.
+func (s *rayJobQueueLister) RayJobQueues(namespace string) RayJobQueueNamespaceLister {
+	return rayJobQueueNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RayJobQueueNamespaceLister helps list and get RayJobQueues.
+// All objects returned here must be treated as read-only.
+type RayJobQueueNamespaceLister interface {
+	// List lists all RayJobQueues in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayJobQueue, err error)
+	// Get retrieves the RayJobQueue from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.RayJobQueue, error)
+	RayJobQueueNamespaceListerExpansion
+}
+
+// rayJobQueueNamespaceLister implements the RayJobQueueNamespaceLister
+// interface.
+type rayJobQueueNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RayJobQueues in the indexer for a given namespace.
+func (s rayJobQueueNamespaceLister) List(selector labels.Selector) (ret []*v1.RayJobQueue, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayJobQueue))
+	})
+	return ret, err
+}
+
+// Get retrieves the RayJobQueue from the indexer for a given namespace and name.
+func (s rayJobQueueNamespaceLister) Get(name string) (*v1.RayJobQueue, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("rayjobqueue"), name)
+	}
+	return obj.(*v1.RayJobQueue), nil
+}